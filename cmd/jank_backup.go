@@ -0,0 +1,142 @@
+// Package cmd 提供应用程序的启动和运行入口
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/backup"
+	"github.com/Done-0/jank/internal/db"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/logger"
+	"github.com/Done-0/jank/internal/storage"
+)
+
+// Export 将站点数据与媒体文件导出为归档
+// 用法：jank export [-o 归档路径] [-include-passwords]
+// 参数：
+//   - args: 子命令参数
+func Export(args []string) {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	output := flags.String("o", "jank-backup-"+time.Now().Format("20060102-150405")+".zip", "归档输出路径")
+	includePasswords := flags.Bool("include-passwords", false, "导出用户密码哈希")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: jank export [-o FILE] [-include-passwords]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	cfgs := initBackupEnv()
+
+	file, err := os.Create(*output)
+	if err != nil {
+		log.Fatalf("failed to create archive: %v", err)
+	}
+
+	manifest, err := backup.Export(context.Background(), global.DB, storage.GlobalStorage, file, backup.ExportOptions{IncludePasswords: *includePasswords})
+	if err == nil {
+		err = file.Close()
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(*output)
+		log.Fatalf("failed to export site: %v", err)
+	}
+
+	for _, table := range slices.Sorted(maps.Keys(manifest.Tables)) {
+		fmt.Printf("%-16s %d\n", table, manifest.Tables[table])
+	}
+	for _, key := range manifest.MissingMedia {
+		fmt.Printf("MISSING  %s\n", key)
+	}
+	fmt.Printf("Exported %d posts and %d media files from %s to %s\n", manifest.Posts, manifest.Media, cfgs.DBConfig.DBDialect, *output)
+	if !manifest.IncludePasswords {
+		fmt.Println("Password hashes were not exported; restored users will use the admin password of the target instance")
+	}
+}
+
+// Restore 将归档恢复到空实例，目标实例已有内容时拒绝恢复
+// 用法：jank restore <归档路径>
+// 参数：
+//   - args: 子命令参数
+func Restore(args []string) {
+	flags := flag.NewFlagSet("restore", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: jank restore <backup.zip>")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		log.Fatalf("failed to open archive: %v", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		log.Fatalf("failed to stat archive: %v", err)
+	}
+
+	cfgs := initBackupEnv()
+
+	// 归档未包含密码哈希的用户使用配置中的管理员密码登录
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(cfgs.AppConfig.User.AdminPassword), bcrypt.DefaultCost)
+	if err != nil {
+		log.Fatalf("failed to hash password: %v", err)
+	}
+
+	result, err := backup.Restore(context.Background(), global.DB, storage.GlobalStorage, file, info.Size(), backup.RestoreOptions{PasswordHash: string(hashedPassword)})
+	if err != nil {
+		log.Fatalf("failed to restore site: %v", err)
+	}
+
+	for _, table := range slices.Sorted(maps.Keys(result.Tables)) {
+		fmt.Printf("%-16s %d\n", table, result.Tables[table])
+	}
+	for _, key := range result.MissingMedia {
+		fmt.Printf("MISSING  %s\n", key)
+	}
+	for _, key := range result.FailedMedia {
+		fmt.Printf("FAILED   %s (copy media/%s from the archive into storage manually)\n", key, key)
+	}
+	fmt.Printf("Restored archive v%d exported from %s at %s, %d media files written to %s storage\n",
+		result.Manifest.Version, result.Manifest.Dialect, time.Unix(result.Manifest.CreatedAt, 0).Format(time.RFC3339), result.Media, storage.GlobalStorage.Driver())
+	if result.ResetPasswords > 0 {
+		fmt.Printf("%d users had no password in the archive and now use the configured admin password; change it after logging in\n", result.ResetPasswords)
+	}
+}
+
+// initBackupEnv 初始化导出与恢复所需的配置、日志、数据库与存储
+// 返回值：
+//   - *configs.Config: 应用配置
+func initBackupEnv() *configs.Config {
+	if err := configs.New(configs.DefaultConfigPath); err != nil {
+		log.Fatalf("failed to initialize config: %v", err)
+	}
+
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		log.Fatalf("failed to get config: %v", err)
+	}
+
+	logger.New(cfgs)
+	db.New(cfgs)
+	storage.New(cfgs)
+	return cfgs
+}
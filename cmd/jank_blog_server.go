@@ -19,7 +19,11 @@ import (
 	"github.com/Done-0/jank/internal/middleware"
 	"github.com/Done-0/jank/internal/plugin"
 	"github.com/Done-0/jank/internal/redis"
+	"github.com/Done-0/jank/internal/scheduler"
+	"github.com/Done-0/jank/internal/storage"
 	"github.com/Done-0/jank/internal/theme"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/importer"
 	"github.com/Done-0/jank/pkg/router"
 )
 
@@ -53,11 +57,25 @@ func Start() {
 	// 初始化主题系统
 	theme.New(cfgs)
 
+	// 初始化后台任务调度
+	scheduler.New(cfgs)
+
+	// 初始化媒体文件存储
+	storage.New(cfgs)
+
+	// 请求体上限需容纳媒体文件与文章导入文件上传，额外预留 1MB 给表单其余部分
+	maxUploadMB := cfgs.StorageConfig.MaxSizeMB
+	if maxUploadMB <= 0 {
+		maxUploadMB = consts.DefaultMediaMaxSizeMB
+	}
+	maxUploadMB = max(maxUploadMB, importer.MaxFileSize>>20)
+
 	// 创建 Hertz 服务器实例
 	addr := fmt.Sprintf("%s:%s", cfgs.AppConfig.AppHost, cfgs.AppConfig.AppPort)
 	h := server.Default(
 		server.WithHostPorts(addr),
 		server.WithExitWaitTime(10*time.Second),
+		server.WithMaxRequestBodySize((maxUploadMB+1)<<20),
 	)
 
 	// 注册中间件
@@ -70,6 +88,7 @@ func Start() {
 	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
 		plugin.GlobalPluginManager.Shutdown()
 		theme.GlobalThemeManager.Shutdown()
+		scheduler.Shutdown()
 	})
 
 	// 启动信息
@@ -0,0 +1,63 @@
+// Package cmd 提供应用程序的启动和运行入口
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/db"
+	"github.com/Done-0/jank/internal/logger"
+	"github.com/Done-0/jank/internal/storage"
+	"github.com/Done-0/jank/internal/theme"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// BuildStatic 使用当前前端主题生成静态站点，输出目录无需服务端即可部署
+// 用法：jank build-static [-o 输出目录] [-clean]
+// 参数：
+//   - args: 子命令参数
+func BuildStatic(args []string) {
+	flags := flag.NewFlagSet("build-static", flag.ExitOnError)
+	output := flags.String("o", "public", "输出目录")
+	clean := flags.Bool("clean", false, "生成前清空输出目录")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: jank build-static [-o DIR] [-clean]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	// 初始化配置
+	if err := configs.New(configs.DefaultConfigPath); err != nil {
+		log.Fatalf("failed to initialize config: %v", err)
+	}
+
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		log.Fatalf("failed to get config: %v", err)
+	}
+
+	// 初始化日志、数据库、存储与主题
+	logger.New(cfgs)
+	db.New(cfgs)
+	storage.New(cfgs)
+	theme.New(cfgs)
+
+	staticSiteService, err := wire.NewStaticSiteService()
+	if err != nil {
+		log.Fatalf("failed to initialize static site service: %v", err)
+	}
+
+	response, err := staticSiteService.Build(app.NewContext(0), &dto.BuildStaticSiteRequest{OutputDir: *output, Clean: *clean})
+	if err != nil {
+		log.Fatalf("failed to build static site: %v", err)
+	}
+
+	fmt.Printf("Built static site to %s with theme %s: %s\n", response.OutputDir, response.Theme, response.Message)
+}
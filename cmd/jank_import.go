@@ -0,0 +1,85 @@
+// Package cmd 提供应用程序的启动和运行入口
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/db"
+	"github.com/Done-0/jank/internal/logger"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/importer"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// Import 从命令行导入文章，存在导入失败的条目时以状态码 1 退出
+// 用法：jank import [-author 用户ID] <目录|zip 压缩包|Markdown 文件|WordPress 导出文件>
+// 参数：
+//   - args: 子命令参数
+func Import(args []string) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	authorID := flags.Int64("author", 0, "作者用户 ID，为 0 时作者未知")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: jank import [-author USER_ID] <dir|file.zip|file.md|export.xml>")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	// 初始化配置
+	if err := configs.New(configs.DefaultConfigPath); err != nil {
+		log.Fatalf("failed to initialize config: %v", err)
+	}
+
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		log.Fatalf("failed to get config: %v", err)
+	}
+
+	// 初始化日志与数据库
+	logger.New(cfgs)
+	db.New(cfgs)
+
+	parsed, err := importer.ParsePath(flags.Arg(0))
+	if err != nil {
+		log.Fatalf("failed to parse import source: %v", err)
+	}
+
+	postService, err := wire.NewPostService()
+	if err != nil {
+		log.Fatalf("failed to initialize post service: %v", err)
+	}
+
+	c := app.NewContext(0)
+	if *authorID > 0 {
+		c.Set(consts.JWTSubjectClaim, *authorID)
+	}
+
+	response, err := postService.ImportParsed(c, parsed)
+	if err != nil {
+		log.Fatalf("failed to import posts: %v", err)
+	}
+
+	for _, item := range response.Items {
+		if item.Error != "" {
+			fmt.Printf("FAIL  %s: %s\n", item.Source, item.Error)
+			continue
+		}
+		fmt.Printf("OK    %s -> %s (%s, %s)\n", item.Source, item.ID, item.Slug, item.Status)
+	}
+	fmt.Println(response.Message)
+
+	if response.Failed > 0 {
+		os.Exit(1)
+	}
+}
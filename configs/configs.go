@@ -127,15 +127,133 @@ type ThemeConfig struct {
 	BuildTimeoutMinutes int    `mapstructure:"BUILD_TIMEOUT_MINUTES"` // 构建超时时间（分钟）
 }
 
+// SchedulerConfig 后台任务调度配置
+type SchedulerConfig struct {
+	Enabled                bool `mapstructure:"ENABLED"`                  // 是否启用定时发布等后台任务调度（浏览量写入任务始终运行）
+	PublishIntervalSeconds int  `mapstructure:"PUBLISH_INTERVAL_SECONDS"` // 定时发布检查间隔（秒）
+}
+
+// SiteConfig 站点配置
+type SiteConfig struct {
+	URL          string `mapstructure:"URL"`           // 站点访问地址，用于生成订阅源、站点地图等中的绝对链接
+	Title        string `mapstructure:"TITLE"`         // 站点标题
+	Description  string `mapstructure:"DESCRIPTION"`   // 站点描述
+	Language     string `mapstructure:"LANGUAGE"`      // 站点语言
+	PostPath     string `mapstructure:"POST_PATH"`     // 文章页面路径模板，支持 {id}、{slug} 占位符
+	CategoryPath string `mapstructure:"CATEGORY_PATH"` // 分类页面路径模板，支持 {id} 占位符
+	TagPath      string `mapstructure:"TAG_PATH"`      // 标签页面路径模板，支持 {id} 占位符
+}
+
+// FeedConfig 订阅源配置
+type FeedConfig struct {
+	Size   int `mapstructure:"SIZE"`    // 订阅源包含的文章数量
+	MaxAge int `mapstructure:"MAX_AGE"` // 客户端缓存时间（秒）
+}
+
+// RobotsConfig robots.txt 配置
+type RobotsConfig struct {
+	Allow    []string `mapstructure:"ALLOW"`    // 允许抓取的路径
+	Disallow []string `mapstructure:"DISALLOW"` // 禁止抓取的路径
+	Content  string   `mapstructure:"CONTENT"`  // 自定义完整内容，非空时忽略其他配置原样输出
+}
+
+// SanitizeConfig 文章 HTML 清洗配置
+type SanitizeConfig struct {
+	Enabled           bool     `mapstructure:"ENABLED"`             // 是否启用 HTML 清洗
+	TrustedRoles      []string `mapstructure:"TRUSTED_ROLES"`       // 受信任角色，作者与所有编辑者均具备受信任角色时文章保留原始 HTML
+	AllowedElements   []string `mapstructure:"ALLOWED_ELEMENTS"`    // 在内置白名单基础上额外允许的元素
+	AllowedAttributes []string `mapstructure:"ALLOWED_ATTRIBUTES"`  // 在内置白名单基础上额外允许的全局属性
+	AllowedURLSchemes []string `mapstructure:"ALLOWED_URL_SCHEMES"` // 允许的链接协议
+}
+
+// MarkdownConfig Markdown 渲染配置
+type MarkdownConfig struct {
+	Extensions           []string `mapstructure:"EXTENSIONS"`             // 启用的渲染扩展，为空时使用默认扩展
+	HighlightStyle       string   `mapstructure:"HIGHLIGHT_STYLE"`        // 代码高亮样式名称
+	HighlightLineNumbers bool     `mapstructure:"HIGHLIGHT_LINE_NUMBERS"` // 代码高亮是否显示行号
+}
+
+// ExcerptConfig 文章自动摘要配置
+type ExcerptConfig struct {
+	Length int `mapstructure:"LENGTH"` // 自动摘要最大字符数，文章描述为空时按此长度由正文生成
+}
+
+// StorageConfig 媒体文件存储配置
+type StorageConfig struct {
+	Driver       string             `mapstructure:"DRIVER"`        // 存储驱动：local 本地文件系统，s3 兼容 S3 协议的对象存储
+	MaxSizeMB    int                `mapstructure:"MAX_SIZE_MB"`   // 单个文件大小上限（MB）
+	AllowedTypes []string           `mapstructure:"ALLOWED_TYPES"` // 允许上传的 MIME 类型
+	Local        LocalStorageConfig `mapstructure:"LOCAL"`         // 本地文件系统存储配置
+	S3           S3StorageConfig    `mapstructure:"S3"`            // S3 兼容对象存储配置
+}
+
+// LocalStorageConfig 本地文件系统存储配置
+type LocalStorageConfig struct {
+	Root      string `mapstructure:"ROOT"`       // 文件存放根目录
+	URLPrefix string `mapstructure:"URL_PREFIX"` // 文件访问路径前缀，由服务端直接提供访问
+}
+
+// S3StorageConfig S3 兼容对象存储配置
+type S3StorageConfig struct {
+	Endpoint  string `mapstructure:"ENDPOINT"`   // 服务地址（不含协议），如 s3.amazonaws.com、localhost:9000
+	AccessKey string `mapstructure:"ACCESS_KEY"` // 访问密钥 ID
+	SecretKey string `mapstructure:"SECRET_KEY"` // 访问密钥
+	Bucket    string `mapstructure:"BUCKET"`     // 存储桶名称
+	Region    string `mapstructure:"REGION"`     // 区域
+	UseSSL    bool   `mapstructure:"USE_SSL"`    // 是否使用 HTTPS 访问服务
+	PublicURL string `mapstructure:"PUBLIC_URL"` // 文件公开访问地址前缀（如 CDN 地址），为空时使用 服务地址/存储桶
+}
+
+// ImageConfig 图片处理配置
+type ImageConfig struct {
+	StripMetadata bool   `mapstructure:"STRIP_METADATA"` // 上传时是否移除 EXIF（含 GPS 定位）等元数据
+	Generate      string `mapstructure:"GENERATE"`       // 变体生成时机：upload 上传时生成，lazy 首次访问时生成并缓存
+	Widths        []int  `mapstructure:"WIDTHS"`         // 变体宽度（像素），仅生成小于原图宽度的变体
+	Quality       int    `mapstructure:"QUALITY"`        // JPEG 与 WebP 编码质量（1-100）
+	WebP          bool   `mapstructure:"WEBP"`           // 是否生成 WebP 版本
+	WebPEncoder   string `mapstructure:"WEBP_ENCODER"`   // WebP 编码器（cwebp）可执行文件路径，不可用时跳过 WebP 版本
+	MaxPixels     int64  `mapstructure:"MAX_PIXELS"`     // 允许解码处理的图片像素数上限（宽×高，GIF 按帧累计），超出时拒绝上传
+}
+
+// ViewConfig 文章浏览量统计配置
+type ViewConfig struct {
+	DedupWindowMinutes   int `mapstructure:"DEDUP_WINDOW_MINUTES"`   // 同一访客重复浏览同一文章的去重时间窗口（分钟）
+	FlushIntervalSeconds int `mapstructure:"FLUSH_INTERVAL_SECONDS"` // Redis 中缓冲的浏览量写入数据库的间隔（秒）
+}
+
+// ReactionConfig 文章表态配置
+type ReactionConfig struct {
+	Types             []ReactionTypeConfig `mapstructure:"TYPES"`               // 可用的表态类型，为空时关闭表态功能
+	RateLimit         int                  `mapstructure:"RATE_LIMIT"`          // 同一用户或 IP 在限流窗口内允许的表态次数，为 0 时使用默认值
+	RateWindowSeconds int                  `mapstructure:"RATE_WINDOW_SECONDS"` // 限流窗口（秒）
+}
+
+// ReactionTypeConfig 表态类型配置
+type ReactionTypeConfig struct {
+	Key   string `mapstructure:"KEY"`   // 表态类型标识，如 like、clap
+	Emoji string `mapstructure:"EMOJI"` // 展示用的表情符号
+}
+
 // Config 总配置结构
 type Config struct {
-	AppConfig    AppConfig      `mapstructure:"APP"`      // 应用配置
-	DBConfig     DatabaseConfig `mapstructure:"DATABASE"` // 数据库配置
-	LogConfig    LogConfig      `mapstructure:"LOG"`      // 日志配置
-	RedisConfig  RedisConfig    `mapstructure:"REDIS"`    // Redis 配置
-	CasbinConfig CasbinConfig   `mapstructure:"CASBIN"`   // Casbin 权限配置
-	PluginConfig PluginConfig   `mapstructure:"PLUGIN"`   // 插件配置
-	ThemeConfig  ThemeConfig    `mapstructure:"THEME"`    // 主题配置
+	AppConfig       AppConfig       `mapstructure:"APP"`       // 应用配置
+	DBConfig        DatabaseConfig  `mapstructure:"DATABASE"`  // 数据库配置
+	LogConfig       LogConfig       `mapstructure:"LOG"`       // 日志配置
+	RedisConfig     RedisConfig     `mapstructure:"REDIS"`     // Redis 配置
+	CasbinConfig    CasbinConfig    `mapstructure:"CASBIN"`    // Casbin 权限配置
+	PluginConfig    PluginConfig    `mapstructure:"PLUGIN"`    // 插件配置
+	ThemeConfig     ThemeConfig     `mapstructure:"THEME"`     // 主题配置
+	SchedulerConfig SchedulerConfig `mapstructure:"SCHEDULER"` // 后台任务调度配置
+	SiteConfig      SiteConfig      `mapstructure:"SITE"`      // 站点配置
+	FeedConfig      FeedConfig      `mapstructure:"FEED"`      // 订阅源配置
+	RobotsConfig    RobotsConfig    `mapstructure:"ROBOTS"`    // robots.txt 配置
+	SanitizeConfig  SanitizeConfig  `mapstructure:"SANITIZE"`  // 文章 HTML 清洗配置
+	MarkdownConfig  MarkdownConfig  `mapstructure:"MARKDOWN"`  // Markdown 渲染配置
+	ExcerptConfig   ExcerptConfig   `mapstructure:"EXCERPT"`   // 文章自动摘要配置
+	StorageConfig   StorageConfig   `mapstructure:"STORAGE"`   // 媒体文件存储配置
+	ImageConfig     ImageConfig     `mapstructure:"IMAGE"`     // 图片处理配置
+	ViewConfig      ViewConfig      `mapstructure:"VIEW"`      // 文章浏览量统计配置
+	ReactionConfig  ReactionConfig  `mapstructure:"REACTION"`  // 文章表态配置
 }
 
 // DefaultConfigPath 默认配置文件路径
@@ -0,0 +1,64 @@
+// Package backup 提供站点整体导出与恢复功能，归档与数据库方言无关，可跨方言迁移
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package backup
+
+import (
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// ArchiveVersion 当前归档格式版本，恢复时拒绝更高版本的归档
+const ArchiveVersion = 1
+
+// 归档内的文件布局
+const (
+	manifestFile = "manifest.json" // 归档清单
+	dataDir      = "data/"         // 各数据表，每行一条 JSON 记录：data/<表名>.jsonl
+	postsDir     = "posts/"        // 文章 Markdown（含 front matter），可直接用于 jank import
+	mediaDir     = "media/"        // 媒体原文件与已生成的变体：media/<存储键>
+)
+
+// batchSize 导出查询与恢复写入的批大小
+const batchSize = 200
+
+// Manifest 归档清单
+type Manifest struct {
+	Version          int              `json:"version"`                 // 归档格式版本
+	CreatedAt        int64            `json:"created_at"`              // 导出时间（Unix 秒）
+	Dialect          string           `json:"dialect"`                 // 导出时的数据库方言，仅供参考
+	IncludePasswords bool             `json:"include_passwords"`       // 是否包含用户密码哈希
+	Tables           map[string]int64 `json:"tables"`                  // 各数据表的记录数
+	Posts            int64            `json:"posts"`                   // 导出的文章 Markdown 文件数
+	Media            int64            `json:"media"`                   // 导出的媒体文件数
+	MissingMedia     []string         `json:"missing_media,omitempty"` // 存储中无法读取而未导出的媒体文件键
+}
+
+// parseSchema 解析模型的表结构
+// 参数：
+//   - db: 数据库连接
+//   - model: 模型指针
+//
+// 返回值：
+//   - *schema.Schema: 表结构
+//   - error: 模型无法解析时返回错误
+func parseSchema(db *gorm.DB, model any) (*schema.Schema, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return nil, fmt.Errorf("failed to parse model %T: %w", model, err)
+	}
+	return stmt.Schema, nil
+}
+
+// newBatch 创建与模型同类型的指针切片，用于批量查询与写入
+// 参数：
+//   - model: 模型指针
+//
+// 返回值：
+//   - reflect.Value: 指向 []*模型 的指针
+func newBatch(model any) reflect.Value {
+	return reflect.New(reflect.SliceOf(reflect.TypeOf(model)))
+}
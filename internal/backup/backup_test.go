@@ -0,0 +1,197 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/Done-0/jank/internal/model"
+	"github.com/Done-0/jank/internal/model/category"
+	mediaModel "github.com/Done-0/jank/internal/model/media"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/series"
+	"github.com/Done-0/jank/internal/model/user"
+)
+
+// memStore 内存媒体存储
+type memStore struct {
+	driver  string
+	files   map[string][]byte
+	failPut bool
+}
+
+func newMemStore(driver string) *memStore {
+	return &memStore{driver: driver, files: make(map[string][]byte)}
+}
+
+func (s *memStore) Driver() string { return s.driver }
+
+func (s *memStore) Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error {
+	if s.failPut {
+		return errors.New("put failed")
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	s.files[key] = content
+	return nil
+}
+
+func (s *memStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	content, ok := s.files[key]
+	if !ok {
+		return nil, fs.ErrNotExist
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (s *memStore) Exists(ctx context.Context, key string) (bool, error) {
+	_, ok := s.files[key]
+	return ok, nil
+}
+
+func (s *memStore) Delete(ctx context.Context, key string) error {
+	delete(s.files, key)
+	return nil
+}
+
+func (s *memStore) URL(key string) string { return "/" + s.driver + "/" + key }
+
+func openDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "jank.db")), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(model.GetAllModels()...); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// seed 写入测试数据，包含与字段默认值不同的零值（false、0）及逻辑删除的记录
+func seed(t *testing.T, db *gorm.DB, store *memStore) {
+	t.Helper()
+	u := &user.User{Email: "a@example.com", Password: "hash", Nickname: "alice", Role: "super_admin"}
+	cat := &category.Category{Name: "Go", Sort: 5, IsActive: true}
+	p := &post.Post{Title: "Hello", Slug: "hello", Status: "published", Markdown: "# Hello", HTML: "<h1>Hello</h1>"}
+	removed := &post.Post{Title: "Removed", Slug: "removed", Status: "draft"}
+	m := &mediaModel.Media{FileName: "a.txt", Storage: "mem", StorageKey: "2026/a.txt", URL: "/src/2026/a.txt", MimeType: "text/plain", Size: 5, Hash: "h"}
+	for _, row := range []any{u, cat, p, removed, m} {
+		if err := db.Create(row).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.Model(cat).Updates(map[string]any{"sort": 0, "is_active": false}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Model(removed).Update("deleted", true).Error; err != nil {
+		t.Fatal(err)
+	}
+	store.files[m.StorageKey] = []byte("hello")
+}
+
+func export(t *testing.T, db *gorm.DB, store *memStore) *bytes.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	if _, err := Export(context.Background(), db, store, &buf, ExportOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestExportRestoreRoundTrip(t *testing.T) {
+	src, srcStore := openDB(t), newMemStore("src")
+	seed(t, src, srcStore)
+	archive := export(t, src, srcStore)
+
+	dst, dstStore := openDB(t), newMemStore("dst")
+	result, err := Restore(context.Background(), dst, dstStore, archive, archive.Size(), RestoreOptions{PasswordHash: "reset"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ResetPasswords != 1 || result.Media != 1 || len(result.MissingMedia) != 0 || len(result.FailedMedia) != 0 {
+		t.Errorf("result = %+v", result)
+	}
+
+	var srcPosts, dstPosts []*post.Post
+	src.Order("id").Find(&srcPosts)
+	dst.Order("id").Find(&dstPosts)
+	if len(dstPosts) != 2 || !reflect.DeepEqual(srcPosts, dstPosts) {
+		t.Errorf("posts = %+v, want %+v", dstPosts, srcPosts)
+	}
+
+	var cat category.Category
+	if err := dst.First(&cat).Error; err != nil {
+		t.Fatal(err)
+	}
+	if cat.IsActive || cat.Sort != 0 {
+		t.Errorf("category is_active = %v, sort = %d, want false and 0", cat.IsActive, cat.Sort)
+	}
+
+	var u user.User
+	if err := dst.First(&u).Error; err != nil {
+		t.Fatal(err)
+	}
+	if u.Password != "reset" || u.Email != "a@example.com" {
+		t.Errorf("user = %+v, want stripped password replaced by reset hash", u)
+	}
+
+	var m mediaModel.Media
+	if err := dst.First(&m).Error; err != nil {
+		t.Fatal(err)
+	}
+	if m.Storage != "dst" || m.URL != "/dst/2026/a.txt" || string(dstStore.files["2026/a.txt"]) != "hello" {
+		t.Errorf("media = %+v, files = %v", m, dstStore.files)
+	}
+
+	// 目标实例已有内容时拒绝恢复
+	archive.Seek(0, io.SeekStart)
+	if _, err := Restore(context.Background(), dst, dstStore, archive, archive.Size(), RestoreOptions{}); !errors.Is(err, ErrNotEmpty) {
+		t.Errorf("Restore into non-empty instance err = %v, want ErrNotEmpty", err)
+	}
+}
+
+func TestRestoreMediaAfterCommit(t *testing.T) {
+	src, srcStore := openDB(t), newMemStore("src")
+	seed(t, src, srcStore)
+	archive := export(t, src, srcStore)
+
+	// 媒体记录之后的数据表写入失败时，不应在存储中留下媒体文件
+	dst, dstStore := openDB(t), newMemStore("dst")
+	if err := dst.Migrator().DropTable(&series.Series{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Restore(context.Background(), dst, dstStore, archive, archive.Size(), RestoreOptions{}); err == nil {
+		t.Fatal("Restore with broken schema succeeded")
+	}
+	if len(dstStore.files) != 0 {
+		t.Errorf("files written despite failed restore: %v", dstStore.files)
+	}
+	var count int64
+	dst.Model(&post.Post{}).Count(&count)
+	if count != 0 {
+		t.Errorf("posts = %d after failed restore, want 0", count)
+	}
+
+	// 媒体文件写入失败时数据库已提交，失败的文件记录在结果中
+	dst, dstStore = openDB(t), newMemStore("dst")
+	dstStore.failPut = true
+	result, err := Restore(context.Background(), dst, dstStore, archive, archive.Size(), RestoreOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Media != 0 || !reflect.DeepEqual(result.FailedMedia, []string{"2026/a.txt"}) {
+		t.Errorf("result = %+v", result)
+	}
+}
@@ -0,0 +1,303 @@
+// Package backup 提供站点整体导出与恢复功能，归档与数据库方言无关，可跨方言迁移
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package backup
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/model"
+	"github.com/Done-0/jank/internal/model/category"
+	mediaModel "github.com/Done-0/jank/internal/model/media"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/tag"
+	"github.com/Done-0/jank/internal/model/user"
+	"github.com/Done-0/jank/internal/storage"
+	mediautils "github.com/Done-0/jank/internal/utils/media"
+)
+
+// ExportOptions 导出选项
+type ExportOptions struct {
+	IncludePasswords bool // 是否导出用户密码哈希，默认置空
+}
+
+// postFrontMatter 导出文章 Markdown 的 front matter，字段与文章导入器兼容
+type postFrontMatter struct {
+	Title       string   `yaml:"title"`
+	Slug        string   `yaml:"slug,omitempty"`
+	Date        string   `yaml:"date"`
+	Updated     string   `yaml:"updated"`
+	Status      string   `yaml:"status"`
+	Description string   `yaml:"description,omitempty"`
+	Image       string   `yaml:"image,omitempty"`
+	Categories  []string `yaml:"categories,omitempty"`
+	Tags        []string `yaml:"tags,omitempty"`
+}
+
+// Export 将所有数据表、文章 Markdown 与媒体文件写入 zip 归档
+// 数据表按主键导出全部记录（含逻辑删除的记录），恢复时原样写回，雪花 ID 与时间戳保持不变
+// 参数：
+//   - ctx: 上下文
+//   - db: 数据库连接
+//   - store: 媒体存储，为 nil 时不导出媒体文件
+//   - w: 归档写入目标
+//   - opts: 导出选项
+//
+// 返回值：
+//   - *Manifest: 归档清单
+//   - error: 导出过程中的错误
+func Export(ctx context.Context, db *gorm.DB, store storage.Storage, w io.Writer, opts ExportOptions) (*Manifest, error) {
+	db = db.WithContext(ctx)
+	zw := zip.NewWriter(w)
+	manifest := &Manifest{
+		Version:          ArchiveVersion,
+		CreatedAt:        time.Now().Unix(),
+		Dialect:          db.Dialector.Name(),
+		IncludePasswords: opts.IncludePasswords,
+		Tables:           make(map[string]int64),
+	}
+
+	for _, m := range model.GetAllModels() {
+		if err := exportTable(db, zw, m, opts, manifest); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := exportPosts(db, zw, manifest); err != nil {
+		return nil, err
+	}
+
+	if store != nil {
+		if err := exportMedia(ctx, db, store, zw, manifest); err != nil {
+			return nil, err
+		}
+	}
+
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	f, err := zw.Create(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create manifest: %w", err)
+	}
+	if _, err := f.Write(content); err != nil {
+		return nil, fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finalize archive: %w", err)
+	}
+	return manifest, nil
+}
+
+// exportTable 按主键分批导出单个数据表，未要求导出密码时置空用户密码哈希
+func exportTable(db *gorm.DB, zw *zip.Writer, m any, opts ExportOptions, manifest *Manifest) error {
+	sch, err := parseSchema(db, m)
+	if err != nil {
+		return err
+	}
+	name := sch.Table
+
+	f, err := zw.Create(dataDir + name + ".jsonl")
+	if err != nil {
+		return fmt.Errorf("failed to create archive entry for table '%s': %w", name, err)
+	}
+	encoder := json.NewEncoder(f)
+
+	manifest.Tables[name] = 0
+	var lastID int64
+	for {
+		batch := newBatch(m)
+		if err := db.Model(m).Where("id > ?", lastID).Order("id").Limit(batchSize).Find(batch.Interface()).Error; err != nil {
+			return fmt.Errorf("failed to query table '%s': %w", name, err)
+		}
+
+		rows := batch.Elem()
+		for i := 0; i < rows.Len(); i++ {
+			row := rows.Index(i).Interface()
+			if u, ok := row.(*user.User); ok && !opts.IncludePasswords {
+				u.Password = ""
+			}
+			if err := encoder.Encode(row); err != nil {
+				return fmt.Errorf("failed to write table '%s': %w", name, err)
+			}
+		}
+
+		manifest.Tables[name] += int64(rows.Len())
+		if rows.Len() < batchSize {
+			return nil
+		}
+		lastID = rows.Index(rows.Len() - 1).Elem().FieldByName("ID").Int()
+	}
+}
+
+// exportPosts 将未删除的文章导出为带 front matter 的 Markdown 文件，便于脱离本程序阅读或迁移到其他生成器
+func exportPosts(db *gorm.DB, zw *zip.Writer, manifest *Manifest) error {
+	var categories []*category.Category
+	if err := db.Model(&category.Category{}).Where("deleted = ?", false).Find(&categories).Error; err != nil {
+		return fmt.Errorf("failed to query categories: %w", err)
+	}
+	categoryNames := make(map[int64]string, len(categories))
+	for _, c := range categories {
+		categoryNames[c.ID] = c.Name
+	}
+
+	var tags []*tag.Tag
+	if err := db.Model(&tag.Tag{}).Where("deleted = ?", false).Find(&tags).Error; err != nil {
+		return fmt.Errorf("failed to query tags: %w", err)
+	}
+	tagNames := make(map[int64]string, len(tags))
+	for _, t := range tags {
+		tagNames[t.ID] = t.Name
+	}
+
+	var postTags []*tag.PostTag
+	if err := db.Model(&tag.PostTag{}).Where("deleted = ?", false).Order("id").Find(&postTags).Error; err != nil {
+		return fmt.Errorf("failed to query post tags: %w", err)
+	}
+	tagsByPost := make(map[int64][]string)
+	for _, pt := range postTags {
+		if name, ok := tagNames[pt.TagID]; ok {
+			tagsByPost[pt.PostID] = append(tagsByPost[pt.PostID], name)
+		}
+	}
+
+	var lastID int64
+	for {
+		var posts []*post.Post
+		if err := db.Model(&post.Post{}).Where("deleted = ? AND id > ?", false, lastID).Order("id").Limit(batchSize).Find(&posts).Error; err != nil {
+			return fmt.Errorf("failed to query posts: %w", err)
+		}
+
+		for _, p := range posts {
+			if err := writePostMarkdown(zw, p, categoryNames, tagsByPost[p.ID]); err != nil {
+				return err
+			}
+		}
+
+		manifest.Posts += int64(len(posts))
+		if len(posts) < batchSize {
+			return nil
+		}
+		lastID = posts[len(posts)-1].ID
+	}
+}
+
+// writePostMarkdown 写入单篇文章的 Markdown 文件，文件名为别名，无别名时使用文章 ID
+func writePostMarkdown(zw *zip.Writer, p *post.Post, categoryNames map[int64]string, tags []string) error {
+	created := p.GmtCreated
+	if p.PublishAt != nil {
+		created = *p.PublishAt
+	}
+
+	meta := postFrontMatter{
+		Title:       p.Title,
+		Slug:        p.Slug,
+		Date:        time.Unix(created, 0).UTC().Format(time.RFC3339),
+		Updated:     time.Unix(p.GmtModified, 0).UTC().Format(time.RFC3339),
+		Status:      p.Status,
+		Description: p.Description,
+		Image:       p.Image,
+		Tags:        tags,
+	}
+	if p.CategoryID != nil {
+		if name, ok := categoryNames[*p.CategoryID]; ok {
+			meta.Categories = []string{name}
+		}
+	}
+
+	header, err := yaml.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("failed to encode front matter of post %d: %w", p.ID, err)
+	}
+
+	name := p.Slug
+	if name == "" {
+		name = strconv.FormatInt(p.ID, 10)
+	}
+	f, err := zw.Create(postsDir + name + ".md")
+	if err != nil {
+		return fmt.Errorf("failed to create archive entry for post %d: %w", p.ID, err)
+	}
+	if _, err := fmt.Fprintf(f, "---\n%s---\n\n%s", header, p.Markdown); err != nil {
+		return fmt.Errorf("failed to write post %d: %w", p.ID, err)
+	}
+	return nil
+}
+
+// exportMedia 导出未删除媒体记录引用的原文件与已生成的变体，同一存储键仅导出一次
+// 存储中无法读取的文件记录在清单中，不中断导出
+func exportMedia(ctx context.Context, db *gorm.DB, store storage.Storage, zw *zip.Writer, manifest *Manifest) error {
+	seen := make(map[string]bool)
+	var lastID int64
+	for {
+		var items []*mediaModel.Media
+		if err := db.Model(&mediaModel.Media{}).Where("deleted = ? AND id > ?", false, lastID).Order("id").Limit(batchSize).Find(&items).Error; err != nil {
+			return fmt.Errorf("failed to query media: %w", err)
+		}
+
+		for _, item := range items {
+			keys := []string{item.StorageKey}
+			if item.Variants != "" {
+				var variants []mediautils.Variant
+				if err := json.Unmarshal([]byte(item.Variants), &variants); err == nil {
+					for _, v := range variants {
+						if v.Size > 0 {
+							keys = append(keys, v.Key)
+						}
+					}
+				}
+			}
+
+			for _, key := range keys {
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				found, err := exportMediaFile(ctx, store, zw, key)
+				if err != nil {
+					return err
+				}
+				if !found {
+					manifest.MissingMedia = append(manifest.MissingMedia, key)
+					continue
+				}
+				manifest.Media++
+			}
+		}
+
+		if len(items) < batchSize {
+			return nil
+		}
+		lastID = items[len(items)-1].ID
+	}
+}
+
+// exportMediaFile 将存储中的单个文件原样（不再压缩）写入归档，文件无法读取时返回 false
+func exportMediaFile(ctx context.Context, store storage.Storage, zw *zip.Writer, key string) (bool, error) {
+	reader, err := store.Get(ctx, key)
+	if err != nil {
+		return false, nil
+	}
+	defer reader.Close()
+
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: mediaDir + key, Method: zip.Store, Modified: time.Now()})
+	if err != nil {
+		return false, fmt.Errorf("failed to create archive entry for media '%s': %w", key, err)
+	}
+	if _, err := io.Copy(f, reader); err != nil {
+		return false, fmt.Errorf("failed to write media '%s': %w", key, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,292 @@
+// Package backup 提供站点整体导出与恢复功能，归档与数据库方言无关，可跨方言迁移
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package backup
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/Done-0/jank/internal/model"
+	mediaModel "github.com/Done-0/jank/internal/model/media"
+	"github.com/Done-0/jank/internal/model/rbac"
+	"github.com/Done-0/jank/internal/model/user"
+	"github.com/Done-0/jank/internal/storage"
+	mediautils "github.com/Done-0/jank/internal/utils/media"
+)
+
+// RestoreOptions 恢复选项
+type RestoreOptions struct {
+	PasswordHash string // 归档未包含密码哈希时为用户设置的密码哈希（bcrypt），为空时这些用户无法登录
+}
+
+// RestoreResult 恢复结果
+type RestoreResult struct {
+	Manifest       *Manifest        // 归档清单
+	Tables         map[string]int64 // 各数据表写入的记录数
+	Media          int64            // 写入存储的媒体文件数
+	MissingMedia   []string         // 归档中缺失的媒体文件键
+	FailedMedia    []string         // 写入存储失败的媒体文件键，可从归档的 media/<存储键> 手动复制到存储
+	ResetPasswords int64            // 使用 PasswordHash 作为密码的用户数
+}
+
+// mediaFile 待写入存储的媒体文件
+type mediaFile struct {
+	file     *zip.File // 归档中的文件
+	key      string    // 存储键
+	mimeType string    // MIME 类型
+}
+
+// ErrNotEmpty 目标实例已有内容
+var ErrNotEmpty = errors.New("target instance is not empty")
+
+// Restore 将归档恢复到空实例，所有数据表在同一事务中写入，原有的初始化数据（管理员账号、默认 RBAC 策略）将被替换
+// 记录按原样写入（跳过模型钩子），雪花 ID 与时间戳保持不变；媒体记录改为指向当前存储，
+// 媒体文件在事务提交后写入存储，恢复失败时不会在存储中留下文件
+// 参数：
+//   - ctx: 上下文
+//   - db: 数据库连接
+//   - store: 媒体存储，为 nil 时不恢复媒体文件
+//   - r: 归档内容
+//   - size: 归档大小
+//   - opts: 恢复选项
+//
+// 返回值：
+//   - *RestoreResult: 恢复结果，单个媒体文件写入失败记录在 FailedMedia 中
+//   - error: 归档无效、目标实例非空或写入数据库失败时返回错误，数据库与存储不做任何修改
+func Restore(ctx context.Context, db *gorm.DB, store storage.Storage, r io.ReaderAt, size int64, opts RestoreOptions) (*RestoreResult, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+
+	manifest, err := readManifest(files)
+	if err != nil {
+		return nil, err
+	}
+
+	db = db.WithContext(ctx)
+	if err := checkEmpty(db); err != nil {
+		return nil, err
+	}
+
+	result := &RestoreResult{Manifest: manifest, Tables: make(map[string]int64)}
+	restored := make(map[string]bool)
+	var pending []mediaFile
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for _, m := range model.GetAllModels() {
+			sch, err := parseSchema(tx, m)
+			if err != nil {
+				return err
+			}
+			name := sch.Table
+
+			// 清除初始化时写入的管理员账号与默认策略
+			if err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(m).Error; err != nil {
+				return fmt.Errorf("failed to clear table '%s': %w", name, err)
+			}
+
+			f, ok := files[dataDir+name+".jsonl"]
+			if !ok {
+				continue // 归档早于该数据表的引入
+			}
+
+			count, err := restoreTable(tx, f, m, sch, func(row any) error {
+				switch row := row.(type) {
+				case *user.User:
+					if row.Password == "" && opts.PasswordHash != "" {
+						row.Password = opts.PasswordHash
+						result.ResetPasswords++
+					}
+				case *mediaModel.Media:
+					if store != nil {
+						pending = append(pending, restoreMedia(store, files, row, restored, result)...)
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("failed to restore table '%s': %w", name, err)
+			}
+			result.Tables[name] = count
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range pending {
+		if err := putFile(ctx, store, m.file, m.key, m.mimeType); err != nil {
+			result.FailedMedia = append(result.FailedMedia, m.key)
+			continue
+		}
+		result.Media++
+	}
+
+	return result, nil
+}
+
+// readManifest 读取并校验归档清单
+func readManifest(files map[string]*zip.File) (*Manifest, error) {
+	f, ok := files[manifestFile]
+	if !ok {
+		return nil, fmt.Errorf("not a backup archive: %s is missing", manifestFile)
+	}
+	reader, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open manifest: %w", err)
+	}
+	defer reader.Close()
+
+	var manifest Manifest
+	if err := json.NewDecoder(reader).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	if manifest.Version < 1 || manifest.Version > ArchiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %d, this build supports up to %d", manifest.Version, ArchiveVersion)
+	}
+	return &manifest, nil
+}
+
+// checkEmpty 检查目标实例是否为空：除初始化写入的一个管理员账号与 RBAC 策略外不得有任何记录（含逻辑删除的记录）
+func checkEmpty(db *gorm.DB) error {
+	for _, m := range model.GetAllModels() {
+		allowed := int64(0)
+		switch m.(type) {
+		case *rbac.Policy:
+			continue
+		case *user.User:
+			allowed = 1
+		}
+
+		var count int64
+		if err := db.Model(m).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to count records of %T: %w", m, err)
+		}
+		if count > allowed {
+			sch, _ := parseSchema(db, m)
+			return fmt.Errorf("%w: table '%s' has %d records", ErrNotEmpty, sch.Table, count)
+		}
+	}
+	return nil
+}
+
+// restoreTable 逐行解码数据表文件并分批写入，prepare 在写入前处理每条记录
+// 记录转换为按列名的映射后写入，避免 GORM 将零值（如 false、0）替换为字段默认值
+func restoreTable(tx *gorm.DB, f *zip.File, m any, sch *schema.Schema, prepare func(row any) error) (int64, error) {
+	reader, err := f.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	ctx := tx.Statement.Context
+	decoder := json.NewDecoder(reader)
+	elemType := reflect.TypeOf(m).Elem()
+	insert := tx.Session(&gorm.Session{SkipHooks: true})
+
+	var count int64
+	batch := make([]map[string]any, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		count += int64(len(batch))
+		if err := insert.Model(m).Create(&batch).Error; err != nil {
+			return err
+		}
+		batch = make([]map[string]any, 0, batchSize)
+		return nil
+	}
+
+	for {
+		row := reflect.New(elemType)
+		if err := decoder.Decode(row.Interface()); err == io.EOF {
+			break
+		} else if err != nil {
+			return 0, fmt.Errorf("failed to decode record: %w", err)
+		}
+		if err := prepare(row.Interface()); err != nil {
+			return 0, err
+		}
+
+		values := make(map[string]any, len(sch.DBNames))
+		for _, name := range sch.DBNames {
+			values[name], _ = sch.FieldsByDBName[name].ValueOf(ctx, row.Elem())
+		}
+
+		batch = append(batch, values)
+		if len(batch) >= batchSize {
+			if err := flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// restoreMedia 将媒体记录改为指向当前存储，并返回记录引用的原文件与变体中待写入存储的文件
+func restoreMedia(store storage.Storage, files map[string]*zip.File, item *mediaModel.Media, restored map[string]bool, result *RestoreResult) []mediaFile {
+	type entry struct{ key, mimeType string }
+	entries := []entry{{item.StorageKey, item.MimeType}}
+	if item.Variants != "" {
+		var variants []mediautils.Variant
+		if err := json.Unmarshal([]byte(item.Variants), &variants); err == nil {
+			for _, v := range variants {
+				if v.Size > 0 {
+					entries = append(entries, entry{v.Key, v.MimeType})
+				}
+			}
+		}
+	}
+
+	var pending []mediaFile
+	for _, e := range entries {
+		if restored[e.key] {
+			continue
+		}
+
+		// 已删除记录的文件未被导出，不视为缺失
+		f, ok := files[mediaDir+e.key]
+		if !ok {
+			if !item.Deleted {
+				restored[e.key] = true
+				result.MissingMedia = append(result.MissingMedia, e.key)
+			}
+			continue
+		}
+		restored[e.key] = true
+		pending = append(pending, mediaFile{file: f, key: e.key, mimeType: e.mimeType})
+	}
+
+	item.Storage = store.Driver()
+	item.URL = store.URL(item.StorageKey)
+	return pending
+}
+
+// putFile 将归档中的单个文件写入存储
+func putFile(ctx context.Context, store storage.Storage, f *zip.File, key, mimeType string) error {
+	reader, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	return store.Put(ctx, key, reader, int64(f.UncompressedSize64), mimeType)
+}
@@ -67,6 +67,8 @@ func New(config *configs.Config) {
 	}
 
 	InitAdminUser(config)
+	InitPostSlugs()
+	InitPostSearchIndex()
 }
 
 // Close 关闭数据库连接
@@ -126,7 +128,8 @@ func connectToDB(config *configs.Config, dbName string) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to get database dialector: %v", err)
 	}
 
-	db, err := gorm.Open(dialector, &gorm.Config{})
+	// 开启方言错误转换，使唯一约束冲突等错误统一为 gorm.ErrDuplicatedKey 等通用错误
+	db, err := gorm.Open(dialector, &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %v", err)
 	}
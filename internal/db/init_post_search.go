@@ -0,0 +1,51 @@
+// Package db 提供文章检索索引补全功能
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package db
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/utils/markdown"
+	"github.com/Done-0/jank/internal/utils/search"
+)
+
+// InitPostSearchIndex 为尚未建立检索索引的文章补建检索词项
+// 检索功能上线前创建的文章没有检索词项，启动时按批次统一建立
+func InitPostSearchIndex() {
+	indexed := global.DB.Model(&post.SearchTerm{}).Distinct("post_id")
+
+	var posts []*post.Post
+	count := 0
+	result := global.DB.Select("id", "title", "description", "markdown").
+		Where("deleted = ? AND id NOT IN (?)", false, indexed).
+		FindInBatches(&posts, 100, func(tx *gorm.DB, batch int) error {
+			for _, p := range posts {
+				weights := search.BuildTermWeights(p.Title, p.Description, markdown.ExtractText([]byte(p.Markdown)))
+				if len(weights) == 0 {
+					continue
+				}
+
+				terms := make([]*post.SearchTerm, 0, len(weights))
+				for term, weight := range weights {
+					terms = append(terms, &post.SearchTerm{PostID: p.ID, Term: term, Weight: int64(weight)})
+				}
+				if err := global.DB.CreateInBatches(terms, 500).Error; err != nil {
+					global.SysLog.Errorf("Failed to index post %d: %v", p.ID, err)
+					continue
+				}
+				count++
+			}
+			return nil
+		})
+	if result.Error != nil {
+		global.SysLog.Errorf("Failed to build post search index: %v", result.Error)
+		return
+	}
+
+	if count > 0 {
+		global.SysLog.Infof("Post search index initialized for %d posts", count)
+	}
+}
@@ -0,0 +1,59 @@
+// Package db 提供历史文章别名补全功能
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package db
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/utils/slug"
+)
+
+// InitPostSlugs 为缺少别名的文章补全别名
+// 别名功能上线前创建的文章没有别名，启动时根据标题统一生成，冲突时追加文章 ID
+func InitPostSlugs() {
+	var posts []*post.Post
+	if err := global.DB.Select("id", "title").Where("slug = ? OR slug IS NULL", "").Find(&posts).Error; err != nil {
+		global.SysLog.Errorf("Failed to list posts without slug: %v", err)
+		return
+	}
+
+	if len(posts) == 0 {
+		return
+	}
+
+	var existing []string
+	if err := global.DB.Model(&post.Post{}).Where("slug <> ?", "").Pluck("slug", &existing).Error; err != nil {
+		global.SysLog.Errorf("Failed to list existing post slugs: %v", err)
+		return
+	}
+	var history []string
+	if err := global.DB.Model(&post.SlugHistory{}).Pluck("slug", &history).Error; err != nil {
+		global.SysLog.Errorf("Failed to list post slug history: %v", err)
+		return
+	}
+
+	taken := make(map[string]bool, len(existing)+len(history))
+	for _, s := range append(existing, history...) {
+		taken[s] = true
+	}
+
+	for _, p := range posts {
+		s := slug.Generate(p.Title)
+		if s == "" || taken[s] {
+			suffix := "-" + strconv.FormatInt(p.ID, 10)
+			s = strings.TrimLeft(strings.TrimRight(s[:min(len(s), slug.MaxLength-len(suffix))], "-")+suffix, "-")
+		}
+		taken[s] = true
+
+		if err := global.DB.Model(&post.Post{}).Where("id = ?", p.ID).Update("slug", s).Error; err != nil {
+			global.SysLog.Errorf("Failed to set slug for post %d: %v", p.ID, err)
+			continue
+		}
+	}
+
+	global.SysLog.Infof("Post slugs initialized for %d posts", len(posts))
+}
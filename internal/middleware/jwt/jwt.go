@@ -87,3 +87,16 @@ func New() app.HandlerFunc {
 
 	return authMiddleware.MiddlewareFunc()
 }
+
+// NewOptional 创建可选的 JWT 认证中间件
+// 未携带 Authorization 头部时直接放行（匿名访问），携带时按 New 的规则校验并注入用户 ID
+func NewOptional() app.HandlerFunc {
+	authHandler := New()
+	return func(ctx context.Context, c *app.RequestContext) {
+		if len(c.GetHeader(constants.HeaderAuthorization)) == 0 {
+			c.Next(ctx)
+			return
+		}
+		authHandler(ctx, c)
+	}
+}
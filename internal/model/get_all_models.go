@@ -5,8 +5,13 @@ package model
 
 import (
 	"github.com/Done-0/jank/internal/model/category"
+	"github.com/Done-0/jank/internal/model/comment"
+	"github.com/Done-0/jank/internal/model/media"
 	"github.com/Done-0/jank/internal/model/post"
 	"github.com/Done-0/jank/internal/model/rbac"
+	"github.com/Done-0/jank/internal/model/reaction"
+	"github.com/Done-0/jank/internal/model/series"
+	"github.com/Done-0/jank/internal/model/tag"
 	"github.com/Done-0/jank/internal/model/user"
 )
 
@@ -19,6 +24,17 @@ func GetAllModels() []any {
 		&user.User{},         // 用户模型
 		&rbac.Policy{},       // RBAC策略模型
 		&post.Post{},         // 文章模型
+		&post.SlugHistory{},  // 文章历史别名模型
+		&post.Revision{},     // 文章修订模型
+		&post.SearchTerm{},   // 文章检索词项模型
+		&post.DailyView{},    // 文章每日浏览量模型
 		&category.Category{}, // 分类模型
+		&comment.Comment{},   // 评论模型
+		&tag.Tag{},           // 标签模型
+		&tag.PostTag{},       // 文章-标签关联模型
+		&media.Media{},       // 媒体文件模型
+		&series.Series{},     // 文章系列模型
+		&series.SeriesPost{}, // 系列-文章关联模型
+		&reaction.Reaction{}, // 文章表态模型
 	}
 }
@@ -0,0 +1,31 @@
+// Package media 提供媒体文件数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package media
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// Media 媒体文件模型，同一内容的文件在存储中仅保留一份
+type Media struct {
+	base.Base
+	OwnerID    int64  `gorm:"type:bigint;not null;index" json:"owner_id"`          // 上传者用户 ID
+	FileName   string `gorm:"type:varchar(255)" json:"file_name"`                  // 原始文件名
+	Storage    string `gorm:"type:varchar(20);not null" json:"storage"`            // 存储驱动名称
+	StorageKey string `gorm:"type:varchar(255);not null;index" json:"storage_key"` // 存储中的文件键
+	URL        string `gorm:"type:varchar(512);not null" json:"url"`               // 访问地址
+	MimeType   string `gorm:"type:varchar(100);not null;index" json:"mime_type"`   // MIME 类型（按文件内容识别）
+	Size       int64  `gorm:"type:bigint;not null" json:"size"`                    // 文件大小（字节）
+	Hash       string `gorm:"type:varchar(64);not null;index" json:"hash"`         // 文件内容 SHA-256 哈希
+	Width      int    `gorm:"type:int;default:0" json:"width"`                     // 图片宽度（像素），非图片为 0
+	Height     int    `gorm:"type:int;default:0" json:"height"`                    // 图片高度（像素），非图片为 0
+	Variants   string `gorm:"type:text" json:"variants"`                           // 图片尺寸变体（JSON），非图片或不生成变体时为空
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (Media) TableName() string {
+	return "media"
+}
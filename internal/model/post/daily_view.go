@@ -0,0 +1,23 @@
+// Package post 提供文章每日浏览量数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package post
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// DailyView 文章每日浏览量模型，用于统计近期热门文章
+type DailyView struct {
+	base.Base
+	PostID int64 `gorm:"type:bigint;not null;uniqueIndex:idx_post_daily_views_post_day" json:"post_id"`   // 文章 ID
+	Day    int64 `gorm:"type:bigint;not null;uniqueIndex:idx_post_daily_views_post_day;index" json:"day"` // 统计日期（当日零点的 Unix 时间戳）
+	Views  int64 `gorm:"type:bigint;not null;default:0" json:"views"`                                     // 当日浏览量（按访客去重）
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (DailyView) TableName() string {
+	return "post_daily_views"
+}
@@ -11,12 +11,32 @@ import (
 type Post struct {
 	base.Base
 	Title       string `gorm:"type:varchar(255);not null;index" json:"title"`                 // 标题
+	Slug        string `gorm:"type:varchar(255);uniqueIndex:idx_posts_slug_uniq" json:"slug"` // URL 别名，全局唯一
 	Description string `gorm:"type:varchar(500)" json:"description"`                          // 文章描述/摘要（可选）
 	Image       string `gorm:"type:varchar(255)" json:"image"`                                // 图片
 	Status      string `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"` // 文章状态
 	CategoryID  *int64 `gorm:"type:bigint;index" json:"category_id"`                          // 分类 ID，NULL表示未分类
+	AuthorID    *int64 `gorm:"type:bigint;index" json:"author_id"`                            // 作者用户 ID，NULL 表示作者未知（作者字段上线前创建的文章）
+	PublishAt   *int64 `gorm:"type:bigint;index" json:"publish_at"`                           // 发布时间（Unix 秒），定时发布文章到达该时间后自动发布
 	Markdown    string `gorm:"type:text" json:"Markdown"`                                     // Markdown 内容
 	HTML        string `gorm:"type:text" json:"Html"`                                         // 渲染后的 HTML 内容
+	TOC         string `gorm:"type:text" json:"toc"`                                          // 目录（JSON），由渲染时提取的标题结构生成
+	WordCount   int    `gorm:"type:int;default:0" json:"word_count"`                          // 字数，中日韩文字按字计、其他文字按词计
+	ReadingTime int    `gorm:"type:int;default:0" json:"reading_time"`                        // 预计阅读时长（分钟）
+
+	// SEO 相关，为空时使用文章对应字段
+	MetaTitle       string `gorm:"type:varchar(255)" json:"meta_title"`        // SEO 标题，为空时使用文章标题
+	MetaDescription string `gorm:"type:varchar(500)" json:"meta_description"`  // SEO 描述，为空时使用文章描述
+	CanonicalURL    string `gorm:"type:varchar(500)" json:"canonical_url"`     // 规范链接，为空时使用文章页面地址
+	NoIndex         bool   `gorm:"type:boolean;default:false" json:"no_index"` // 是否禁止搜索引擎收录，禁止时不列入站点地图
+	OGImage         string `gorm:"type:varchar(255)" json:"og_image"`          // 分享卡片图片，为空时使用封面图片
+
+	// 置顶、推荐与浏览量
+	PinScope    string `gorm:"type:varchar(20);not null;default:'';index" json:"pin_scope"` // 置顶范围，为空表示未置顶
+	PinOrder    int64  `gorm:"type:bigint;not null;default:0" json:"pin_order"`             // 置顶排序权重，数字越大越靠前
+	PinExpireAt *int64 `gorm:"type:bigint" json:"pin_expire_at"`                            // 置顶到期时间（Unix 秒），NULL 表示永久置顶
+	Featured    bool   `gorm:"type:boolean;not null;default:false;index" json:"featured"`   // 是否推荐
+	ViewCount   int64  `gorm:"type:bigint;not null;default:0;index" json:"view_count"`      // 浏览量
 }
 
 // TableName 指定表名
@@ -0,0 +1,25 @@
+// Package post 提供文章修订历史数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package post
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// Revision 文章修订模型，每次创建或更新文章时记录一份完整内容快照
+type Revision struct {
+	base.Base
+	PostID   int64  `gorm:"type:bigint;not null;index" json:"post_id"` // 文章 ID
+	Version  int64  `gorm:"type:bigint;not null" json:"version"`       // 修订版本号，同一文章内从 1 递增
+	Title    string `gorm:"type:varchar(255);not null" json:"title"`   // 标题快照
+	Markdown string `gorm:"type:text" json:"markdown"`                 // Markdown 内容快照
+	EditorID *int64 `gorm:"type:bigint;index" json:"editor_id"`        // 修改者用户 ID，NULL 表示系统操作
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (Revision) TableName() string {
+	return "post_revisions"
+}
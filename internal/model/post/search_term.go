@@ -0,0 +1,23 @@
+// Package post 提供文章全文检索索引数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package post
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// SearchTerm 文章检索词项模型（倒排索引），保存文章中每个词项的加权词频
+type SearchTerm struct {
+	base.Base
+	PostID int64  `gorm:"type:bigint;not null;index" json:"post_id"`   // 文章 ID
+	Term   string `gorm:"type:varchar(64);not null;index" json:"term"` // 词项
+	Weight int64  `gorm:"type:bigint;not null" json:"weight"`          // 加权词频（标题、描述、正文按不同权重累加）
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (SearchTerm) TableName() string {
+	return "post_search_terms"
+}
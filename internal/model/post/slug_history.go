@@ -0,0 +1,22 @@
+// Package post 提供文章别名历史数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package post
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// SlugHistory 文章历史别名模型，别名变更后保留旧别名用于 301 重定向
+type SlugHistory struct {
+	base.Base
+	PostID int64  `gorm:"type:bigint;not null;index" json:"post_id"`    // 文章 ID
+	Slug   string `gorm:"type:varchar(255);not null;index" json:"slug"` // 历史别名
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (SlugHistory) TableName() string {
+	return "post_slug_histories"
+}
@@ -0,0 +1,23 @@
+// Package reaction 提供文章表态数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package reaction
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// Reaction 文章表态模型，同一表态者对同一文章的每种表态类型仅保留一条记录
+type Reaction struct {
+	base.Base
+	PostID  int64  `gorm:"type:bigint;not null;uniqueIndex:idx_reactions_post_type_reactor" json:"post_id"`      // 文章 ID
+	Type    string `gorm:"type:varchar(32);not null;uniqueIndex:idx_reactions_post_type_reactor" json:"type"`    // 表态类型
+	Reactor string `gorm:"type:varchar(80);not null;uniqueIndex:idx_reactions_post_type_reactor" json:"reactor"` // 表态者标识：登录用户为 user:{userID}，匿名访客为 anon:{hash}
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (Reaction) TableName() string {
+	return "reactions"
+}
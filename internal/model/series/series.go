@@ -0,0 +1,37 @@
+// Package series 提供文章系列数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package series
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// Series 文章系列模型，用于将多篇文章按顺序组织为连载或教程
+type Series struct {
+	base.Base
+	Name        string `gorm:"type:varchar(100);not null;index" json:"name"` // 系列名称
+	Description string `gorm:"type:varchar(500)" json:"description"`         // 系列描述（可选）
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (Series) TableName() string {
+	return "series"
+}
+
+// SeriesPost 系列-文章关联模型，一篇文章最多属于一个系列
+type SeriesPost struct {
+	base.Base
+	SeriesID int64 `gorm:"type:bigint;not null;index" json:"series_id"`    // 系列 ID
+	PostID   int64 `gorm:"type:bigint;not null;index" json:"post_id"`      // 文章 ID
+	Position int64 `gorm:"type:bigint;not null;default:0" json:"position"` // 文章在系列中的顺序，从 1 开始
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (SeriesPost) TableName() string {
+	return "series_posts"
+}
@@ -0,0 +1,36 @@
+// Package tag 提供标签数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package tag
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// Tag 标签模型
+type Tag struct {
+	base.Base
+	Name        string `gorm:"type:varchar(64);not null;uniqueIndex:idx_tags_name_uniq" json:"name"` // 标签名称（唯一）
+	Description string `gorm:"type:varchar(500)" json:"description"`                                 // 标签描述（可选）
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// PostTag 文章-标签关联模型
+type PostTag struct {
+	base.Base
+	PostID int64 `gorm:"type:bigint;not null;index" json:"post_id"` // 文章 ID
+	TagID  int64 `gorm:"type:bigint;not null;index" json:"tag_id"`  // 标签 ID
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (PostTag) TableName() string {
+	return "post_tags"
+}
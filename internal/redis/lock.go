@@ -0,0 +1,102 @@
+// Package redis 提供基于 Redis 的分布式锁
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Done-0/jank/internal/global"
+)
+
+// unlockScript 仅当锁仍由当前持有者持有时才删除，避免误删其他实例的锁
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// renewScript 仅当锁仍由当前持有者持有时才延长过期时间
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// TryLock 尝试获取分布式锁
+// 参数：
+//   - ctx: 上下文
+//   - key: 锁键
+//   - ttl: 锁过期时间，持有者异常退出时锁将在过期后自动释放
+//
+// 返回值：
+//   - string: 锁令牌，释放锁时使用；未获取到锁时为空
+//   - bool: 是否获取成功
+//   - error: 操作过程中的错误
+func TryLock(ctx context.Context, key string, ttl time.Duration) (string, bool, error) {
+	if global.RedisClient == nil {
+		return "", false, errors.New("redis client not initialized")
+	}
+
+	token := uuid.NewString()
+	ok, err := global.RedisClient.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to acquire lock '%s': %w", key, err)
+	}
+	if !ok {
+		return "", false, nil
+	}
+
+	return token, true, nil
+}
+
+// Renew 延长分布式锁的过期时间，用于执行时间可能超过锁过期时间的任务
+// 参数：
+//   - ctx: 上下文
+//   - key: 锁键
+//   - token: 获取锁时返回的令牌
+//   - ttl: 新的锁过期时间
+//
+// 返回值：
+//   - bool: 是否续期成功，锁已过期或被其他实例持有时为 false
+//   - error: 操作过程中的错误
+func Renew(ctx context.Context, key, token string, ttl time.Duration) (bool, error) {
+	if global.RedisClient == nil {
+		return false, errors.New("redis client not initialized")
+	}
+
+	n, err := renewScript.Run(ctx, global.RedisClient, []string{key}, token, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, fmt.Errorf("failed to renew lock '%s': %w", key, err)
+	}
+
+	return n == 1, nil
+}
+
+// Unlock 释放分布式锁
+// 参数：
+//   - ctx: 上下文
+//   - key: 锁键
+//   - token: 获取锁时返回的令牌
+//
+// 返回值：
+//   - error: 操作过程中的错误
+func Unlock(ctx context.Context, key, token string) error {
+	if global.RedisClient == nil {
+		return errors.New("redis client not initialized")
+	}
+
+	if err := unlockScript.Run(ctx, global.RedisClient, []string{key}, token).Err(); err != nil {
+		return fmt.Errorf("failed to release lock '%s': %w", key, err)
+	}
+
+	return nil
+}
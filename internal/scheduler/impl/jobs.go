@@ -0,0 +1,134 @@
+// Package impl 提供内置后台任务实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/pageview"
+)
+
+// PublishScheduledPosts 将发布时间已到的定时文章更新为已发布状态
+// 参数：
+//   - ctx: 上下文
+//
+// 返回值：
+//   - error: 操作过程中的错误
+func PublishScheduledPosts(ctx context.Context) error {
+	now := time.Now().Unix()
+	result := global.DB.WithContext(ctx).Model(&post.Post{}).
+		Where("status = ? AND publish_at <= ? AND deleted = ?", consts.PostStatusScheduled, now, false).
+		Updates(map[string]any{
+			"status":       consts.PostStatusPublished,
+			"gmt_modified": now,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to publish scheduled posts: %w", result.Error)
+	}
+
+	if result.RowsAffected > 0 {
+		global.SysLog.Infof("Published %d scheduled posts", result.RowsAffected)
+	}
+
+	return nil
+}
+
+// FlushPostViews 将 Redis 中缓冲的文章浏览量写入数据库（文章总浏览量与每日浏览量）
+// 写入前将待写入计数整体转移到处理中的键，每写入一项即删除该项；上次写入中断时优先处理剩余计数
+// 参数：
+//   - ctx: 上下文
+//
+// 返回值：
+//   - error: 操作过程中的错误
+func FlushPostViews(ctx context.Context) error {
+	if global.RedisClient == nil {
+		return nil
+	}
+
+	flushing, err := global.RedisClient.Exists(ctx, consts.PostViewFlushingKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to check flushing post views: %w", err)
+	}
+	if flushing == 0 {
+		pending, err := global.RedisClient.Exists(ctx, consts.PostViewPendingKey).Result()
+		if err != nil {
+			return fmt.Errorf("failed to check pending post views: %w", err)
+		}
+		if pending == 0 {
+			return nil
+		}
+		if err := global.RedisClient.Rename(ctx, consts.PostViewPendingKey, consts.PostViewFlushingKey).Err(); err != nil {
+			return fmt.Errorf("failed to move pending post views: %w", err)
+		}
+	}
+
+	counts, err := global.RedisClient.HGetAll(ctx, consts.PostViewFlushingKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get flushing post views: %w", err)
+	}
+
+	var total int64
+	for field, value := range counts {
+		postID, day, err := pageview.ParseField(field)
+		if err != nil {
+			global.SysLog.Warnf("Skipping malformed post view count: %v", err)
+		} else if views, err := strconv.ParseInt(value, 10, 64); err != nil {
+			global.SysLog.Warnf("Skipping malformed post view count '%s' of field '%s'", value, field)
+		} else if views > 0 {
+			if err := addPostViews(global.DB.WithContext(ctx), postID, day, views); err != nil {
+				return fmt.Errorf("failed to flush views of post %d: %w", postID, err)
+			}
+			total += views
+		}
+
+		if err := global.RedisClient.HDel(ctx, consts.PostViewFlushingKey, field).Err(); err != nil {
+			return fmt.Errorf("failed to remove flushed post view count '%s': %w", field, err)
+		}
+	}
+
+	if total > 0 {
+		global.SysLog.Infof("Flushed %d post views", total)
+	}
+
+	return nil
+}
+
+// addPostViews 累加文章总浏览量与指定日期的浏览量，不修改文章更新时间
+// 参数：
+//   - db: 数据库连接
+//   - postID: 文章 ID
+//   - day: 统计日期（当日零点的 Unix 时间戳）
+//   - views: 新增浏览量
+//
+// 返回值：
+//   - error: 操作过程中的错误
+func addPostViews(db *gorm.DB, postID, day, views int64) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&post.Post{}).Where("id = ? AND deleted = ?", postID, false).
+			UpdateColumn("view_count", gorm.Expr("view_count + ?", views)).Error; err != nil {
+			return fmt.Errorf("failed to update view count: %w", err)
+		}
+
+		daily := &post.DailyView{PostID: postID, Day: day, Views: views}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "post_id"}, {Name: "day"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"views":        gorm.Expr("post_daily_views.views + ?", views),
+				"gmt_modified": time.Now().Unix(),
+			}),
+		}).Create(daily).Error; err != nil {
+			return fmt.Errorf("failed to update daily views: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,150 @@
+// Package impl 提供后台任务调度实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/redis"
+	"github.com/Done-0/jank/internal/types/consts"
+)
+
+// minLockTTL 任务锁的最短过期时间，执行间隔较短的任务也保留足够的执行时间余量
+const minLockTTL = time.Minute
+
+// Job 后台任务定义
+type Job struct {
+	Name     string                          // 任务名称，同时作为分布式锁标识
+	Interval time.Duration                   // 执行间隔
+	Run      func(ctx context.Context) error // 任务执行函数，需保证幂等
+}
+
+// Scheduler 后台任务调度器
+// 多实例部署时，每次执行前通过 Redis 锁保证同一任务同一时刻仅由一个实例执行，
+// 任务执行期间持续为锁续期，锁仍被持有时跳过本次执行；
+// Redis 不可用时退化为单实例模式直接执行
+type Scheduler struct {
+	jobs   []*Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewScheduler 创建调度器实例
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Register 注册后台任务
+func (s *Scheduler) Register(job *Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start 启动所有已注册任务
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+}
+
+// Shutdown 停止所有任务并等待正在执行的任务结束
+func (s *Scheduler) Shutdown() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	global.SysLog.Info("Scheduler stopped")
+}
+
+// loop 按间隔循环执行任务
+func (s *Scheduler) loop(ctx context.Context, job *Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.execute(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// execute 获取分布式锁后执行一次任务
+func (s *Scheduler) execute(ctx context.Context, job *Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			global.SysLog.Errorf("Scheduler job '%s' panicked: %v", job.Name, r)
+		}
+	}()
+
+	if global.RedisClient != nil {
+		lockKey := fmt.Sprintf("%s:%s", consts.SchedulerLockKeyPrefix, job.Name)
+		lockTTL := max(2*job.Interval, minLockTTL)
+		token, ok, err := redis.TryLock(ctx, lockKey, lockTTL)
+		if err != nil {
+			global.SysLog.Errorf("Scheduler job '%s' failed to acquire lock: %v", job.Name, err)
+			return
+		}
+		if !ok {
+			global.SysLog.Debugf("Scheduler job '%s' is still held by another run, skipping", job.Name)
+			return
+		}
+
+		runCtx, cancel := context.WithCancel(ctx)
+		renewed := make(chan struct{})
+		go func() {
+			defer close(renewed)
+			s.renew(runCtx, cancel, job, lockKey, token, lockTTL)
+		}()
+		defer func() {
+			cancel()
+			<-renewed
+			if err := redis.Unlock(context.Background(), lockKey, token); err != nil {
+				global.SysLog.Warnf("Scheduler job '%s' failed to release lock: %v", job.Name, err)
+			}
+		}()
+		ctx = runCtx
+	}
+
+	if err := job.Run(ctx); err != nil {
+		global.SysLog.Errorf("Scheduler job '%s' failed: %v", job.Name, err)
+	}
+}
+
+// renew 任务执行期间按锁过期时间的三分之一间隔续期，锁丢失时取消任务上下文，避免与其他实例重复执行
+func (s *Scheduler) renew(ctx context.Context, cancel context.CancelFunc, job *Job, lockKey, token string, ttl time.Duration) {
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ok, err := redis.Renew(ctx, lockKey, token, ttl)
+		if err != nil {
+			global.SysLog.Warnf("Scheduler job '%s' failed to renew lock: %v", job.Name, err)
+			continue
+		}
+		if !ok {
+			global.SysLog.Errorf("Scheduler job '%s' lost its lock, cancelling run", job.Name)
+			cancel()
+			return
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Package scheduler 提供后台任务调度核心接口定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package scheduler
+
+import (
+	"time"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/scheduler/impl"
+)
+
+const (
+	defaultPublishInterval   = 30 * time.Second // 定时发布默认检查间隔
+	defaultViewFlushInterval = 60 * time.Second // 缓冲浏览量默认写入间隔
+)
+
+// Scheduler 后台任务调度器接口
+type Scheduler interface {
+	// Register 注册后台任务，需在 Start 之前调用
+	Register(job *impl.Job)
+	// Start 启动所有已注册任务
+	Start()
+	// Shutdown 停止所有任务并等待正在执行的任务结束
+	Shutdown()
+}
+
+// 全局调度器实例
+var GlobalScheduler Scheduler
+
+// New 初始化调度器并注册内置任务
+// 浏览量写入任务不受调度开关控制：文章浏览量始终缓冲在 Redis 中，需持续写入数据库
+func New(config *configs.Config) {
+	viewFlushInterval := time.Duration(config.ViewConfig.FlushIntervalSeconds) * time.Second
+	if viewFlushInterval <= 0 {
+		viewFlushInterval = defaultViewFlushInterval
+	}
+
+	GlobalScheduler = impl.NewScheduler()
+	GlobalScheduler.Register(&impl.Job{
+		Name:     "flush_post_views",
+		Interval: viewFlushInterval,
+		Run:      impl.FlushPostViews,
+	})
+
+	if config.SchedulerConfig.Enabled {
+		interval := time.Duration(config.SchedulerConfig.PublishIntervalSeconds) * time.Second
+		if interval <= 0 {
+			interval = defaultPublishInterval
+		}
+		GlobalScheduler.Register(&impl.Job{
+			Name:     "publish_scheduled_posts",
+			Interval: interval,
+			Run:      impl.PublishScheduledPosts,
+		})
+	} else {
+		global.SysLog.Info("Scheduler disabled, only post view flushing will run")
+	}
+
+	GlobalScheduler.Start()
+
+	global.SysLog.Info("Scheduler initialized")
+}
+
+// Shutdown 关闭调度器
+func Shutdown() {
+	if GlobalScheduler == nil {
+		return
+	}
+	GlobalScheduler.Shutdown()
+}
@@ -0,0 +1,147 @@
+// Package impl 提供媒体文件存储实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStorage 本地文件系统存储
+type LocalStorage struct {
+	driver    string
+	root      string
+	urlPrefix string
+}
+
+// NewLocalStorage 创建本地文件系统存储，根目录不存在时自动创建
+// 参数：
+//   - driver: 驱动名称
+//   - root: 文件存放根目录
+//   - urlPrefix: 文件访问路径前缀
+//
+// 返回值：
+//   - *LocalStorage: 本地存储实例
+//   - error: 创建过程中的错误
+func NewLocalStorage(driver, root, urlPrefix string) (*LocalStorage, error) {
+	if root == "" {
+		return nil, errors.New("local storage root is empty")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create storage root '%s': %w", root, err)
+	}
+
+	return &LocalStorage{
+		driver:    driver,
+		root:      root,
+		urlPrefix: "/" + strings.Trim(urlPrefix, "/"),
+	}, nil
+}
+
+// Driver 返回存储驱动名称
+func (s *LocalStorage) Driver() string {
+	return s.driver
+}
+
+// Put 写入文件，先写入临时文件再重命名，避免读取到写入一半的文件
+func (s *LocalStorage) Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error {
+	target, err := s.Path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for '%s': %w", key, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file for '%s': %w", key, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, reader); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write file '%s': %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close file '%s': %w", key, err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return fmt.Errorf("failed to move file '%s': %w", key, err)
+	}
+
+	return nil
+}
+
+// Get 读取文件
+func (s *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	target, err := s.Path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file '%s': %w", key, err)
+	}
+	return file, nil
+}
+
+// Exists 判断文件是否存在
+func (s *LocalStorage) Exists(ctx context.Context, key string) (bool, error) {
+	target, err := s.Path(key)
+	if err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(target); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to stat file '%s': %w", key, err)
+	}
+	return true, nil
+}
+
+// Delete 删除文件
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	target, err := s.Path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete file '%s': %w", key, err)
+	}
+	return nil
+}
+
+// URL 返回文件访问地址
+func (s *LocalStorage) URL(key string) string {
+	return path.Join(s.urlPrefix, key)
+}
+
+// URLPrefix 返回文件访问路径前缀，用于注册静态文件路由
+func (s *LocalStorage) URLPrefix() string {
+	return s.urlPrefix
+}
+
+// Path 将文件键转换为根目录下的文件路径，拒绝越出根目录的键
+// 参数：
+//   - key: 文件键
+//
+// 返回值：
+//   - string: 文件路径
+//   - error: 键非法时返回错误
+func (s *LocalStorage) Path(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" {
+		return "", fmt.Errorf("invalid storage key: %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(strings.TrimPrefix(cleaned, "/"))), nil
+}
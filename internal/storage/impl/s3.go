@@ -0,0 +1,146 @@
+// Package impl 提供媒体文件存储实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// s3InitTimeout 初始化时检查存储桶的超时时间
+const s3InitTimeout = 10 * time.Second
+
+// S3Options S3 兼容对象存储选项
+type S3Options struct {
+	Endpoint  string // 服务地址（不含协议）
+	AccessKey string // 访问密钥 ID
+	SecretKey string // 访问密钥
+	Bucket    string // 存储桶名称
+	Region    string // 区域
+	UseSSL    bool   // 是否使用 HTTPS
+	PublicURL string // 文件公开访问地址前缀，为空时使用 服务地址/存储桶
+}
+
+// S3Storage S3 兼容对象存储，适用于 AWS S3、MinIO 等
+type S3Storage struct {
+	driver    string
+	client    *minio.Client
+	bucket    string
+	publicURL string
+}
+
+// NewS3Storage 创建 S3 兼容对象存储，存储桶不存在时自动创建
+// 参数：
+//   - driver: 驱动名称
+//   - opts: 对象存储选项
+//
+// 返回值：
+//   - *S3Storage: 对象存储实例
+//   - error: 创建过程中的错误
+func NewS3Storage(driver string, opts S3Options) (*S3Storage, error) {
+	if opts.Endpoint == "" || opts.Bucket == "" {
+		return nil, errors.New("s3 storage endpoint and bucket are required")
+	}
+
+	client, err := minio.New(opts.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(opts.AccessKey, opts.SecretKey, ""),
+		Secure: opts.UseSSL,
+		Region: opts.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s3InitTimeout)
+	defer cancel()
+
+	exists, err := client.BucketExists(ctx, opts.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bucket '%s': %w", opts.Bucket, err)
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, opts.Bucket, minio.MakeBucketOptions{Region: opts.Region}); err != nil {
+			return nil, fmt.Errorf("failed to create bucket '%s': %w", opts.Bucket, err)
+		}
+	}
+
+	publicURL := strings.TrimRight(opts.PublicURL, "/")
+	if publicURL == "" {
+		scheme := "http"
+		if opts.UseSSL {
+			scheme = "https"
+		}
+		publicURL = fmt.Sprintf("%s://%s/%s", scheme, opts.Endpoint, opts.Bucket)
+	}
+
+	return &S3Storage{
+		driver:    driver,
+		client:    client,
+		bucket:    opts.Bucket,
+		publicURL: publicURL,
+	}, nil
+}
+
+// Driver 返回存储驱动名称
+func (s *S3Storage) Driver() string {
+	return s.driver
+}
+
+// Put 写入对象
+func (s *S3Storage) Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, reader, size, minio.PutObjectOptions{
+		ContentType:  contentType,
+		CacheControl: "public, max-age=31536000, immutable",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put object '%s': %w", key, err)
+	}
+	return nil
+}
+
+// Get 读取对象
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object '%s': %w", key, err)
+	}
+	// GetObject 延迟请求，通过 Stat 提前暴露对象不存在等错误
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		return nil, fmt.Errorf("failed to get object '%s': %w", key, err)
+	}
+	return object, nil
+}
+
+// Exists 判断对象是否存在
+func (s *S3Storage) Exists(ctx context.Context, key string) (bool, error) {
+	if _, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{}); err != nil {
+		if minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to stat object '%s': %w", key, err)
+	}
+	return true, nil
+}
+
+// Delete 删除对象
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	if err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to delete object '%s': %w", key, err)
+	}
+	return nil
+}
+
+// URL 返回对象公开访问地址
+func (s *S3Storage) URL(key string) string {
+	return s.publicURL + "/" + strings.TrimLeft(key, "/")
+}
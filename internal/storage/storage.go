@@ -0,0 +1,105 @@
+// Package storage 提供媒体文件存储核心接口定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/storage/impl"
+)
+
+// 存储驱动名称
+const (
+	DriverLocal = "local" // 本地文件系统
+	DriverS3    = "s3"    // 兼容 S3 协议的对象存储
+)
+
+// Storage 媒体文件存储接口
+type Storage interface {
+	// Driver 返回存储驱动名称，随媒体记录一并保存
+	Driver() string
+	// Put 写入文件，key 已存在时覆盖
+	Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error
+	// Get 读取文件，调用方负责关闭
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Exists 判断文件是否存在
+	Exists(ctx context.Context, key string) (bool, error)
+	// Delete 删除文件，文件不存在时不返回错误
+	Delete(ctx context.Context, key string) error
+	// URL 返回文件的公开访问地址
+	URL(key string) string
+}
+
+// 全局存储实例
+var GlobalStorage Storage
+
+// New 根据配置初始化存储
+// 参数：
+//   - config: 应用配置
+func New(config *configs.Config) {
+	s, err := NewStorage(config.StorageConfig)
+	if err != nil {
+		log.Fatalf("failed to initialize storage: %v", err)
+	}
+
+	GlobalStorage = s
+	global.SysLog.Infof("Storage initialized with driver '%s'", s.Driver())
+}
+
+// NewStorage 根据存储配置创建存储实例
+// 参数：
+//   - config: 存储配置
+//
+// 返回值：
+//   - Storage: 存储实例
+//   - error: 创建过程中的错误
+func NewStorage(config configs.StorageConfig) (Storage, error) {
+	switch config.Driver {
+	case DriverLocal, "":
+		return impl.NewLocalStorage(DriverLocal, config.Local.Root, config.Local.URLPrefix)
+	case DriverS3:
+		return impl.NewS3Storage(DriverS3, impl.S3Options{
+			Endpoint:  config.S3.Endpoint,
+			AccessKey: config.S3.AccessKey,
+			SecretKey: config.S3.SecretKey,
+			Bucket:    config.S3.Bucket,
+			Region:    config.S3.Region,
+			UseSSL:    config.S3.UseSSL,
+			PublicURL: config.S3.PublicURL,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported storage driver: %s", config.Driver)
+	}
+}
+
+// LocalURLPrefix 当前使用本地存储时返回文件访问路径前缀，否则返回空字符串
+// 返回值：
+//   - string: 访问路径前缀
+func LocalURLPrefix() string {
+	local, ok := GlobalStorage.(*impl.LocalStorage)
+	if !ok {
+		return ""
+	}
+	return local.URLPrefix()
+}
+
+// LocalPath 获取本地存储中文件的路径
+// 参数：
+//   - key: 文件键
+//
+// 返回值：
+//   - string: 文件路径
+//   - error: 当前未使用本地存储或键非法时返回错误
+func LocalPath(key string) (string, error) {
+	local, ok := GlobalStorage.(*impl.LocalStorage)
+	if !ok {
+		return "", fmt.Errorf("local storage not in use")
+	}
+	return local.Path(key)
+}
@@ -15,9 +15,10 @@ type ThemeInfo struct {
 	Preview     string `json:"preview,omitempty"`     // 主题预览图片地址
 
 	// 配置信息
-	Type          string `json:"type,omitempty"`            // 主题类型（frontend/console）
-	IndexFilePath string `json:"index_file_path,omitempty"` // 首页文件相对路径
-	StaticDirPath string `json:"static_dir_path,omitempty"` // 静态资源目录相对路径
+	Type          string          `json:"type,omitempty"`            // 主题类型（frontend/console）
+	IndexFilePath string          `json:"index_file_path,omitempty"` // 首页文件相对路径
+	StaticDirPath string          `json:"static_dir_path,omitempty"` // 静态资源目录相对路径
+	Templates     *ThemeTemplates `json:"templates,omitempty"`       // 服务端渲染模板，未声明时以首页文件作为单页应用提供
 
 	// 运行时信息
 	Status   string `json:"status"`              // 当前状态（ready/active/inactive/error）
@@ -25,3 +26,13 @@ type ThemeInfo struct {
 	Path     string `json:"path"`                // 主题文件路径
 	IsActive bool   `json:"is_active"`           // 是否为当前激活主题
 }
+
+// ThemeTemplates 主题服务端渲染模板配置，文件路径均相对于主题目录
+type ThemeTemplates struct {
+	Index    string   `json:"index,omitempty"`     // 首页模板
+	Post     string   `json:"post,omitempty"`      // 文章页模板
+	Category string   `json:"category,omitempty"`  // 分类页模板
+	NotFound string   `json:"not_found,omitempty"` // 404 页面模板
+	Partials []string `json:"partials,omitempty"`  // 公共模板文件，支持通配符，与每个页面模板一同解析
+	PageSize int64    `json:"page_size,omitempty"` // 首页与分类页每页文章数，未设置时为 10
+}
@@ -7,4 +7,16 @@ const (
 	// Redis 缓存键前缀 - 认证相关
 	AuthAccessTokenKeyPrefix  = "auth:access_token"  // 访问令牌缓存键前缀: auth:access_token:{userID}
 	AuthRefreshTokenKeyPrefix = "auth:refresh_token" // 刷新令牌缓存键前缀: auth:refresh_token:{userID}
+
+	// Redis 缓存键前缀 - 后台任务相关
+	SchedulerLockKeyPrefix = "scheduler:lock" // 后台任务分布式锁键前缀: scheduler:lock:{jobName}
+
+	// Redis 缓存键前缀 - 文章浏览量相关
+	PostViewSaltKeyPrefix = "post:view:salt"     // 访客标识盐值缓存键前缀: post:view:salt:{day}，每日轮换
+	PostViewSeenKeyPrefix = "post:view:seen"     // 访客浏览去重键前缀: post:view:seen:{postID}:{visitorHash}
+	PostViewPendingKey    = "post:view:pending"  // 待写入数据库的浏览量计数（哈希，字段为 {postID}:{day}）
+	PostViewFlushingKey   = "post:view:flushing" // 正在写入数据库的浏览量计数（哈希），写入中断时下次优先处理
+
+	// Redis 缓存键前缀 - 文章表态相关
+	ReactionRateKeyPrefix = "reaction:rate" // 表态限流计数键前缀: reaction:rate:{subject}，登录用户为 user:{userID}，匿名访客为 IP 的 HMAC
 )
@@ -0,0 +1,19 @@
+// Package consts 提供评论相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package consts
+
+// 评论审核状态常量
+const (
+	CommentStatusPending  = "pending"  // 待审核状态 - 评论已提交，等待管理员审核
+	CommentStatusApproved = "approved" // 已通过状态 - 评论审核通过，对外可见
+	CommentStatusSpam     = "spam"     // 垃圾评论状态 - 评论被标记为垃圾信息，不对外展示
+)
+
+// 评论权限常量（Casbin 策略资源）
+const (
+	CommentPermissionModerate          = "/api/v1/comment/moderate"     // 查看待审核评论（含评论者邮箱、IP 等信息）、审核及删除评论的权限资源
+	CommentPermissionModerateAction    = "POST"                         // 审核评论的权限操作
+	CommentPermissionAutoApprove       = "/api/v1/comment/auto-approve" // 发表评论免审核的权限资源
+	CommentPermissionAutoApproveAction = "POST"                         // 评论免审核的权限操作
+)
@@ -0,0 +1,14 @@
+// Package consts 提供订阅源相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package consts
+
+// 订阅源格式
+const (
+	FeedFormatRSS  = "rss"  // RSS 2.0
+	FeedFormatAtom = "atom" // Atom 1.0
+	FeedFormatJSON = "json" // JSON Feed 1.1
+)
+
+// DefaultFeedSize 未配置时订阅源包含的文章数量
+const DefaultFeedSize = 20
@@ -0,0 +1,25 @@
+// Package consts 提供媒体文件相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package consts
+
+// 媒体文件常量
+const (
+	DefaultMediaMaxSizeMB = 10 // 默认单个媒体文件大小上限（MB）
+)
+
+// 图片处理常量
+const (
+	ImageGenerateUpload    = "upload"          // 上传时生成图片变体
+	ImageGenerateLazy      = "lazy"            // 首次访问时生成图片变体
+	DefaultImageQuality    = 82                // 默认 JPEG 与 WebP 编码质量
+	DefaultImageMaxPixels  = 50_000_000        // 默认允许解码处理的图片像素数上限（宽×高，GIF 按帧累计）
+	ImageEncodeTimeout     = 30                // 单个 WebP 变体编码超时时间（秒）
+	MediaVariantPathPrefix = "/media/variants" // 按需生成图片变体的访问路径前缀
+)
+
+// 媒体文件权限常量（Casbin 策略资源）
+const (
+	MediaPermissionManage       = "/api/v1/media/manage" // 管理所有用户媒体文件（查看、删除他人上传的文件）的权限资源
+	MediaPermissionManageAction = "POST"                 // 管理媒体文件的权限操作
+)
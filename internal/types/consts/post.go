@@ -3,10 +3,60 @@
 // 创建时间：2025-08-13
 package consts
 
+import "time"
+
 // 文章状态常量
 const (
 	PostStatusDraft     = "draft"     // 草稿状态 - 文章正在编辑中，不对外展示
 	PostStatusPublished = "published" // 已发布状态 - 文章已发布，对外可见
 	PostStatusPrivate   = "private"   // 私有状态 - 文章仅作者可见
 	PostStatusArchived  = "archived"  // 已归档状态 - 文章已归档，不在列表中显示但可通过链接访问
+	PostStatusScheduled = "scheduled" // 定时发布状态 - 文章将在发布时间到达后由后台任务自动发布
+)
+
+// 文章权限常量（Casbin 策略资源）
+const (
+	PostPermissionViewHidden       = "/api/v1/post/view-hidden" // 查看他人草稿、私有及定时发布文章的权限资源
+	PostPermissionViewHiddenAction = "GET"                      // 查看隐藏文章的权限操作
+	PostPermissionRerender         = "/api/v1/post/rerender"    // 重新渲染全部文章的权限资源
+	PostPermissionRerenderAction   = "POST"                     // 重新渲染全部文章的权限操作
+	PostPermissionImport           = "/api/v1/post/import"      // 导入文章的权限资源
+	PostPermissionImportAction     = "POST"                     // 导入文章的权限操作
+	PostPermissionPin              = "/api/v1/post/pin"         // 置顶、推荐文章及调整置顶顺序的权限资源
+	PostPermissionPinAction        = "POST"                     // 置顶、推荐文章的权限操作
+
+	PostPermissionRevision       = "/api/v1/post/revision/restore" // 恢复他人文章修订的权限资源
+	PostPermissionRevisionAction = "POST"                          // 恢复文章修订的权限操作
+)
+
+// 文章置顶范围常量
+const (
+	PostPinScopeNone     = "none"     // 取消置顶（仅用于请求参数）
+	PostPinScopeGlobal   = "global"   // 全局置顶 - 在首页文章列表及所属分类列表顶部展示
+	PostPinScopeCategory = "category" // 分类置顶 - 仅在所属分类的文章列表顶部展示
+)
+
+// 文章列表排序方式常量
+const (
+	PostSortNewest      = "newest"       // 最新发布（默认）
+	PostSortOldest      = "oldest"       // 最早发布
+	PostSortMostViewed  = "most_viewed"  // 浏览量最多
+	PostSortLastUpdated = "last_updated" // 最近更新
+)
+
+// 文章浏览量统计常量
+const (
+	DefaultViewDedupWindow   = 30 * time.Minute // 默认访客浏览去重时间窗口
+	PostViewSaltTTL          = 48 * time.Hour   // 访客标识盐值保留时间，覆盖当日全部去重窗口
+	PostPopularWeekDays      = 7                // 本周热门文章统计的天数（含当日）
+	DefaultPopularPostsLimit = 10               // 热门文章列表默认数量
+)
+
+// 文章摘要与封面常量
+const (
+	DefaultExcerptLength     = 200                // 默认自动摘要长度（字符数）
+	PostDescriptionMaxLength = 500                // 文章描述最大长度，与数据库字段长度一致
+	PostImageMaxLength       = 255                // 文章封面地址最大长度，与数据库字段长度一致
+	PostExtAutoDescription   = "auto_description" // 扩展字段键：描述由内容自动生成，内容变更时随之更新
+	PostExtAutoImage         = "auto_image"       // 扩展字段键：封面由内容自动提取，内容变更时随之更新
 )
@@ -0,0 +1,22 @@
+// Package consts 提供文章表态相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package consts
+
+import "time"
+
+// 文章表态权限常量（Casbin 策略资源）
+const (
+	ReactionPermissionReport       = "/api/v1/reaction/report" // 查看表态最多文章报表的权限资源
+	ReactionPermissionReportAction = "GET"                     // 查看表态报表的权限操作
+)
+
+// 文章表态常量
+const (
+	PostExtReactionTypes        = "reaction_types" // 文章扩展字段键：文章启用的表态类型，未设置或为空时启用全部已配置类型
+	DefaultReactionRateLimit    = 30               // 默认限流窗口内允许的表态次数
+	DefaultReactionRateWindow   = time.Minute      // 默认表态限流窗口
+	DefaultReactionReportLimit  = 20               // 表态报表默认返回的文章数量
+	ReactionTypeKeyMaxLength    = 32               // 表态类型标识最大长度，与数据库字段长度一致
+	ReactionFingerprintMaxBytes = 128              // 匿名访客客户端指纹最大长度
+)
@@ -11,4 +11,10 @@ const (
 	// 主题类型
 	ThemeTypeFrontend = "frontend" // 前端主题
 	ThemeTypeConsole  = "console"  // 控制台主题
+
+	// 主题模板页面
+	ThemeTemplateIndex    = "index"     // 首页
+	ThemeTemplatePost     = "post"      // 文章页
+	ThemeTemplateCategory = "category"  // 分类页
+	ThemeTemplateNotFound = "not_found" // 404 页面
 )
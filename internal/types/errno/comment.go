@@ -0,0 +1,23 @@
+// Package errno 评论模块错误码定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package errno
+
+import (
+	"github.com/Done-0/jank/internal/utils/errorx/code"
+)
+
+// 评论模块错误码: 80000 ~ 89999
+const (
+	ErrCommentCreateFailed   = 80001 // 创建评论失败
+	ErrCommentListFailed     = 80002 // 获取评论列表失败
+	ErrCommentModerateFailed = 80003 // 审核评论失败
+	ErrCommentDeleteFailed   = 80004 // 删除评论失败
+)
+
+func init() {
+	code.Register(ErrCommentCreateFailed, "create comment failed: {post_id}")
+	code.Register(ErrCommentListFailed, "list comments failed: {msg}")
+	code.Register(ErrCommentModerateFailed, "moderate comment failed: {id}")
+	code.Register(ErrCommentDeleteFailed, "delete comment failed: {id}")
+}
@@ -0,0 +1,17 @@
+// Package errno 订阅源模块错误码定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package errno
+
+import (
+	"github.com/Done-0/jank/internal/utils/errorx/code"
+)
+
+// 订阅源模块错误码: 100000 ~ 109999
+const (
+	ErrFeedGenerateFailed = 100001 // 生成订阅源失败
+)
+
+func init() {
+	code.Register(ErrFeedGenerateFailed, "generate feed failed: {format}")
+}
@@ -0,0 +1,27 @@
+// Package errno 媒体文件模块错误码定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package errno
+
+import (
+	"github.com/Done-0/jank/internal/utils/errorx/code"
+)
+
+// 媒体文件模块错误码: 120000 ~ 129999
+const (
+	ErrMediaUploadFailed   = 120001 // 上传媒体文件失败
+	ErrMediaListFailed     = 120002 // 获取媒体文件列表失败
+	ErrMediaDeleteFailed   = 120003 // 删除媒体文件失败
+	ErrMediaTooLarge       = 120004 // 媒体文件过大
+	ErrMediaTypeNotAllowed = 120005 // 媒体文件类型不允许
+	ErrMediaVariantFailed  = 120006 // 生成图片变体失败
+)
+
+func init() {
+	code.Register(ErrMediaUploadFailed, "upload media failed: {msg}")
+	code.Register(ErrMediaListFailed, "list media failed: {msg}")
+	code.Register(ErrMediaDeleteFailed, "delete media failed: {id}")
+	code.Register(ErrMediaTooLarge, "media file too large: {msg}")
+	code.Register(ErrMediaTypeNotAllowed, "media type not allowed: {type}")
+	code.Register(ErrMediaVariantFailed, "generate image variant failed: {key}")
+}
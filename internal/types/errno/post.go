@@ -9,11 +9,24 @@ import (
 
 // 文章模块错误码: 40000 ~ 49999
 const (
-	ErrPostCreateFailed = 40001 // 创建文章失败
-	ErrPostGetFailed    = 40002 // 获取文章失败
-	ErrPostUpdateFailed = 40003 // 更新文章失败
-	ErrPostDeleteFailed = 40004 // 删除文章失败
-	ErrPostListFailed   = 40005 // 获取文章列表失败
+	ErrPostCreateFailed          = 40001 // 创建文章失败
+	ErrPostGetFailed             = 40002 // 获取文章失败
+	ErrPostUpdateFailed          = 40003 // 更新文章失败
+	ErrPostDeleteFailed          = 40004 // 删除文章失败
+	ErrPostListFailed            = 40005 // 获取文章列表失败
+	ErrPostGetBySlugFailed       = 40006 // 根据别名获取文章失败
+	ErrPostRevisionListFailed    = 40007 // 获取文章修订列表失败
+	ErrPostRevisionDiffFailed    = 40008 // 对比文章修订失败
+	ErrPostRevisionRestoreFailed = 40009 // 恢复文章修订失败
+	ErrPostListByAuthorFailed    = 40010 // 获取作者文章列表失败
+	ErrPostSearchFailed          = 40011 // 检索文章失败
+	ErrPostRerenderFailed        = 40012 // 重新渲染文章失败
+	ErrPostImportFailed          = 40013 // 导入文章失败
+	ErrPostPinFailed             = 40014 // 置顶文章失败
+	ErrPostFeatureFailed         = 40015 // 推荐文章失败
+	ErrPostPinReorderFailed      = 40016 // 调整置顶顺序失败
+	ErrPostPinnedListFailed      = 40017 // 获取置顶文章列表失败
+	ErrPostPopularListFailed     = 40018 // 获取热门文章列表失败
 )
 
 func init() {
@@ -22,4 +35,17 @@ func init() {
 	code.Register(ErrPostUpdateFailed, "update post failed: {id}")
 	code.Register(ErrPostDeleteFailed, "delete post failed: {id}")
 	code.Register(ErrPostListFailed, "list posts failed: {msg}")
+	code.Register(ErrPostGetBySlugFailed, "get post by slug failed: {slug}")
+	code.Register(ErrPostRevisionListFailed, "list post revisions failed: {post_id}")
+	code.Register(ErrPostRevisionDiffFailed, "diff post revisions failed: {from_id} -> {to_id}")
+	code.Register(ErrPostRevisionRestoreFailed, "restore post revision failed: {id}")
+	code.Register(ErrPostListByAuthorFailed, "list posts by author failed: {author_id}")
+	code.Register(ErrPostSearchFailed, "search posts failed: {keyword}")
+	code.Register(ErrPostRerenderFailed, "rerender posts failed: {msg}")
+	code.Register(ErrPostImportFailed, "import posts failed: {msg}")
+	code.Register(ErrPostPinFailed, "pin post failed: {id}")
+	code.Register(ErrPostFeatureFailed, "feature post failed: {id}")
+	code.Register(ErrPostPinReorderFailed, "reorder pinned posts failed: {scope}")
+	code.Register(ErrPostPinnedListFailed, "list pinned posts failed: {scope}")
+	code.Register(ErrPostPopularListFailed, "list popular posts failed: {period}")
 }
@@ -0,0 +1,25 @@
+// Package errno 文章表态模块错误码定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package errno
+
+import (
+	"github.com/Done-0/jank/internal/utils/errorx/code"
+)
+
+// 文章表态模块错误码: 140000 ~ 149999
+const (
+	ErrReactionToggleFailed   = 140001 // 表态失败
+	ErrReactionGetFailed      = 140002 // 获取文章表态失败
+	ErrReactionTypeListFailed = 140003 // 获取表态类型失败
+	ErrReactionReportFailed   = 140004 // 获取表态报表失败
+	ErrReactionRateLimited    = 140005 // 表态过于频繁
+)
+
+func init() {
+	code.Register(ErrReactionToggleFailed, "toggle reaction failed: {post_id}")
+	code.Register(ErrReactionGetFailed, "get reactions failed: {post_id}")
+	code.Register(ErrReactionTypeListFailed, "list reaction types failed: {msg}")
+	code.Register(ErrReactionReportFailed, "get reaction report failed: {msg}")
+	code.Register(ErrReactionRateLimited, "reaction rate limited: {msg}")
+}
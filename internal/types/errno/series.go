@@ -0,0 +1,25 @@
+// Package errno 文章系列模块错误码定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package errno
+
+import (
+	"github.com/Done-0/jank/internal/utils/errorx/code"
+)
+
+// 文章系列模块错误码: 130000 ~ 139999
+const (
+	ErrSeriesCreateFailed = 130001 // 创建系列失败
+	ErrSeriesGetFailed    = 130002 // 获取系列失败
+	ErrSeriesUpdateFailed = 130003 // 更新系列失败
+	ErrSeriesDeleteFailed = 130004 // 删除系列失败
+	ErrSeriesListFailed   = 130005 // 获取系列列表失败
+)
+
+func init() {
+	code.Register(ErrSeriesCreateFailed, "create series failed: {name}")
+	code.Register(ErrSeriesGetFailed, "get series failed: {id}")
+	code.Register(ErrSeriesUpdateFailed, "update series failed: {id}")
+	code.Register(ErrSeriesDeleteFailed, "delete series failed: {id}")
+	code.Register(ErrSeriesListFailed, "list series failed: {msg}")
+}
@@ -0,0 +1,19 @@
+// Package errno 站点地图模块错误码定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package errno
+
+import (
+	"github.com/Done-0/jank/internal/utils/errorx/code"
+)
+
+// 站点地图模块错误码: 110000 ~ 119999
+const (
+	ErrSitemapGenerateFailed = 110001 // 生成站点地图失败
+	ErrRobotsGenerateFailed  = 110002 // 生成 robots.txt 失败
+)
+
+func init() {
+	code.Register(ErrSitemapGenerateFailed, "generate sitemap failed: {page}")
+	code.Register(ErrRobotsGenerateFailed, "generate robots.txt failed: {msg}")
+}
@@ -0,0 +1,25 @@
+// Package errno 标签模块错误码定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package errno
+
+import (
+	"github.com/Done-0/jank/internal/utils/errorx/code"
+)
+
+// 标签模块错误码: 90000 ~ 99999
+const (
+	ErrTagCreateFailed = 90001 // 创建标签失败
+	ErrTagGetFailed    = 90002 // 获取标签失败
+	ErrTagUpdateFailed = 90003 // 更新标签失败
+	ErrTagDeleteFailed = 90004 // 删除标签失败
+	ErrTagListFailed   = 90005 // 获取标签列表失败
+)
+
+func init() {
+	code.Register(ErrTagCreateFailed, "create tag failed: {name}")
+	code.Register(ErrTagGetFailed, "get tag failed: {id}")
+	code.Register(ErrTagUpdateFailed, "update tag failed: {id}")
+	code.Register(ErrTagDeleteFailed, "delete tag failed: {id}")
+	code.Register(ErrTagListFailed, "list tags failed: {msg}")
+}
@@ -0,0 +1,299 @@
+// Package feed 提供 RSS 2.0、Atom 1.0 与 JSON Feed 1.1 订阅源生成工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"time"
+)
+
+// 订阅源内容类型
+const (
+	ContentTypeRSS  = "application/rss+xml; charset=utf-8"   // RSS 2.0
+	ContentTypeAtom = "application/atom+xml; charset=utf-8"  // Atom 1.0
+	ContentTypeJSON = "application/feed+json; charset=utf-8" // JSON Feed 1.1
+)
+
+// Feed 订阅源
+type Feed struct {
+	Title       string    // 订阅源标题
+	Link        string    // 站点首页链接
+	FeedURL     string    // 订阅源自身链接
+	Description string    // 订阅源描述
+	Language    string    // 语言
+	Updated     time.Time // 最近更新时间
+	Items       []*Item   // 条目列表
+}
+
+// Item 订阅源条目
+type Item struct {
+	ID         string    // 条目唯一标识
+	Title      string    // 标题
+	Link       string    // 条目链接
+	Summary    string    // 摘要
+	Content    string    // 完整 HTML 内容
+	Author     string    // 作者名称
+	Categories []string  // 分类与标签
+	Published  time.Time // 发布时间
+	Updated    time.Time // 更新时间
+}
+
+// cdata CDATA 文本节点
+type cdata struct {
+	Text string `xml:",cdata"`
+}
+
+// rss RSS 2.0 根节点
+type rss struct {
+	XMLName      xml.Name   `xml:"rss"`
+	Version      string     `xml:"version,attr"`
+	XMLNSAtom    string     `xml:"xmlns:atom,attr"`
+	XMLNSContent string     `xml:"xmlns:content,attr"`
+	XMLNSDC      string     `xml:"xmlns:dc,attr"`
+	Channel      rssChannel `xml:"channel"`
+}
+
+// rssChannel RSS 2.0 频道
+type rssChannel struct {
+	Title         string     `xml:"title"`
+	Link          string     `xml:"link"`
+	Description   string     `xml:"description"`
+	Language      string     `xml:"language,omitempty"`
+	LastBuildDate string     `xml:"lastBuildDate,omitempty"`
+	AtomLink      atomLink   `xml:"atom:link"`
+	Items         []*rssItem `xml:"item"`
+}
+
+// rssItem RSS 2.0 条目
+type rssItem struct {
+	Title      string   `xml:"title"`
+	Link       string   `xml:"link"`
+	GUID       rssGUID  `xml:"guid"`
+	Creator    string   `xml:"dc:creator,omitempty"`
+	Categories []string `xml:"category"`
+	PubDate    string   `xml:"pubDate"`
+	Summary    string   `xml:"description"`
+	Content    *cdata   `xml:"content:encoded,omitempty"`
+}
+
+// rssGUID RSS 2.0 条目唯一标识
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// atomFeed Atom 1.0 根节点
+type atomFeed struct {
+	XMLName  xml.Name     `xml:"http://www.w3.org/2005/Atom feed"`
+	Lang     string       `xml:"xml:lang,attr,omitempty"`
+	Title    string       `xml:"title"`
+	Subtitle string       `xml:"subtitle,omitempty"`
+	ID       string       `xml:"id"`
+	Links    []atomLink   `xml:"link"`
+	Updated  string       `xml:"updated"`
+	Entries  []*atomEntry `xml:"entry"`
+}
+
+// atomLink Atom 链接
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+// atomEntry Atom 1.0 条目
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     *atomAuthor    `xml:"author,omitempty"`
+	Categories []atomCategory `xml:"category"`
+	Summary    *atomText      `xml:"summary,omitempty"`
+	Content    *atomText      `xml:"content,omitempty"`
+}
+
+// atomAuthor Atom 作者
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// atomCategory Atom 分类
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// atomText Atom 文本内容
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// jsonFeed JSON Feed 1.1 根节点
+type jsonFeed struct {
+	Version     string      `json:"version"`
+	Title       string      `json:"title"`
+	HomePageURL string      `json:"home_page_url,omitempty"`
+	FeedURL     string      `json:"feed_url,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Language    string      `json:"language,omitempty"`
+	Items       []*jsonItem `json:"items"`
+}
+
+// jsonItem JSON Feed 1.1 条目
+type jsonItem struct {
+	ID            string        `json:"id"`
+	URL           string        `json:"url,omitempty"`
+	Title         string        `json:"title,omitempty"`
+	ContentHTML   string        `json:"content_html,omitempty"`
+	Summary       string        `json:"summary,omitempty"`
+	DatePublished string        `json:"date_published,omitempty"`
+	DateModified  string        `json:"date_modified,omitempty"`
+	Authors       []*jsonAuthor `json:"authors,omitempty"`
+	Tags          []string      `json:"tags,omitempty"`
+}
+
+// jsonAuthor JSON Feed 作者
+type jsonAuthor struct {
+	Name string `json:"name"`
+}
+
+// RenderRSS 生成 RSS 2.0 订阅源
+// 参数：
+//   - f: 订阅源
+//
+// 返回值：
+//   - []byte: XML 内容
+//   - error: 生成过程中的错误
+func RenderRSS(f *Feed) ([]byte, error) {
+	channel := rssChannel{
+		Title:       f.Title,
+		Link:        f.Link,
+		Description: f.Description,
+		Language:    f.Language,
+		AtomLink:    atomLink{Href: f.FeedURL, Rel: "self", Type: "application/rss+xml"},
+	}
+	if !f.Updated.IsZero() {
+		channel.LastBuildDate = f.Updated.Format(time.RFC1123Z)
+	}
+
+	for _, item := range f.Items {
+		ri := &rssItem{
+			Title:      item.Title,
+			Link:       item.Link,
+			GUID:       rssGUID{IsPermaLink: item.ID == item.Link, Value: item.ID},
+			Creator:    item.Author,
+			Categories: item.Categories,
+			PubDate:    item.Published.Format(time.RFC1123Z),
+			Summary:    item.Summary,
+		}
+		if item.Content != "" {
+			ri.Content = &cdata{Text: item.Content}
+		}
+		channel.Items = append(channel.Items, ri)
+	}
+
+	return marshalXML(&rss{
+		Version:      "2.0",
+		XMLNSAtom:    "http://www.w3.org/2005/Atom",
+		XMLNSContent: "http://purl.org/rss/1.0/modules/content/",
+		XMLNSDC:      "http://purl.org/dc/elements/1.1/",
+		Channel:      channel,
+	})
+}
+
+// RenderAtom 生成 Atom 1.0 订阅源
+// 参数：
+//   - f: 订阅源
+//
+// 返回值：
+//   - []byte: XML 内容
+//   - error: 生成过程中的错误
+func RenderAtom(f *Feed) ([]byte, error) {
+	af := &atomFeed{
+		Lang:     f.Language,
+		Title:    f.Title,
+		Subtitle: f.Description,
+		ID:       f.FeedURL,
+		Links: []atomLink{
+			{Href: f.Link, Rel: "alternate", Type: "text/html"},
+			{Href: f.FeedURL, Rel: "self", Type: "application/atom+xml"},
+		},
+		Updated: f.Updated.Format(time.RFC3339),
+	}
+
+	for _, item := range f.Items {
+		entry := &atomEntry{
+			Title:     item.Title,
+			ID:        item.ID,
+			Link:      atomLink{Href: item.Link, Rel: "alternate", Type: "text/html"},
+			Published: item.Published.Format(time.RFC3339),
+			Updated:   item.Updated.Format(time.RFC3339),
+		}
+		if item.Author != "" {
+			entry.Author = &atomAuthor{Name: item.Author}
+		}
+		for _, category := range item.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: category})
+		}
+		if item.Summary != "" {
+			entry.Summary = &atomText{Type: "text", Value: item.Summary}
+		}
+		if item.Content != "" {
+			entry.Content = &atomText{Type: "html", Value: item.Content}
+		}
+		af.Entries = append(af.Entries, entry)
+	}
+
+	return marshalXML(af)
+}
+
+// RenderJSON 生成 JSON Feed 1.1 订阅源
+// 参数：
+//   - f: 订阅源
+//
+// 返回值：
+//   - []byte: JSON 内容
+//   - error: 生成过程中的错误
+func RenderJSON(f *Feed) ([]byte, error) {
+	jf := &jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       f.Title,
+		HomePageURL: f.Link,
+		FeedURL:     f.FeedURL,
+		Description: f.Description,
+		Language:    f.Language,
+		Items:       make([]*jsonItem, 0, len(f.Items)),
+	}
+
+	for _, item := range f.Items {
+		ji := &jsonItem{
+			ID:            item.ID,
+			URL:           item.Link,
+			Title:         item.Title,
+			ContentHTML:   item.Content,
+			Summary:       item.Summary,
+			DatePublished: item.Published.Format(time.RFC3339),
+			DateModified:  item.Updated.Format(time.RFC3339),
+			Tags:          item.Categories,
+		}
+		if item.Author != "" {
+			ji.Authors = []*jsonAuthor{{Name: item.Author}}
+		}
+		jf.Items = append(jf.Items, ji)
+	}
+
+	return json.MarshalIndent(jf, "", "  ")
+}
+
+// marshalXML 序列化 XML 并添加声明头
+func marshalXML(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
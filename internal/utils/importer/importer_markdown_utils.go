@@ -0,0 +1,256 @@
+// Package importer 提供从 Hexo、Hugo、Jekyll 的 Markdown 文件与 WordPress WXR 导出文件解析文章的工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/slug"
+)
+
+// jekyllFileName Jekyll 文章文件名格式：YYYY-MM-DD-标题.md
+var jekyllFileName = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})-(.+)$`)
+
+// 各生成器中含义相同的 front matter 字段，按优先级排列
+var (
+	updatedKeys     = []string{"updated", "lastmod", "last_modified_at", "modified"}
+	descriptionKeys = []string{"description", "excerpt", "summary"}
+	imageKeys       = []string{"image", "cover", "thumbnail", "featured_image", "banner", "images"}
+)
+
+// ParseMarkdown 解析带 YAML（---）或 TOML（+++）front matter 的 Markdown 文件
+// 支持 Hexo、Hugo、Jekyll 的常用字段；标题、别名与日期缺失时依次由 Jekyll 文件名、Hugo 页面包目录名或文件名推断，位于 _drafts 目录的文件视为草稿
+// 参数：
+//   - source: 文件路径
+//   - content: 文件内容
+//
+// 返回值：
+//   - *Item: 解析得到的文章
+//   - error: front matter 格式错误时返回错误
+func ParseMarkdown(source string, content []byte) (*Item, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	meta, body, err := splitFrontMatter(content)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSuffix(path.Base(source), path.Ext(source))
+	if name == "index" && path.Dir(source) != "." {
+		name = path.Base(path.Dir(source)) // Hugo 页面包
+	}
+	var fileDate time.Time
+	if m := jekyllFileName.FindStringSubmatch(name); m != nil {
+		if t, ok := parseTime(m[1]); ok {
+			fileDate = t
+			name = m[2]
+		}
+	}
+
+	item := &Item{
+		Source:      source,
+		Title:       stringValue(meta["title"]),
+		Description: firstString(meta, descriptionKeys),
+		Image:       firstString(meta, imageKeys),
+		Categories:  listValue(firstValue(meta, "categories", "category"), false),
+		Tags:        listValue(firstValue(meta, "tags", "tag"), true),
+		Markdown:    strings.TrimLeft(string(body), "\r\n"),
+	}
+	if item.Title == "" {
+		item.Title = name
+	}
+
+	item.Slug = slug.Generate(stringValue(meta["slug"]))
+	if item.Slug == "" {
+		item.Slug = slug.Generate(name)
+	}
+
+	if t, ok := timeValue(meta["date"]); ok {
+		item.Created = t
+	} else {
+		item.Created = fileDate
+	}
+	if t, ok := timeValue(firstValue(meta, updatedKeys...)); ok {
+		item.Updated = t
+	}
+
+	item.Status = markdownStatus(meta, strings.Contains("/"+source, "/_drafts/"))
+	if item.Status == consts.PostStatusPublished && item.Created.After(time.Now()) {
+		item.Status = consts.PostStatusScheduled
+	}
+
+	return item, nil
+}
+
+// splitFrontMatter 拆分 front matter 与正文，无 front matter 时返回空映射
+// Hexo 允许省略开头的 ---，此时仅当分隔线之前的内容是包含 title 的 YAML 映射才视为 front matter
+func splitFrontMatter(content []byte) (map[string]any, []byte, error) {
+	lines := bytes.SplitAfter(content, []byte("\n"))
+	delimiter := string(bytes.TrimRight(lines[0], "\r\n"))
+	explicit := delimiter == "---" || delimiter == "+++"
+	if !explicit {
+		delimiter = "---"
+	}
+
+	start := 0
+	if explicit {
+		start = len(lines[0])
+	}
+	offset := start
+	for i, line := range lines {
+		if explicit && i == 0 {
+			continue
+		}
+		text := string(bytes.TrimRight(line, "\r\n"))
+		if text != delimiter && (delimiter != "---" || text != "...") {
+			offset += len(line)
+			continue
+		}
+
+		meta := make(map[string]any)
+		raw, body := content[start:offset], content[offset+len(line):]
+		var err error
+		if delimiter == "+++" {
+			err = toml.Unmarshal(raw, &meta)
+		} else {
+			err = yaml.Unmarshal(raw, &meta)
+		}
+		if !explicit {
+			// 省略开头分隔线时，解析失败或缺少标题均视为普通正文
+			if err != nil || meta["title"] == nil {
+				return make(map[string]any), content, nil
+			}
+			return meta, body, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid front matter: %w", err)
+		}
+		return meta, body, nil
+	}
+
+	if explicit {
+		return nil, nil, errors.New("unterminated front matter")
+	}
+	return make(map[string]any), content, nil
+}
+
+// markdownStatus 根据 front matter 确定文章状态：draft: true、published: false 或位于草稿目录时为草稿，status 字段可显式指定状态
+func markdownStatus(meta map[string]any, inDrafts bool) string {
+	if status := strings.ToLower(stringValue(meta["status"])); status != "" {
+		switch status {
+		case "draft", "pending":
+			return consts.PostStatusDraft
+		case "private":
+			return consts.PostStatusPrivate
+		case "published", "publish":
+			return consts.PostStatusPublished
+		}
+	}
+	if draft, ok := meta["draft"].(bool); ok && draft {
+		return consts.PostStatusDraft
+	}
+	if published, ok := meta["published"].(bool); ok && !published {
+		return consts.PostStatusDraft
+	}
+	if inDrafts {
+		return consts.PostStatusDraft
+	}
+	return consts.PostStatusPublished
+}
+
+// firstValue 获取第一个存在的字段值
+func firstValue(meta map[string]any, keys ...string) any {
+	for _, key := range keys {
+		if v, ok := meta[key]; ok && v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// firstString 获取第一个非空的字符串字段值，列表取第一个元素
+func firstString(meta map[string]any, keys []string) string {
+	for _, key := range keys {
+		if s := stringValue(meta[key]); s != "" {
+			return s
+		}
+		if list := listValue(meta[key], false); len(list) > 0 {
+			return list[0]
+		}
+	}
+	return ""
+}
+
+// stringValue 将字段值转换为字符串，非标量值返回空字符串
+func stringValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case int, int64, float64, bool:
+		return fmt.Sprint(v)
+	}
+	return ""
+}
+
+// listValue 将字段值转换为字符串列表：列表（含 Hexo 嵌套列表）按顺序展开，字符串按逗号拆分，splitSpace 为 true 且无逗号时按空白拆分（Jekyll 标签写法）
+func listValue(v any, splitSpace bool) []string {
+	var out []string
+	seen := make(map[string]bool)
+	add := func(s string) {
+		s = strings.TrimSpace(s)
+		if s != "" && !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case []any:
+			for _, e := range v {
+				walk(e)
+			}
+		case string:
+			switch {
+			case strings.Contains(v, ","):
+				for _, s := range strings.Split(v, ",") {
+					add(s)
+				}
+			case splitSpace:
+				for _, s := range strings.Fields(v) {
+					add(s)
+				}
+			default:
+				add(v)
+			}
+		default:
+			add(stringValue(v))
+		}
+	}
+	walk(v)
+	return out
+}
+
+// timeValue 将字段值转换为时间，支持 YAML 与 TOML 的日期类型及常见字符串格式
+func timeValue(v any) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		return parseTime(v)
+	case fmt.Stringer: // TOML 本地日期时间类型
+		return parseTime(v.String())
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,226 @@
+// Package importer 提供从 Hexo、Hugo、Jekyll 的 Markdown 文件与 WordPress WXR 导出文件解析文章的工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	MaxFileSize  = 32 << 20  // 单个待解析文件（含压缩包内文件解压后）的大小上限
+	MaxTotalSize = 256 << 20 // 单次解析压缩包或目录时读取的文件总大小上限，防止压缩炸弹
+	MaxEntries   = 10000     // 单次解析压缩包或目录时遍历的条目数上限
+)
+
+// budget 遍历压缩包或目录时剩余的读取额度，任一额度耗尽时中止解析
+type budget struct {
+	bytes   int64 // 剩余可读取的字节数
+	entries int   // 剩余可遍历的条目数
+}
+
+// newBudget 创建默认读取额度
+func newBudget() *budget {
+	return &budget{bytes: MaxTotalSize, entries: MaxEntries}
+}
+
+// Item 解析得到的文章
+type Item struct {
+	Source      string    // 来源，Markdown 为文件路径，WXR 为 文件名#文章 ID
+	Title       string    // 标题
+	Slug        string    // URL 别名（已规范化），为空时由标题生成
+	Description string    // 描述/摘要
+	Image       string    // 封面图片
+	Status      string    // 文章状态：draft、published、private、scheduled
+	Categories  []string  // 分类名称，第一个作为文章分类
+	Tags        []string  // 标签名称
+	Created     time.Time // 原始发布时间，零值表示未知
+	Updated     time.Time // 原始更新时间，零值表示未知
+	Markdown    string    // 正文，WXR 中的 HTML 正文原样保留
+}
+
+// Failure 解析失败的条目
+type Failure struct {
+	Source string // 来源
+	Error  string // 失败原因
+}
+
+// Result 解析结果
+type Result struct {
+	Items    []*Item    // 解析成功的文章
+	Failures []*Failure // 解析失败的条目
+}
+
+// Parse 按文件扩展名解析单个文件：.zip 压缩包、.xml WordPress 导出文件或 .md/.markdown 文件
+// 参数：
+//   - name: 文件名
+//   - content: 文件内容
+//
+// 返回值：
+//   - *Result: 解析结果，单篇文章的解析错误记录在 Failures 中
+//   - error: 文件类型不支持或整体无法解析时返回错误
+func Parse(name string, content []byte) (*Result, error) {
+	result := &Result{}
+	switch strings.ToLower(path.Ext(name)) {
+	case ".zip":
+		archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open zip archive: %w", err)
+		}
+		if err := parseFS(archive, result, newBudget()); err != nil {
+			return nil, err
+		}
+	case ".xml":
+		items, failures, err := ParseWXR(name, bytes.NewReader(content))
+		if err != nil {
+			return nil, err
+		}
+		result.Items = append(result.Items, items...)
+		result.Failures = append(result.Failures, failures...)
+	case ".md", ".markdown":
+		item, err := ParseMarkdown(name, content)
+		if err != nil {
+			result.Failures = append(result.Failures, &Failure{Source: name, Error: err.Error()})
+		} else {
+			result.Items = append(result.Items, item)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported import file type: %s", name)
+	}
+	return result, nil
+}
+
+// ParsePath 解析本地目录或文件，目录中的 Markdown 与 WXR 文件按路径顺序解析
+// 参数：
+//   - p: 目录或文件路径
+//
+// 返回值：
+//   - *Result: 解析结果
+//   - error: 路径无法读取或文件类型不支持时返回错误
+func ParsePath(p string) (*Result, error) {
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat '%s': %w", p, err)
+	}
+
+	if info.IsDir() {
+		result := &Result{}
+		if err := parseFS(os.DirFS(p), result, newBudget()); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	if info.Size() > MaxFileSize {
+		return nil, fmt.Errorf("file '%s' exceeds limit of %d bytes", p, MaxFileSize)
+	}
+	content, err := os.ReadFile(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read '%s': %w", p, err)
+	}
+	return Parse(filepath.Base(p), content)
+}
+
+// parseFS 遍历文件系统中的 Markdown 与 WXR 文件，跳过隐藏文件与 Hugo 栏目页 _index.md；
+// 遍历条目数或读取的文件总大小超过额度时中止解析并返回错误
+func parseFS(fsys fs.FS, result *Result, b *budget) error {
+	return fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("failed to read '%s': %w", name, err)
+		}
+		if b.entries--; b.entries < 0 {
+			return fmt.Errorf("too many entries: exceeds limit of %d", MaxEntries)
+		}
+		base := path.Base(name)
+		if name != "." && (strings.HasPrefix(base, ".") || base == "__MACOSX") {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() || base == "_index.md" {
+			return nil
+		}
+
+		ext := strings.ToLower(path.Ext(name))
+		if ext != ".md" && ext != ".markdown" && ext != ".xml" {
+			return nil
+		}
+
+		content, err := readFile(fsys, name, b)
+		if err != nil {
+			if b.bytes <= 0 {
+				return fmt.Errorf("total file size exceeds limit of %d bytes", MaxTotalSize)
+			}
+			result.Failures = append(result.Failures, &Failure{Source: name, Error: err.Error()})
+			return nil
+		}
+
+		if ext == ".xml" {
+			items, failures, err := ParseWXR(name, bytes.NewReader(content))
+			if err != nil {
+				result.Failures = append(result.Failures, &Failure{Source: name, Error: err.Error()})
+				return nil
+			}
+			result.Items = append(result.Items, items...)
+			result.Failures = append(result.Failures, failures...)
+			return nil
+		}
+
+		item, err := ParseMarkdown(name, content)
+		if err != nil {
+			result.Failures = append(result.Failures, &Failure{Source: name, Error: err.Error()})
+			return nil
+		}
+		result.Items = append(result.Items, item)
+		return nil
+	})
+}
+
+// readFile 读取文件内容并扣减读取额度，超过单文件大小上限或剩余额度时返回错误
+func readFile(fsys fs.FS, name string, b *budget) ([]byte, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	limit := min(int64(MaxFileSize), b.bytes)
+	content, err := io.ReadAll(io.LimitReader(file, limit+1))
+	b.bytes -= int64(len(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if int64(len(content)) > limit {
+		return nil, fmt.Errorf("file exceeds limit of %d bytes", limit)
+	}
+	return content, nil
+}
+
+// parseTime 按常见格式解析时间，不含时区的时间按本地时区解析
+func parseTime(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05 -0700", "2006-01-02 15:04:05 -07:00", time.RFC1123Z, time.RFC1123} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02T15:04", "2006-01-02 15:04", "2006-01-02", "2006/01/02 15:04:05", "2006/01/02"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,265 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestParseMarkdownYAML(t *testing.T) {
+	content := "---\ntitle: Hello World\ndate: 2020-01-02 03:04:05\nupdated: 2020-02-03 04:05:06\ncategories:\n  - Notes\ntags: [Go, Web]\n---\n\nBody text\n"
+	item, err := ParseMarkdown("source/_posts/hello.md", []byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.Title != "Hello World" || item.Slug != "hello" || item.Status != "published" {
+		t.Errorf("item = %+v", item)
+	}
+	if want := time.Date(2020, 1, 2, 3, 4, 5, 0, time.Local); !item.Created.Equal(want) {
+		t.Errorf("Created = %v, want %v", item.Created, want)
+	}
+	if item.Updated.IsZero() {
+		t.Errorf("Updated not parsed")
+	}
+	if !reflect.DeepEqual(item.Categories, []string{"Notes"}) || !reflect.DeepEqual(item.Tags, []string{"Go", "Web"}) {
+		t.Errorf("categories = %q, tags = %q", item.Categories, item.Tags)
+	}
+	if item.Markdown != "Body text\n" {
+		t.Errorf("Markdown = %q", item.Markdown)
+	}
+}
+
+func TestParseMarkdownTOML(t *testing.T) {
+	content := "+++\ntitle = \"Hugo Post\"\ndate = 2021-05-06T07:08:09Z\nlastmod = 2021-05-07\ndraft = true\nslug = \"My Post\"\n+++\nContent"
+	item, err := ParseMarkdown("content/posts/bundle/index.md", []byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.Title != "Hugo Post" || item.Slug != "my-post" || item.Status != "draft" {
+		t.Errorf("item = %+v", item)
+	}
+	if want := time.Date(2021, 5, 6, 7, 8, 9, 0, time.UTC); !item.Created.Equal(want) {
+		t.Errorf("Created = %v, want %v", item.Created, want)
+	}
+	if item.Updated.IsZero() {
+		t.Errorf("Updated not parsed from local date")
+	}
+}
+
+func TestParseMarkdownJekyll(t *testing.T) {
+	content := "---\nlayout: post\ntags: go web\npublished: true\n---\nText"
+	item, err := ParseMarkdown("_posts/2019-03-04-jekyll-post.md", []byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.Title != "jekyll-post" || item.Slug != "jekyll-post" {
+		t.Errorf("title = %q, slug = %q", item.Title, item.Slug)
+	}
+	if item.Created.Format("2006-01-02") != "2019-03-04" {
+		t.Errorf("Created = %v", item.Created)
+	}
+	if !reflect.DeepEqual(item.Tags, []string{"go", "web"}) {
+		t.Errorf("tags = %q", item.Tags)
+	}
+
+	draft, err := ParseMarkdown("_drafts/idea.md", []byte("---\ntitle: Idea\n---\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if draft.Status != "draft" {
+		t.Errorf("draft status = %q", draft.Status)
+	}
+}
+
+func TestParseMarkdownWithoutOpeningDelimiter(t *testing.T) {
+	item, err := ParseMarkdown("a.md", []byte("title: Hexo\n---\nBody"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Title != "Hexo" || item.Markdown != "Body" {
+		t.Errorf("item = %+v", item)
+	}
+
+	plain, err := ParseMarkdown("b.md", []byte("Intro\n\n---\n\nMore"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plain.Title != "b" || !strings.HasPrefix(plain.Markdown, "Intro") {
+		t.Errorf("plain = %+v", plain)
+	}
+
+	if _, err := ParseMarkdown("c.md", []byte("---\ntitle: x\n")); err == nil {
+		t.Errorf("expected error for unterminated front matter")
+	}
+}
+
+const testWXR = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:excerpt="http://wordpress.org/export/1.2/excerpt/" xmlns:content="http://purl.org/rss/1.0/modules/content/" xmlns:wp="http://wordpress.org/export/1.2/">
+<channel>
+	<item>
+		<title>Cover</title>
+		<wp:post_id>10</wp:post_id>
+		<wp:post_type>attachment</wp:post_type>
+		<wp:attachment_url>https://example.com/cover.jpg</wp:attachment_url>
+	</item>
+	<item>
+		<title>WordPress &amp; Go</title>
+		<content:encoded><![CDATA[<p>Hello</p>]]></content:encoded>
+		<excerpt:encoded><![CDATA[Short]]></excerpt:encoded>
+		<wp:post_id>11</wp:post_id>
+		<wp:post_date>2018-07-08 10:00:00</wp:post_date>
+		<wp:post_date_gmt>2018-07-08 02:00:00</wp:post_date_gmt>
+		<wp:post_modified_gmt>2018-07-09 02:00:00</wp:post_modified_gmt>
+		<wp:post_name>wordpress-go</wp:post_name>
+		<wp:status>publish</wp:status>
+		<wp:post_type>post</wp:post_type>
+		<category domain="category" nicename="dev"><![CDATA[Dev]]></category>
+		<category domain="post_tag" nicename="go"><![CDATA[Go]]></category>
+		<wp:postmeta><wp:meta_key>_thumbnail_id</wp:meta_key><wp:meta_value>10</wp:meta_value></wp:postmeta>
+	</item>
+	<item>
+		<title>About</title>
+		<wp:post_id>12</wp:post_id>
+		<wp:post_type>page</wp:post_type>
+	</item>
+	<item>
+		<title>Trashed</title>
+		<wp:post_id>13</wp:post_id>
+		<wp:status>trash</wp:status>
+		<wp:post_type>post</wp:post_type>
+	</item>
+	<item>
+		<title></title>
+		<wp:post_id>14</wp:post_id>
+		<wp:status>draft</wp:status>
+		<wp:post_type>post</wp:post_type>
+	</item>
+</channel>
+</rss>`
+
+func TestParseWXR(t *testing.T) {
+	items, failures, err := ParseWXR("export.xml", strings.NewReader(testWXR))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || len(failures) != 1 {
+		t.Fatalf("got %d items and %d failures, want 1 and 1", len(items), len(failures))
+	}
+	if failures[0].Source != "export.xml#14" {
+		t.Errorf("failure source = %q", failures[0].Source)
+	}
+
+	item := items[0]
+	want := &Item{
+		Source:      "export.xml#11",
+		Title:       "WordPress & Go",
+		Slug:        "wordpress-go",
+		Description: "Short",
+		Image:       "https://example.com/cover.jpg",
+		Status:      "published",
+		Categories:  []string{"Dev"},
+		Tags:        []string{"Go"},
+		Created:     time.Date(2018, 7, 8, 2, 0, 0, 0, time.UTC),
+		Updated:     time.Date(2018, 7, 9, 2, 0, 0, 0, time.UTC),
+		Markdown:    "<p>Hello</p>",
+	}
+	if !reflect.DeepEqual(item, want) {
+		t.Errorf("item = %+v, want %+v", item, want)
+	}
+}
+
+func TestParseZip(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	files := map[string]string{
+		"posts/a.md":          "---\ntitle: A\n---\nA",
+		"posts/_index.md":     "---\ntitle: Section\n---\n",
+		"posts/broken.md":     "---\ntitle: [\n---\n",
+		"posts/.hidden.md":    "---\ntitle: Hidden\n---\n",
+		"__MACOSX/posts/a.md": "junk",
+		"posts/readme.txt":    "ignored",
+	}
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(content))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Parse("export.zip", buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Items) != 1 || result.Items[0].Title != "A" {
+		t.Errorf("items = %+v", result.Items)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].Source != "posts/broken.md" {
+		t.Errorf("failures = %+v", result.Failures)
+	}
+
+	if _, err := Parse("export.rar", nil); err == nil {
+		t.Errorf("expected error for unsupported type")
+	}
+}
+
+func TestParseFSBudget(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.md": {Data: []byte("---\ntitle: A\n---\n" + strings.Repeat("a", 100))},
+		"b.md": {Data: []byte("---\ntitle: B\n---\n" + strings.Repeat("b", 100))},
+		"c.md": {Data: []byte("---\ntitle: C\n---\n" + strings.Repeat("c", 100))},
+	}
+
+	result := &Result{}
+	if err := parseFS(fsys, result, &budget{bytes: 1 << 10, entries: 10}); err != nil {
+		t.Fatalf("parseFS within budget: %v", err)
+	}
+	if len(result.Items) != 3 {
+		t.Errorf("items = %d, want 3", len(result.Items))
+	}
+
+	// 文件总大小超过额度时中止，不再继续读取后续文件
+	result = &Result{}
+	err := parseFS(fsys, result, &budget{bytes: 200, entries: 10})
+	if err == nil || !strings.Contains(err.Error(), "total file size") {
+		t.Errorf("parseFS over size budget err = %v", err)
+	}
+	if len(result.Items) != 1 {
+		t.Errorf("items before abort = %d, want 1", len(result.Items))
+	}
+
+	// 条目数超过额度时中止（根目录也计为一个条目）
+	err = parseFS(fsys, &Result{}, &budget{bytes: 1 << 10, entries: 3})
+	if err == nil || !strings.Contains(err.Error(), "too many entries") {
+		t.Errorf("parseFS over entry budget err = %v", err)
+	}
+}
+
+func TestParseZipBomb(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for i := range MaxTotalSize/MaxFileSize + 1 {
+		w, err := zw.Create(strings.Repeat("x", i+1) + ".md")
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(bytes.Repeat([]byte{'a'}, MaxFileSize))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Parse("bomb.zip", buf.Bytes()); err == nil || !strings.Contains(err.Error(), "total file size") {
+		t.Errorf("Parse(zip bomb) err = %v", err)
+	}
+}
@@ -0,0 +1,171 @@
+// Package importer 提供从 Hexo、Hugo、Jekyll 的 Markdown 文件与 WordPress WXR 导出文件解析文章的工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package importer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/slug"
+)
+
+// wxrDocument WordPress 导出文件（WXR），字段按本地名称匹配以兼容 1.0~1.2 各版本的命名空间
+type wxrDocument struct {
+	Items []*wxrItem `xml:"channel>item"`
+}
+
+// wxrItem WXR 条目，文章、页面与附件共用此结构
+type wxrItem struct {
+	Title         string        `xml:"title"`
+	PubDate       string        `xml:"pubDate"`
+	Encoded       []wxrEncoded  `xml:"encoded"` // content:encoded 正文与 excerpt:encoded 摘要
+	PostID        string        `xml:"post_id"`
+	PostDate      string        `xml:"post_date"`
+	PostDateGMT   string        `xml:"post_date_gmt"`
+	PostModified  string        `xml:"post_modified"`
+	ModifiedGMT   string        `xml:"post_modified_gmt"`
+	PostName      string        `xml:"post_name"`
+	Status        string        `xml:"status"`
+	PostType      string        `xml:"post_type"`
+	AttachmentURL string        `xml:"attachment_url"`
+	Categories    []wxrCategory `xml:"category"`
+	Meta          []wxrMeta     `xml:"postmeta"`
+}
+
+// wxrEncoded 带命名空间的编码内容
+type wxrEncoded struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+// wxrCategory 条目的分类或标签
+type wxrCategory struct {
+	Domain string `xml:"domain,attr"` // category 为分类，post_tag 为标签
+	Name   string `xml:",chardata"`
+}
+
+// wxrMeta 条目的自定义字段
+type wxrMeta struct {
+	Key   string `xml:"meta_key"`
+	Value string `xml:"meta_value"`
+}
+
+// ParseWXR 解析 WordPress 导出文件，仅导入文章（post），忽略页面、附件与回收站中的文章
+// 正文为 WordPress 保存的 HTML，原样作为 Markdown 内容保留；特色图片按附件地址设置为封面
+// 参数：
+//   - source: 文件名，用于标识条目来源
+//   - r: 文件内容
+//
+// 返回值：
+//   - []*Item: 解析得到的文章
+//   - []*Failure: 无法导入的条目
+//   - error: 文件不是合法的 XML 时返回错误
+func ParseWXR(source string, r io.Reader) ([]*Item, []*Failure, error) {
+	var doc wxrDocument
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse wxr file: %w", err)
+	}
+
+	attachments := make(map[string]string)
+	for _, it := range doc.Items {
+		if it.PostType == "attachment" && it.AttachmentURL != "" {
+			attachments[it.PostID] = it.AttachmentURL
+		}
+	}
+
+	var items []*Item
+	var failures []*Failure
+	for _, it := range doc.Items {
+		if it.PostType != "" && it.PostType != "post" {
+			continue
+		}
+
+		itemSource := source + "#" + it.PostID
+		status, ok := wxrStatus(it.Status)
+		if !ok {
+			continue
+		}
+		if strings.TrimSpace(it.Title) == "" {
+			failures = append(failures, &Failure{Source: itemSource, Error: "missing title"})
+			continue
+		}
+
+		item := &Item{
+			Source: itemSource,
+			Title:  strings.TrimSpace(it.Title),
+			Status: status,
+		}
+		for _, enc := range it.Encoded {
+			if strings.Contains(enc.XMLName.Space, "excerpt") {
+				item.Description = strings.TrimSpace(enc.Value)
+			} else {
+				item.Markdown = strings.TrimSpace(enc.Value)
+			}
+		}
+		for _, cat := range it.Categories {
+			name := strings.TrimSpace(cat.Name)
+			switch {
+			case name == "":
+			case cat.Domain == "category":
+				item.Categories = append(item.Categories, name)
+			case cat.Domain == "post_tag":
+				item.Tags = append(item.Tags, name)
+			}
+		}
+		for _, meta := range it.Meta {
+			if meta.Key == "_thumbnail_id" {
+				item.Image = attachments[meta.Value]
+			}
+		}
+
+		name, err := url.PathUnescape(it.PostName)
+		if err != nil {
+			name = it.PostName
+		}
+		item.Slug = slug.Generate(name)
+
+		item.Created = wxrTime(it.PostDateGMT, it.PostDate)
+		if item.Created.IsZero() {
+			item.Created, _ = parseTime(it.PubDate)
+		}
+		item.Updated = wxrTime(it.ModifiedGMT, it.PostModified)
+
+		items = append(items, item)
+	}
+
+	return items, failures, nil
+}
+
+// wxrStatus 将 WordPress 文章状态映射为文章状态，回收站等不导入的状态返回 false
+func wxrStatus(status string) (string, bool) {
+	switch status {
+	case "publish", "":
+		return consts.PostStatusPublished, true
+	case "future":
+		return consts.PostStatusScheduled, true
+	case "private":
+		return consts.PostStatusPrivate, true
+	case "draft", "pending", "auto-draft":
+		return consts.PostStatusDraft, true
+	}
+	return "", false
+}
+
+// wxrTime 解析 WordPress 时间，优先使用 UTC 时间，未设置（0000-00-00 00:00:00）时使用站点本地时间
+func wxrTime(gmt, local string) time.Time {
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", gmt, time.UTC); err == nil && t.Year() > 1 {
+		return t
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", local, time.Local); err == nil && t.Year() > 1 {
+		return t
+	}
+	return time.Time{}
+}
@@ -0,0 +1,68 @@
+// Package markdown 提供Markdown渲染工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package markdown
+
+import (
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// headingAnchorClass 标题锚点链接的 class
+const headingAnchorClass = "heading-anchor"
+
+// headingAnchorExtension 标题锚点扩展，为带 ID 的标题追加可点击的 # 锚点链接
+type headingAnchorExtension struct{}
+
+// Extend 实现 goldmark.Extender 接口
+func (e *headingAnchorExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithASTTransformers(
+		util.Prioritized(&headingAnchorTransformer{}, 500),
+	))
+}
+
+// headingAnchorTransformer 标题锚点 AST 转换器
+type headingAnchorTransformer struct{}
+
+// Transform 实现 parser.ASTTransformer 接口
+func (t *headingAnchorTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		heading, ok := n.(*ast.Heading)
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+
+		id, ok := heading.AttributeString("id")
+		if !ok {
+			return ast.WalkSkipChildren, nil
+		}
+		value, ok := id.([]byte)
+		if !ok || len(value) == 0 {
+			return ast.WalkSkipChildren, nil
+		}
+
+		anchor := ast.NewLink()
+		anchor.Destination = append([]byte("#"), value...)
+		anchor.SetAttributeString("class", []byte(headingAnchorClass))
+		anchor.AppendChild(anchor, ast.NewString([]byte("#")))
+		heading.AppendChild(heading, ast.NewString([]byte(" ")))
+		heading.AppendChild(heading, anchor)
+		return ast.WalkSkipChildren, nil
+	})
+}
+
+// isHeadingAnchor 判断链接是否为标题锚点扩展生成的锚点
+func isHeadingAnchor(link *ast.Link) bool {
+	class, ok := link.AttributeString("class")
+	if !ok {
+		return false
+	}
+	value, ok := class.([]byte)
+	return ok && string(value) == headingAnchorClass
+}
@@ -0,0 +1,108 @@
+// Package markdown 提供Markdown渲染工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package markdown
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// mermaidLanguage Mermaid 图表代码块的语言标识
+const mermaidLanguage = "mermaid"
+
+// codeBlockExtension 代码块渲染扩展，统一处理语法高亮与 Mermaid 图表
+// goldmark 中同一节点类型只能注册一个渲染函数，因此两者合并在同一扩展中实现
+type codeBlockExtension struct {
+	highlight   bool   // 是否启用语法高亮
+	mermaid     bool   // 是否将 mermaid 代码块原样输出供前端渲染
+	style       string // 高亮样式名称
+	lineNumbers bool   // 是否显示行号
+}
+
+// Extend 实现 goldmark.Extender 接口
+func (e *codeBlockExtension) Extend(m goldmark.Markdown) {
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(&codeBlockRenderer{codeBlockExtension: e}, 100),
+	))
+}
+
+// codeBlockRenderer 围栏代码块渲染器
+type codeBlockRenderer struct {
+	*codeBlockExtension
+}
+
+// RegisterFuncs 实现 renderer.NodeRenderer 接口
+func (r *codeBlockRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindFencedCodeBlock, r.renderFencedCodeBlock)
+}
+
+// renderFencedCodeBlock 渲染围栏代码块
+func (r *codeBlockRenderer) renderFencedCodeBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*ast.FencedCodeBlock)
+	language := strings.ToLower(string(n.Language(source)))
+
+	var code bytes.Buffer
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		code.Write(line.Value(source))
+	}
+
+	if r.mermaid && language == mermaidLanguage {
+		_, _ = w.WriteString(`<pre class="mermaid">`)
+		_, _ = w.Write(util.EscapeHTML(code.Bytes()))
+		_, _ = w.WriteString("</pre>\n")
+		return ast.WalkSkipChildren, nil
+	}
+
+	if r.highlight && language != "" {
+		if lexer := lexers.Get(language); lexer != nil {
+			var out bytes.Buffer
+			if err := r.highlightCode(&out, lexer, code.String()); err == nil {
+				_, _ = w.Write(out.Bytes())
+				_ = w.WriteByte('\n')
+				return ast.WalkSkipChildren, nil
+			}
+		}
+	}
+
+	// 未启用高亮、语言未知或高亮失败时按普通代码块输出
+	_, _ = w.WriteString("<pre><code")
+	if language != "" {
+		_, _ = w.WriteString(` class="language-`)
+		_, _ = w.Write(util.EscapeHTML([]byte(language)))
+		_ = w.WriteByte('"')
+	}
+	_ = w.WriteByte('>')
+	_, _ = w.Write(util.EscapeHTML(code.Bytes()))
+	_, _ = w.WriteString("</code></pre>\n")
+	return ast.WalkSkipChildren, nil
+}
+
+// highlightCode 使用 chroma 输出带内联样式的高亮代码，无需前端额外引入样式表
+func (r *codeBlockRenderer) highlightCode(out *bytes.Buffer, lexer chroma.Lexer, code string) error {
+	iterator, err := chroma.Coalesce(lexer).Tokenise(nil, code)
+	if err != nil {
+		return err
+	}
+
+	formatter := chromahtml.New(
+		chromahtml.WithClasses(false),
+		chromahtml.WithLineNumbers(r.lineNumbers),
+	)
+	return formatter.Format(out, styles.Get(r.style), iterator)
+}
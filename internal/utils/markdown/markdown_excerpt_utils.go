@@ -0,0 +1,151 @@
+// Package markdown 提供Markdown渲染工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package markdown
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// MoreSeparator 摘要分隔标记，标记之前的内容作为摘要
+const MoreSeparator = "<!--more-->"
+
+var (
+	moreSeparatorPattern = regexp.MustCompile(`(?i)<!--\s*more\s*-->`)                        // 摘要分隔标记，允许内部空白
+	htmlImagePattern     = regexp.MustCompile(`(?i)<img\b[^>]*?\bsrc\s*=\s*["']([^"']+)["']`) // 原始 HTML 中的图片地址
+)
+
+// Excerpt 生成摘要：存在 <!--more--> 标记时取标记之前的内容，否则取开头的段落，并在长度上限内按句子边界截断
+// 参数：
+//   - content: Markdown内容
+//   - maxLen: 摘要最大字符数
+//
+// 返回值：
+//   - string: 摘要纯文本，无可用内容时为空
+func Excerpt(content []byte, maxLen int) string {
+	if maxLen <= 0 {
+		return ""
+	}
+
+	var plain string
+	if loc := moreSeparatorPattern.FindIndex(content); loc != nil {
+		plain = ExtractText(content[:loc[0]])
+	} else {
+		plain = leadingParagraphs(content, maxLen)
+	}
+
+	return truncateAtSentence(strings.Join(strings.Fields(plain), " "), maxLen)
+}
+
+// FirstImage 获取内容中的第一张图片地址，同时识别 Markdown 图片与原始 HTML 中的 img 标签
+// 参数：
+//   - content: Markdown内容
+//
+// 返回值：
+//   - string: 图片地址，不存在时为空
+func FirstImage(content []byte) string {
+	doc := getRenderer(Options{}).Parser().Parse(text.NewReader(content))
+
+	var image string
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node := n.(type) {
+		case *ast.Image:
+			image = string(node.Destination)
+		case *ast.HTMLBlock:
+			var raw strings.Builder
+			lines := node.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				line := lines.At(i)
+				raw.Write(line.Value(content))
+			}
+			image = matchHTMLImage(raw.String())
+		case *ast.RawHTML:
+			var raw strings.Builder
+			for i := 0; i < node.Segments.Len(); i++ {
+				segment := node.Segments.At(i)
+				raw.Write(segment.Value(content))
+			}
+			image = matchHTMLImage(raw.String())
+		}
+
+		if image != "" {
+			return ast.WalkStop, nil
+		}
+		return ast.WalkContinue, nil
+	})
+
+	return strings.TrimSpace(image)
+}
+
+// leadingParagraphs 提取开头的顶层段落文本，累计长度达到上限后停止，标题、代码块、表格等不计入
+func leadingParagraphs(content []byte, maxLen int) string {
+	doc := getRenderer(Options{}).Parser().Parse(text.NewReader(content))
+
+	var paragraphs []string
+	length := 0
+	for n := doc.FirstChild(); n != nil && length < maxLen; n = n.NextSibling() {
+		if n.Kind() != ast.KindParagraph {
+			continue
+		}
+		paragraph := strings.TrimSpace(extractText(n, content))
+		if paragraph == "" {
+			continue
+		}
+		paragraphs = append(paragraphs, paragraph)
+		length += len([]rune(paragraph))
+	}
+
+	return strings.Join(paragraphs, " ")
+}
+
+// truncateAtSentence 在长度上限内截断文本，优先在句末标点处截断，其次在空白处截断并追加省略号
+func truncateAtSentence(plain string, maxLen int) string {
+	runes := []rune(plain)
+	if len(runes) <= maxLen {
+		return plain
+	}
+
+	// 句子边界不宜过于靠前，否则摘要过短
+	for i := maxLen - 1; i >= maxLen/3; i-- {
+		if isSentenceEnd(runes, i) {
+			return string(runes[:i+1])
+		}
+	}
+
+	cut := maxLen - 1
+	for i := cut; i >= maxLen/2; i-- {
+		if unicode.IsSpace(runes[i]) {
+			cut = i
+			break
+		}
+	}
+	return strings.TrimSpace(string(runes[:cut])) + "…"
+}
+
+// isSentenceEnd 判断指定位置是否为句末：中文句末标点直接成立，英文句末标点需后跟空白
+func isSentenceEnd(runes []rune, i int) bool {
+	switch runes[i] {
+	case '。', '！', '？', '；', '…':
+		return true
+	case '.', '!', '?':
+		return i+1 < len(runes) && unicode.IsSpace(runes[i+1])
+	}
+	return false
+}
+
+// matchHTMLImage 提取原始 HTML 中第一个 img 标签的地址
+func matchHTMLImage(raw string) string {
+	if match := htmlImagePattern.FindStringSubmatch(raw); match != nil {
+		return match[1]
+	}
+	return ""
+}
@@ -0,0 +1,245 @@
+// Package markdown 提供Markdown渲染工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// KindMathInline 行内公式节点类型
+var KindMathInline = ast.NewNodeKind("MathInline")
+
+// KindMathBlock 块级公式节点类型
+var KindMathBlock = ast.NewNodeKind("MathBlock")
+
+// MathInline 行内公式节点，对应 $...$ 与 $$...$$
+type MathInline struct {
+	ast.BaseInline
+	Value   []byte // 公式源码
+	Display bool   // 是否为行间展示公式
+}
+
+// Kind 实现 ast.Node 接口
+func (n *MathInline) Kind() ast.NodeKind {
+	return KindMathInline
+}
+
+// Dump 实现 ast.Node 接口
+func (n *MathInline) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Value": string(n.Value)}, nil)
+}
+
+// MathBlock 块级公式节点，对应以 $$ 独占一行包裹或单行书写的 $$...$$ 公式
+type MathBlock struct {
+	ast.BaseBlock
+	closed bool // 起始行内已出现结束标记
+}
+
+// Kind 实现 ast.Node 接口
+func (n *MathBlock) Kind() ast.NodeKind {
+	return KindMathBlock
+}
+
+// IsRaw 实现 ast.Node 接口，公式内容不再做行内解析
+func (n *MathBlock) IsRaw() bool {
+	return true
+}
+
+// Dump 实现 ast.Node 接口
+func (n *MathBlock) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, nil, nil)
+}
+
+// mathExtension 数学公式扩展，输出 KaTeX 可识别的 \(...\) 与 \[...\] 定界符，由前端完成排版
+type mathExtension struct{}
+
+// Extend 实现 goldmark.Extender 接口
+func (e *mathExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(util.Prioritized(&mathBlockParser{}, 150)),
+		parser.WithInlineParsers(util.Prioritized(&mathInlineParser{}, 150)),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(&mathRenderer{}, 150),
+	))
+}
+
+// mathInlineParser 行内公式解析器
+type mathInlineParser struct{}
+
+// Trigger 实现 parser.InlineParser 接口
+func (p *mathInlineParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+// Parse 实现 parser.InlineParser 接口
+// 规则与 Pandoc 一致：$ 内侧不能紧邻空白，结束 $ 后不能紧跟数字，避免误识别金额
+func (p *mathInlineParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+	display := len(line) > 1 && line[1] == '$'
+	open := 1
+	if display {
+		open = 2
+	}
+	if len(line) <= open || util.IsSpace(line[open]) {
+		return nil
+	}
+
+	for i := open; i < len(line); i++ {
+		switch {
+		case line[i] == '\\':
+			i++
+		case line[i] == '$':
+			if display {
+				if i+1 >= len(line) || line[i+1] != '$' {
+					continue
+				}
+			} else if util.IsSpace(line[i-1]) || (i+1 < len(line) && isDigit(line[i+1])) {
+				continue
+			}
+			// 以数字开头且含空白时视为金额，如 "$5 and $10"
+			if i == open || (!display && isDigit(line[open]) && bytes.ContainsAny(line[open:i], " \t")) {
+				return nil
+			}
+
+			node := &MathInline{Value: append([]byte(nil), line[open:i]...), Display: display}
+			block.Advance(i + open)
+			return node
+		}
+	}
+	return nil
+}
+
+// isDigit 判断字节是否为 ASCII 数字
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// mathBlockParser 块级公式解析器
+type mathBlockParser struct{}
+
+// Trigger 实现 parser.BlockParser 接口
+func (p *mathBlockParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+// Open 实现 parser.BlockParser 接口
+func (p *mathBlockParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || !bytes.HasPrefix(line[pos:], []byte("$$")) {
+		return nil, parser.NoChildren
+	}
+
+	node := &MathBlock{}
+	seg := text.NewSegment(segment.Start+pos+2, segment.Stop)
+	seg = seg.TrimLeftSpace(reader.Source())
+	seg = seg.TrimRightSpace(reader.Source())
+	if value := seg.Value(reader.Source()); bytes.HasSuffix(value, []byte("$$")) {
+		// 单行公式：$$ ... $$
+		seg.Stop -= 2
+		seg = seg.TrimRightSpace(reader.Source())
+		node.closed = true
+	} else if len(value) > 0 {
+		// 起始标记后仍有内容且未闭合时交由行内解析器处理
+		return nil, parser.NoChildren
+	}
+	if seg.Len() > 0 {
+		node.Lines().Append(seg)
+	}
+	reader.Advance(segment.Len() - 1)
+	return node, parser.NoChildren
+}
+
+// Continue 实现 parser.BlockParser 接口
+func (p *mathBlockParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	n := node.(*MathBlock)
+	if n.closed {
+		return parser.Close
+	}
+
+	line, segment := reader.PeekLine()
+	newline := 0
+	if len(line) > 0 && line[len(line)-1] == '\n' {
+		newline = 1
+	}
+
+	trimmed := util.TrimRightSpace(line)
+	if bytes.HasSuffix(trimmed, []byte("$$")) {
+		seg := text.NewSegment(segment.Start, segment.Start+len(trimmed)-2)
+		if !util.IsBlank(seg.Value(reader.Source())) {
+			n.Lines().Append(seg)
+		}
+		reader.Advance(segment.Len() - newline)
+		return parser.Close
+	}
+
+	n.Lines().Append(segment)
+	reader.Advance(segment.Len() - newline)
+	return parser.Continue | parser.NoChildren
+}
+
+// Close 实现 parser.BlockParser 接口
+func (p *mathBlockParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {}
+
+// CanInterruptParagraph 实现 parser.BlockParser 接口
+func (p *mathBlockParser) CanInterruptParagraph() bool {
+	return true
+}
+
+// CanAcceptIndentedLine 实现 parser.BlockParser 接口
+func (p *mathBlockParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// mathRenderer 公式渲染器
+type mathRenderer struct{}
+
+// RegisterFuncs 实现 renderer.NodeRenderer 接口
+func (r *mathRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindMathInline, r.renderMathInline)
+	reg.Register(KindMathBlock, r.renderMathBlock)
+}
+
+// renderMathInline 渲染行内公式
+func (r *mathRenderer) renderMathInline(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*MathInline)
+	if n.Display {
+		_, _ = w.WriteString(`<span class="math math-display">\[`)
+		_, _ = w.Write(util.EscapeHTML(n.Value))
+		_, _ = w.WriteString(`\]</span>`)
+	} else {
+		_, _ = w.WriteString(`<span class="math math-inline">\(`)
+		_, _ = w.Write(util.EscapeHTML(n.Value))
+		_, _ = w.WriteString(`\)</span>`)
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+// renderMathBlock 渲染块级公式
+func (r *mathRenderer) renderMathBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	_, _ = w.WriteString(`<div class="math math-display">\[`)
+	lines := node.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		_, _ = w.Write(util.EscapeHTML(line.Value(source)))
+	}
+	_, _ = w.WriteString("\\]</div>\n")
+	return ast.WalkSkipChildren, nil
+}
@@ -0,0 +1,131 @@
+// Package markdown 提供Markdown渲染工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package markdown
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// 阅读速度，中日韩文字按字计、其他文字按词计
+const (
+	CJKCharsPerMinute   = 300 // 中日韩文字每分钟阅读字数
+	LatinWordsPerMinute = 200 // 其他文字每分钟阅读词数
+)
+
+// TOCItem 目录项
+type TOCItem struct {
+	Level    int        `json:"level"`              // 标题级别（1-6）
+	Text     string     `json:"text"`               // 标题文本
+	ID       string     `json:"id"`                 // 标题锚点 ID
+	Children []*TOCItem `json:"children,omitempty"` // 下级目录项
+}
+
+// Result 渲染结果
+type Result struct {
+	HTML        string     // 渲染后的 HTML
+	TOC         []*TOCItem // 嵌套目录
+	WordCount   int        // 字数，中日韩文字每字计一，其他文字按空白与标点分词计数
+	ReadingTime int        // 预计阅读时长（分钟），有内容时至少为 1
+}
+
+// buildTOC 根据语法树中带 ID 的标题构建嵌套目录，跳级的标题挂在最近的上级标题下
+func buildTOC(doc ast.Node, source []byte) []*TOCItem {
+	var (
+		root  []*TOCItem
+		stack []*TOCItem
+	)
+
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		heading, ok := n.(*ast.Heading)
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+
+		id, _ := heading.AttributeString("id")
+		idValue, _ := id.([]byte)
+		item := &TOCItem{
+			Level: heading.Level,
+			Text:  strings.TrimSpace(strings.Join(strings.Fields(extractText(heading, source)), " ")),
+			ID:    string(idValue),
+		}
+		if item.Text == "" {
+			return ast.WalkSkipChildren, nil
+		}
+
+		for len(stack) > 0 && stack[len(stack)-1].Level >= item.Level {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			root = append(root, item)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, item)
+		}
+		stack = append(stack, item)
+		return ast.WalkSkipChildren, nil
+	})
+
+	return root
+}
+
+// CountWords 统计字数，中日韩文字每字计一，其他连续的字母数字计为一个词
+// 参数：
+//   - text: 纯文本内容
+//
+// 返回值：
+//   - int: 字数
+func CountWords(text string) int {
+	cjk, latin := countWords(text)
+	return cjk + latin
+}
+
+// ReadingTime 根据文本估算阅读时长，中日韩文字与其他文字分别按各自的阅读速度计算
+// 参数：
+//   - text: 纯文本内容
+//
+// 返回值：
+//   - int: 预计阅读时长（分钟），有内容时至少为 1
+func ReadingTime(text string) int {
+	cjk, latin := countWords(text)
+	if cjk+latin == 0 {
+		return 0
+	}
+	minutes := float64(cjk)/CJKCharsPerMinute + float64(latin)/LatinWordsPerMinute
+	return int(math.Max(1, math.Ceil(minutes)))
+}
+
+// countWords 分别统计中日韩文字字数与其他文字词数
+func countWords(text string) (cjk, latin int) {
+	inWord := false
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			cjk++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			if !inWord {
+				latin++
+				inWord = true
+			}
+		case inWord && (r == '\'' || r == '_'):
+			// 词内的撇号与下划线不拆分，如 don't、snake_case
+		default:
+			inWord = false
+		}
+	}
+	return cjk, latin
+}
+
+// isCJK 判断字符是否为中日韩文字
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
@@ -5,13 +5,17 @@ package markdown
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/extension"
 	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/renderer"
 	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
 )
 
 // 使用 sync.Pool 复用 buffer
@@ -42,21 +46,107 @@ func NewMarkdownRenderer(config MarkdownConfig) goldmark.Markdown {
 	)
 }
 
-// defaultMarkdownConfig 返回默认的 Markdown 配置
+// 可按站点配置启用的扩展名称
+const (
+	ExtensionLinkify        = "linkify"         // 自动链接
+	ExtensionGFM            = "gfm"             // GitHub Flavored Markdown（含表格、任务列表、删除线与自动链接）
+	ExtensionTable          = "table"           // 表格
+	ExtensionTaskList       = "tasklist"        // 任务列表
+	ExtensionStrikethrough  = "strikethrough"   // 删除线
+	ExtensionFootnote       = "footnote"        // 脚注
+	ExtensionDefinitionList = "definition_list" // 定义列表
+	ExtensionTypographer    = "typographer"     // 排版符号替换
+	ExtensionHighlight      = "highlight"       // 代码语法高亮
+	ExtensionMath           = "math"            // KaTeX 数学公式
+	ExtensionMermaid        = "mermaid"         // Mermaid 图表
+	ExtensionHeadingAnchor  = "heading_anchor"  // 标题锚点
+)
+
+// DefaultHighlightStyle 默认代码高亮样式
+const DefaultHighlightStyle = "github"
+
+// Options 渲染选项，由站点配置转换而来
+type Options struct {
+	Extensions           []string // 启用的扩展名称，为空时使用默认扩展
+	HighlightStyle       string   // 代码高亮样式名称
+	HighlightLineNumbers bool     // 代码高亮是否显示行号
+}
+
+// defaultExtensions 未配置扩展时启用的扩展
+var defaultExtensions = []string{
+	ExtensionLinkify,
+	ExtensionGFM,
+	ExtensionTable,
+	ExtensionTaskList,
+	ExtensionStrikethrough,
+	ExtensionFootnote,
+	ExtensionDefinitionList,
+	ExtensionTypographer,
+}
+
+// builtinExtensions 扩展名称与 Goldmark 扩展的映射
+var builtinExtensions = map[string]goldmark.Extender{
+	ExtensionLinkify:        extension.Linkify,
+	ExtensionGFM:            extension.GFM,
+	ExtensionTable:          extension.Table,
+	ExtensionTaskList:       extension.TaskList,
+	ExtensionStrikethrough:  extension.Strikethrough,
+	ExtensionFootnote:       extension.Footnote,
+	ExtensionDefinitionList: extension.DefinitionList,
+	ExtensionTypographer:    extension.Typographer,
+	ExtensionMath:           &mathExtension{},
+	ExtensionHeadingAnchor:  &headingAnchorExtension{},
+}
+
+// NewMarkdownConfig 根据渲染选项构建 Markdown 配置，未知的扩展名称将被忽略
+// 参数：
+//   - opts: 渲染选项
+//
+// 返回值：
+//   - MarkdownConfig: Markdown配置
+func NewMarkdownConfig(opts Options) MarkdownConfig {
+	names := opts.Extensions
+	if len(names) == 0 {
+		names = defaultExtensions
+	}
+
+	config := defaultMarkdownConfig()
+	codeBlock := &codeBlockExtension{style: opts.HighlightStyle, lineNumbers: opts.HighlightLineNumbers}
+	if codeBlock.style == "" {
+		codeBlock.style = DefaultHighlightStyle
+	}
+
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		switch name {
+		case ExtensionHighlight:
+			codeBlock.highlight = true
+		case ExtensionMermaid:
+			codeBlock.mermaid = true
+		default:
+			if ext, ok := builtinExtensions[name]; ok {
+				config.Extensions = append(config.Extensions, ext)
+			}
+		}
+	}
+	if codeBlock.highlight || codeBlock.mermaid {
+		config.Extensions = append(config.Extensions, codeBlock)
+	}
+
+	return config
+}
+
+// defaultMarkdownConfig 返回不含扩展的基础 Markdown 配置
 // 返回值：
-//   - MarkdownConfig: 默认Markdown配置
+//   - MarkdownConfig: 基础Markdown配置
 func defaultMarkdownConfig() MarkdownConfig {
 	return MarkdownConfig{
-		Extensions: []goldmark.Extender{
-			extension.Linkify,        // 自动链接支持
-			extension.GFM,            // 启用 GitHub Flavored Markdown
-			extension.Table,          // 表格支持
-			extension.TaskList,       // 任务列表支持
-			extension.Strikethrough,  // 删除线支持
-			extension.Footnote,       // 脚注支持
-			extension.DefinitionList, // 定义列表支持
-			extension.Typographer,    // Typography support
-		},
 		ParserOptions: []parser.Option{
 			parser.WithAutoHeadingID(),         // 自动生成标题 ID
 			parser.WithBlockParsers(),          // 块解析器
@@ -73,7 +163,28 @@ func defaultMarkdownConfig() MarkdownConfig {
 	}
 }
 
-// RenderMarkdown 将 Markdown 渲染为 HTML
+// 渲染器缓存，渲染选项变化时重新构建
+var (
+	rendererMu    sync.Mutex
+	rendererKey   string
+	rendererCache goldmark.Markdown
+)
+
+// getRenderer 获取与渲染选项对应的渲染器
+func getRenderer(opts Options) goldmark.Markdown {
+	key := fmt.Sprintf("%v|%s|%t", opts.Extensions, opts.HighlightStyle, opts.HighlightLineNumbers)
+
+	rendererMu.Lock()
+	defer rendererMu.Unlock()
+
+	if rendererCache == nil || rendererKey != key {
+		rendererCache = NewMarkdownRenderer(NewMarkdownConfig(opts))
+		rendererKey = key
+	}
+	return rendererCache
+}
+
+// RenderMarkdown 使用默认扩展将 Markdown 渲染为 HTML
 // 参数：
 //   - content: Markdown内容
 //
@@ -81,14 +192,91 @@ func defaultMarkdownConfig() MarkdownConfig {
 //   - string: 渲染后的 HTML
 //   - error: 渲染过程中的错误
 func RenderMarkdown(content []byte) (string, error) {
-	md := NewMarkdownRenderer(defaultMarkdownConfig())
+	result, err := Render(content, Options{})
+	if err != nil {
+		return "", err
+	}
+	return result.HTML, nil
+}
+
+// Render 按渲染选项将 Markdown 渲染为 HTML，同时基于同一棵语法树提取目录、字数与阅读时长
+// 参数：
+//   - content: Markdown内容
+//   - opts: 渲染选项
+//
+// 返回值：
+//   - *Result: 渲染结果
+//   - error: 渲染过程中的错误
+func Render(content []byte, opts Options) (*Result, error) {
+	md := getRenderer(opts)
+	doc := md.Parser().Parse(text.NewReader(content))
+
 	buf := bufferPool.Get().(*bytes.Buffer)
 	buf.Reset()
 	defer bufferPool.Put(buf)
 
-	if err := md.Convert(content, buf); err != nil {
-		return "", err
+	if err := md.Renderer().Render(buf, content, doc); err != nil {
+		return nil, err
 	}
 
-	return buf.String(), nil
+	plain := extractText(doc, content)
+	return &Result{
+		HTML:        buf.String(),
+		TOC:         buildTOC(doc, content),
+		WordCount:   CountWords(plain),
+		ReadingTime: ReadingTime(plain),
+	}, nil
+}
+
+// ExtractText 提取 Markdown 中的纯文本内容，忽略标记与原始 HTML，块级元素之间以换行分隔
+// 参数：
+//   - content: Markdown内容
+//
+// 返回值：
+//   - string: 纯文本内容
+func ExtractText(content []byte) string {
+	md := getRenderer(Options{})
+	doc := md.Parser().Parse(text.NewReader(content))
+	return extractText(doc, content)
+}
+
+// extractText 提取语法树中的纯文本内容，忽略原始 HTML 与标题锚点
+func extractText(doc ast.Node, content []byte) string {
+	var sb strings.Builder
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			if n.Type() == ast.TypeBlock && sb.Len() > 0 && !strings.HasSuffix(sb.String(), "\n") {
+				sb.WriteByte('\n')
+			}
+			return ast.WalkContinue, nil
+		}
+
+		switch node := n.(type) {
+		case *ast.Text:
+			sb.Write(node.Segment.Value(content))
+			if node.SoftLineBreak() || node.HardLineBreak() {
+				sb.WriteByte(' ')
+			}
+		case *ast.String:
+			sb.Write(node.Value)
+		case *ast.AutoLink:
+			sb.Write(node.Label(content))
+		case *ast.FencedCodeBlock, *ast.CodeBlock:
+			lines := n.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				line := lines.At(i)
+				sb.Write(line.Value(content))
+			}
+			return ast.WalkSkipChildren, nil
+		case *ast.HTMLBlock, *ast.RawHTML:
+			return ast.WalkSkipChildren, nil
+		case *ast.Link:
+			if isHeadingAnchor(node) {
+				return ast.WalkSkipChildren, nil
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	return strings.TrimSpace(sb.String())
 }
@@ -0,0 +1,89 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	src := "# Title\n\nCosts $5 and $10, while $a_1 < b$ is math.\n\n$$\nx^2\n$$\n\n" +
+		"```go\nfunc main() {}\n```\n\n```mermaid\ngraph TD; A-->B\n```\n"
+
+	result, err := Render([]byte(src), Options{
+		Extensions: []string{ExtensionGFM, ExtensionHighlight, ExtensionMath, ExtensionMermaid, ExtensionHeadingAnchor},
+	})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	got := result.HTML
+
+	for _, want := range []string{
+		`<a href="#title" class="heading-anchor">#</a>`,
+		`Costs $5 and $10, while <span class="math math-inline">\(a_1 &lt; b\)</span>`,
+		`<div class="math math-display">\[x^2` + "\n" + `\]</div>`,
+		`<pre class="mermaid">graph TD; A--&gt;B`,
+		`<span style="color:#000;font-weight:bold">func</span>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Render() missing %q:\n%s", want, got)
+		}
+	}
+
+	plain, err := RenderMarkdown([]byte(src))
+	if err != nil {
+		t.Fatalf("RenderMarkdown() error = %v", err)
+	}
+	for _, unwanted := range []string{"heading-anchor", "math-inline", `class="mermaid"`, "style="} {
+		if strings.Contains(plain, unwanted) {
+			t.Errorf("RenderMarkdown() unexpectedly contains %q:\n%s", unwanted, plain)
+		}
+	}
+}
+
+func TestRenderMeta(t *testing.T) {
+	src := "# 简介\n\nHello world, 你好世界。\n\n### Deep\n\n## Usage {#usage}\n\ntext\n"
+
+	result, err := Render([]byte(src), Options{Extensions: []string{ExtensionHeadingAnchor}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	if len(result.TOC) != 1 || result.TOC[0].Text != "简介" || len(result.TOC[0].Children) != 2 {
+		t.Fatalf("Render() TOC = %+v", result.TOC)
+	}
+	if child := result.TOC[0].Children[1]; child.Level != 2 || child.ID != "usage" || child.Text != "Usage" {
+		t.Errorf("Render() TOC child = %+v", child)
+	}
+	// 简介(2) + Hello world(2) + 你好世界(4) + Deep(1) + Usage(1) + text(1)
+	if result.WordCount != 11 {
+		t.Errorf("Render() WordCount = %d, want 11", result.WordCount)
+	}
+	if result.ReadingTime != 1 {
+		t.Errorf("Render() ReadingTime = %d, want 1", result.ReadingTime)
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	tests := []struct {
+		name, src, want string
+	}{
+		{"sentence boundary", "# Title\n\nFirst sentence here. Second sentence is longer than the rest.\n", "First sentence here."},
+		{"more separator", "Intro paragraph.\n\n<!--more-->\n\nHidden body.", "Intro paragraph."},
+		{"cjk", "```\ncode\n```\n\n第一句话写在这里，稍微长一点。第二句话比较长，会超过四十个字符的上限，因此应当被截掉。", "第一句话写在这里，稍微长一点。"},
+		{"word boundary", "one two three four five six seven eight nine ten eleven", "one two three four five six seven eight…"},
+	}
+	for _, tt := range tests {
+		if got := Excerpt([]byte(tt.src), 40); got != tt.want {
+			t.Errorf("%s: Excerpt() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFirstImage(t *testing.T) {
+	if got := FirstImage([]byte("text\n\n![a](/img/a.png) ![b](/img/b.png)")); got != "/img/a.png" {
+		t.Errorf("FirstImage() = %q", got)
+	}
+	if got := FirstImage([]byte(`<div><img alt="x" src="https://example.com/c.jpg"></div>`)); got != "https://example.com/c.jpg" {
+		t.Errorf("FirstImage() html = %q", got)
+	}
+}
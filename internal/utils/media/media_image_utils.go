@@ -0,0 +1,362 @@
+// Package media 提供媒体文件识别与存储键生成工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"path"
+	"slices"
+	"strings"
+
+	xdraw "golang.org/x/image/draw"
+)
+
+// Variant 图片变体，Size 为 0 表示尚未生成
+type Variant struct {
+	Width    int    `json:"width"`     // 宽度（像素）
+	Height   int    `json:"height"`    // 高度（像素）
+	MimeType string `json:"mime_type"` // MIME 类型
+	Key      string `json:"key"`       // 存储中的文件键
+	Size     int64  `json:"size"`      // 文件大小（字节）
+}
+
+// ErrTooManyPixels 图片像素数超出处理上限
+var ErrTooManyPixels = errors.New("image exceeds pixel limit")
+
+// VariantOptions 图片变体生成选项
+type VariantOptions struct {
+	Widths []int // 变体宽度（像素），仅生成小于原图宽度的变体
+	WebP   bool  // 是否生成 WebP 版本
+}
+
+// IsProcessableImage 判断 MIME 类型是否为可处理的位图
+// 参数：
+//   - mimeType: MIME 类型
+//
+// 返回值：
+//   - bool: 是否可处理
+func IsProcessableImage(mimeType string) bool {
+	switch mimeType {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		return true
+	}
+	return false
+}
+
+// IsAnimated 判断 GIF 或 WebP 图片是否为动图，动图不生成变体
+// 参数：
+//   - content: 图片内容
+//   - mimeType: MIME 类型
+//
+// 返回值：
+//   - bool: 是否为动图
+func IsAnimated(content []byte, mimeType string) bool {
+	switch mimeType {
+	case "image/gif":
+		return gifFrames(content) > 1
+	case "image/webp":
+		// VP8X 头的动画标记位
+		return len(content) >= 21 && string(content[12:16]) == "VP8X" && content[20]&0x02 != 0
+	}
+	return false
+}
+
+// CheckPixels 按图片头部声明的尺寸检查像素数，不解码像素数据；GIF 按帧数累计，避免体积小而尺寸极大的图片在解码时耗尽内存
+// 参数：
+//   - content: 图片内容
+//   - maxPixels: 像素数上限，不大于 0 时不限制
+//
+// 返回值：
+//   - error: 无法识别尺寸或超出上限时返回错误
+func CheckPixels(content []byte, maxPixels int64) error {
+	if maxPixels <= 0 {
+		return nil
+	}
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return fmt.Errorf("failed to decode image config: %w", err)
+	}
+	pixels := int64(cfg.Width) * int64(cfg.Height)
+	if format == "gif" {
+		pixels *= int64(max(1, gifFrames(content)))
+	}
+	if pixels > maxPixels {
+		return fmt.Errorf("%w: %dx%d (%d pixels) exceeds %d", ErrTooManyPixels, cfg.Width, cfg.Height, pixels, maxPixels)
+	}
+	return nil
+}
+
+// Normalize 规范化上传的图片：JPEG 带有旋转方向时按方向旋转像素后重新编码，否则仅移除元数据
+// 参数：
+//   - content: 图片内容
+//   - mimeType: MIME 类型
+//   - quality: JPEG 重新编码质量（1-100）
+//   - maxPixels: 允许解码的像素数上限，不大于 0 时不限制
+//
+// 返回值：
+//   - []byte: 规范化后的内容
+//   - error: 处理过程中的错误
+func Normalize(content []byte, mimeType string, quality int, maxPixels int64) ([]byte, error) {
+	if mimeType == "image/jpeg" && Orientation(content) != 1 {
+		img, err := Decode(content, maxPixels)
+		if err != nil {
+			return nil, err
+		}
+		return Encode(img, mimeType, quality)
+	}
+	return StripMetadata(content, mimeType)
+}
+
+// Decode 解码图片，JPEG 按 EXIF 方向旋转为正常显示方向；解码前检查像素数上限
+// 参数：
+//   - content: 图片内容
+//   - maxPixels: 像素数上限，不大于 0 时不限制
+//
+// 返回值：
+//   - image.Image: 图片
+//   - error: 超出像素数上限或解码失败时返回错误
+func Decode(content []byte, maxPixels int64) (image.Image, error) {
+	if err := CheckPixels(content, maxPixels); err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	return applyOrientation(img, Orientation(content)), nil
+}
+
+// Resize 按宽度等比缩放图片
+// 参数：
+//   - img: 原图
+//   - width: 目标宽度（像素）
+//
+// 返回值：
+//   - image.Image: 缩放后的图片
+func Resize(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	height := ScaledHeight(bounds.Dx(), bounds.Dy(), width)
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, xdraw.Src, nil)
+	return dst
+}
+
+// ScaledHeight 计算等比缩放后的高度
+// 参数：
+//   - width: 原图宽度
+//   - height: 原图高度
+//   - target: 目标宽度
+//
+// 返回值：
+//   - int: 目标高度，至少为 1
+func ScaledHeight(width, height, target int) int {
+	if width <= 0 {
+		return 1
+	}
+	return max(1, (height*target+width/2)/width)
+}
+
+// Encode 按 MIME 类型编码图片，仅支持 JPEG 与 PNG
+// 参数：
+//   - img: 图片
+//   - mimeType: 目标 MIME 类型
+//   - quality: JPEG 编码质量（1-100）
+//
+// 返回值：
+//   - []byte: 编码后的内容
+//   - error: 编码过程中的错误
+func Encode(img image.Image, mimeType string, quality int) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch mimeType {
+	case "image/jpeg":
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality})
+	case "image/png":
+		err = png.Encode(&buf, img)
+	default:
+		return nil, fmt.Errorf("unsupported encode type: %s", mimeType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", mimeType, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// IsOpaque 判断图片是否不含透明像素
+// 参数：
+//   - img: 图片
+//
+// 返回值：
+//   - bool: 是否不透明
+func IsOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
+
+// VariantMimeType 获取非 WebP 变体的 MIME 类型：JPEG 与不透明的 WebP 使用 JPEG，其余使用 PNG 以保留透明度
+// 参数：
+//   - mimeType: 原图 MIME 类型
+//   - opaque: 原图是否不透明
+//
+// 返回值：
+//   - string: 变体 MIME 类型
+func VariantMimeType(mimeType string, opaque bool) string {
+	if mimeType == "image/jpeg" || (mimeType == "image/webp" && opaque) {
+		return "image/jpeg"
+	}
+	return "image/png"
+}
+
+// VariantKey 根据原图存储键生成变体存储键
+// 参数：
+//   - key: 原图存储键
+//   - width: 变体宽度，为 0 时表示原尺寸
+//   - mimeType: 变体 MIME 类型
+//
+// 返回值：
+//   - string: 变体存储键，如 ab/cd/abcd...1234_w640.webp
+func VariantKey(key string, width int, mimeType string) string {
+	base := strings.TrimSuffix(key, path.Ext(key))
+	if width > 0 {
+		base += fmt.Sprintf("_w%d", width)
+	}
+	return base + Extension(mimeType)
+}
+
+// PlanVariants 规划图片变体：每个小于原图宽度的配置宽度生成一个与原图同类的变体，启用 WebP 时另生成对应的 WebP 版本及原尺寸 WebP 版本
+// 参数：
+//   - key: 原图存储键
+//   - mimeType: 原图 MIME 类型
+//   - opaque: 原图是否不透明
+//   - width: 原图宽度
+//   - height: 原图高度
+//   - opts: 变体生成选项
+//
+// 返回值：
+//   - []Variant: 变体列表（尚未生成），按宽度升序
+func PlanVariants(key, mimeType string, opaque bool, width, height int, opts VariantOptions) []Variant {
+	widths := make([]int, 0, len(opts.Widths))
+	for _, w := range opts.Widths {
+		if w > 0 && w < width && !slices.Contains(widths, w) {
+			widths = append(widths, w)
+		}
+	}
+	slices.Sort(widths)
+
+	fallback := VariantMimeType(mimeType, opaque)
+	variants := make([]Variant, 0, len(widths)*2+1)
+	for _, w := range widths {
+		h := ScaledHeight(width, height, w)
+		variants = append(variants, Variant{Width: w, Height: h, MimeType: fallback, Key: VariantKey(key, w, fallback)})
+		if opts.WebP {
+			variants = append(variants, Variant{Width: w, Height: h, MimeType: "image/webp", Key: VariantKey(key, w, "image/webp")})
+		}
+	}
+	if opts.WebP && mimeType != "image/webp" {
+		variants = append(variants, Variant{Width: width, Height: height, MimeType: "image/webp", Key: VariantKey(key, 0, "image/webp")})
+	}
+	return variants
+}
+
+// applyOrientation 按 EXIF 方向旋转或翻转图片
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	src := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+	w, h := b.Dx(), b.Dy()
+
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2: // 水平翻转
+				sx, sy = w-1-x, y
+			case 3: // 旋转 180 度
+				sx, sy = w-1-x, h-1-y
+			case 4: // 垂直翻转
+				sx, sy = x, h-1-y
+			case 5: // 沿主对角线翻转
+				sx, sy = y, x
+			case 6: // 顺时针旋转 90 度
+				sx, sy = y, h-1-x
+			case 7: // 沿副对角线翻转
+				sx, sy = w-1-y, h-1-x
+			case 8: // 逆时针旋转 90 度
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], src.Pix[src.PixOffset(sx, sy):src.PixOffset(sx, sy)+4])
+		}
+	}
+	return dst
+}
+
+// gifFrames 遍历 GIF 数据块统计帧数，不解码像素数据；格式错误时返回已统计的帧数
+func gifFrames(content []byte) int {
+	if len(content) < 13 {
+		return 0
+	}
+	pos := 13
+	if content[10]&0x80 != 0 { // 全局颜色表
+		pos += 3 << (content[10]&0x07 + 1)
+	}
+
+	frames := 0
+	for pos < len(content) {
+		switch content[pos] {
+		case 0x21: // 扩展块：标签之后为数据子块
+			pos = skipSubBlocks(content, pos+2)
+		case 0x2C: // 图像描述符
+			frames++
+			if pos+10 > len(content) {
+				return frames
+			}
+			flags := content[pos+9]
+			pos += 10
+			if flags&0x80 != 0 { // 局部颜色表
+				pos += 3 << (flags&0x07 + 1)
+			}
+			pos = skipSubBlocks(content, pos+1) // 跳过 LZW 最小码长
+		default: // 结束符或格式错误
+			return frames
+		}
+	}
+	return frames
+}
+
+// skipSubBlocks 跳过以长度为 0 的子块结尾的 GIF 数据子块序列，返回其后的位置
+func skipSubBlocks(content []byte, pos int) int {
+	for pos < len(content) {
+		n := int(content[pos])
+		pos += n + 1
+		if n == 0 {
+			break
+		}
+	}
+	return pos
+}
+
+// orientedSize 按 EXIF 方向调整图片尺寸，方向为 5~8 时宽高互换
+func orientedSize(content []byte, width, height int) (int, int) {
+	if Orientation(content) >= 5 {
+		return height, width
+	}
+	return width, height
+}
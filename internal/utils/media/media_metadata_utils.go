@@ -0,0 +1,257 @@
+// Package media 提供媒体文件识别与存储键生成工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+var (
+	pngSignature = []byte("\x89PNG\r\n\x1a\n")
+	exifHeader   = []byte("Exif\x00\x00")
+)
+
+// PNG 中需要移除的元数据块：EXIF、文本注释与修改时间
+var pngMetadataChunks = map[string]bool{
+	"eXIf": true,
+	"tEXt": true,
+	"zTXt": true,
+	"iTXt": true,
+	"tIME": true,
+}
+
+// StripMetadata 移除图片中的 EXIF（含 GPS）、XMP、IPTC 与注释等元数据，不重新编码图片数据
+// 保留 JFIF、ICC 色彩配置与 Adobe 标记，以免影响颜色显示；GIF 及其他类型原样返回
+// 参数：
+//   - content: 图片内容
+//   - mimeType: MIME 类型
+//
+// 返回值：
+//   - []byte: 移除元数据后的内容
+//   - error: 图片结构损坏时返回错误
+func StripMetadata(content []byte, mimeType string) ([]byte, error) {
+	switch mimeType {
+	case "image/jpeg":
+		return stripJPEGMetadata(content)
+	case "image/png":
+		return stripPNGMetadata(content)
+	case "image/webp":
+		return stripWebPMetadata(content)
+	default:
+		return content, nil
+	}
+}
+
+// Orientation 读取 JPEG 图片 EXIF 中的方向标记
+// 参数：
+//   - content: 图片内容
+//
+// 返回值：
+//   - int: 方向（1-8），无 EXIF 或无法识别时为 1
+func Orientation(content []byte) int {
+	if len(content) < 4 || content[0] != 0xFF || content[1] != 0xD8 {
+		return 1
+	}
+
+	orientation := 1
+	_, _ = walkJPEGSegments(content, func(marker byte, segment []byte) bool {
+		if marker != 0xE1 || len(segment) < 4 || !bytes.HasPrefix(segment[4:], exifHeader) {
+			return true
+		}
+		orientation = exifOrientation(segment[4+len(exifHeader):])
+		return false
+	})
+	return orientation
+}
+
+// stripJPEGMetadata 移除 JPEG 中的 APP1（EXIF/XMP）、APP3~APP13、APP15 与注释段
+func stripJPEGMetadata(content []byte) ([]byte, error) {
+	if len(content) < 4 || content[0] != 0xFF || content[1] != 0xD8 {
+		return nil, errors.New("invalid jpeg data")
+	}
+
+	out := make([]byte, 0, len(content))
+	out = append(out, 0xFF, 0xD8)
+	end, err := walkJPEGSegments(content, func(marker byte, segment []byte) bool {
+		if !isJPEGMetadataMarker(marker) {
+			out = append(out, segment...)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 扫描数据及之后的内容原样保留
+	return append(out, content[end:]...), nil
+}
+
+// isJPEGMetadataMarker 判断 JPEG 段是否为需要移除的元数据段
+func isJPEGMetadataMarker(marker byte) bool {
+	switch {
+	case marker == 0xE1: // EXIF、XMP
+		return true
+	case marker >= 0xE3 && marker <= 0xED: // 厂商扩展、IPTC/Photoshop
+		return true
+	case marker == 0xEF, marker == 0xFE: // APP15、注释
+		return true
+	}
+	return false
+}
+
+// walkJPEGSegments 依次遍历 JPEG 中扫描数据之前的段（含标记字节），回调返回 false 时停止，处理完 SOS 段或 EOI 后停止
+// 返回值为停止遍历时的偏移量，即尚未遍历内容的起始位置
+func walkJPEGSegments(content []byte, fn func(marker byte, segment []byte) bool) (int, error) {
+	pos := 2
+	for pos < len(content) {
+		if content[pos] != 0xFF {
+			return pos, fmt.Errorf("invalid jpeg marker at offset %d", pos)
+		}
+		start := pos
+		// 跳过填充字节
+		for pos < len(content) && content[pos] == 0xFF {
+			pos++
+		}
+		if pos >= len(content) {
+			return pos, errors.New("unexpected end of jpeg data")
+		}
+		marker := content[pos]
+		pos++
+
+		// 无长度字段的独立标记
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD9) {
+			if !fn(marker, content[start:pos]) || marker == 0xD9 {
+				return pos, nil
+			}
+			continue
+		}
+
+		if pos+2 > len(content) {
+			return pos, errors.New("unexpected end of jpeg data")
+		}
+		length := int(binary.BigEndian.Uint16(content[pos : pos+2]))
+		if length < 2 || pos+length > len(content) {
+			return pos, fmt.Errorf("invalid jpeg segment length at offset %d", pos)
+		}
+		pos += length
+
+		if !fn(marker, content[start:pos]) || marker == 0xDA {
+			return pos, nil
+		}
+	}
+	return pos, nil
+}
+
+// exifOrientation 从 TIFF 结构的 IFD0 中读取方向标记
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[offset : offset+2]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:entry+2]) != 0x0112 {
+			continue
+		}
+		value := int(order.Uint16(tiff[entry+8 : entry+10]))
+		if value < 1 || value > 8 {
+			return 1
+		}
+		return value
+	}
+	return 1
+}
+
+// stripPNGMetadata 移除 PNG 中的 EXIF、文本与时间块
+func stripPNGMetadata(content []byte) ([]byte, error) {
+	if !bytes.HasPrefix(content, pngSignature) {
+		return nil, errors.New("invalid png data")
+	}
+
+	out := make([]byte, 0, len(content))
+	out = append(out, pngSignature...)
+	pos := len(pngSignature)
+	for pos < len(content) {
+		if pos+8 > len(content) {
+			return nil, errors.New("unexpected end of png data")
+		}
+		length := int(binary.BigEndian.Uint32(content[pos : pos+4]))
+		end := pos + 12 + length
+		if end > len(content) {
+			return nil, fmt.Errorf("invalid png chunk length at offset %d", pos)
+		}
+		chunkType := string(content[pos+4 : pos+8])
+		if !pngMetadataChunks[chunkType] {
+			out = append(out, content[pos:end]...)
+		}
+		pos = end
+		if chunkType == "IEND" {
+			break
+		}
+	}
+	return out, nil
+}
+
+// stripWebPMetadata 移除 WebP 中的 EXIF 与 XMP 块，并清除 VP8X 头中对应的标记位
+func stripWebPMetadata(content []byte) ([]byte, error) {
+	if len(content) < 12 || string(content[:4]) != "RIFF" || string(content[8:12]) != "WEBP" {
+		return nil, errors.New("invalid webp data")
+	}
+
+	out := make([]byte, 12, len(content))
+	copy(out, content[:12])
+	pos := 12
+	for pos < len(content) {
+		if pos+8 > len(content) {
+			return nil, errors.New("unexpected end of webp data")
+		}
+		size := int(binary.LittleEndian.Uint32(content[pos+4 : pos+8]))
+		end := pos + 8 + size + size%2
+		if end > len(content) {
+			// 部分编码器省略末尾填充字节
+			if pos+8+size == len(content) {
+				end = len(content)
+			} else {
+				return nil, fmt.Errorf("invalid webp chunk size at offset %d", pos)
+			}
+		}
+
+		switch string(content[pos : pos+4]) {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			chunk := append([]byte(nil), content[pos:end]...)
+			if len(chunk) > 8 {
+				chunk[8] &^= 0x08 | 0x04 // EXIF 与 XMP 标记位
+			}
+			out = append(out, chunk...)
+		default:
+			out = append(out, content[pos:end]...)
+		}
+		pos = end
+	}
+
+	binary.LittleEndian.PutUint32(out[4:8], uint32(len(out)-8))
+	return out, nil
+}
@@ -0,0 +1,98 @@
+// Package media 提供媒体文件识别与存储键生成工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package media
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	_ "image/gif"  // 注册 GIF 解码器
+	_ "image/jpeg" // 注册 JPEG 解码器
+	_ "image/png"  // 注册 PNG 解码器
+	"mime"
+	"net/http"
+	"path"
+
+	_ "golang.org/x/image/webp" // 注册 WebP 解码器
+)
+
+// 常见 MIME 类型对应的扩展名，优先于系统 MIME 表以保证扩展名稳定
+var extensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"image/svg+xml":   ".svg",
+	"application/pdf": ".pdf",
+	"video/mp4":       ".mp4",
+	"audio/mpeg":      ".mp3",
+}
+
+// DetectContentType 根据文件内容识别 MIME 类型（不含参数）
+// 参数：
+//   - content: 文件内容
+//
+// 返回值：
+//   - string: MIME 类型
+func DetectContentType(content []byte) string {
+	detected := http.DetectContentType(content)
+	if mediaType, _, err := mime.ParseMediaType(detected); err == nil {
+		return mediaType
+	}
+	return detected
+}
+
+// Hash 计算文件内容的 SHA-256 哈希
+// 参数：
+//   - content: 文件内容
+//
+// 返回值：
+//   - string: 十六进制哈希
+func Hash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// ImageSize 获取图片显示尺寸（已考虑 EXIF 方向），非图片或格式不支持时返回 0
+// 参数：
+//   - content: 文件内容
+//
+// 返回值：
+//   - int: 宽度（像素）
+//   - int: 高度（像素）
+func ImageSize(content []byte) (int, int) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return 0, 0
+	}
+	return orientedSize(content, cfg.Width, cfg.Height)
+}
+
+// Extension 获取 MIME 类型对应的扩展名
+// 参数：
+//   - mimeType: MIME 类型
+//
+// 返回值：
+//   - string: 扩展名（含点号），未知类型时为空
+func Extension(mimeType string) string {
+	if ext, ok := extensions[mimeType]; ok {
+		return ext
+	}
+	if exts, err := mime.ExtensionsByType(mimeType); err == nil && len(exts) > 0 {
+		return exts[0]
+	}
+	return ""
+}
+
+// StorageKey 根据内容哈希生成存储键，相同内容映射到同一文件，按哈希前缀分目录避免单目录文件过多
+// 参数：
+//   - hash: 内容哈希
+//   - mimeType: MIME 类型
+//
+// 返回值：
+//   - string: 存储键，如 ab/cd/abcd...1234.png
+func StorageKey(hash, mimeType string) string {
+	return path.Join(hash[:2], hash[2:4], hash+Extension(mimeType))
+}
@@ -0,0 +1,166 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"testing"
+)
+
+func testImage(w, h int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x * 10), G: uint8(y * 10), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+// withExif 在 JPEG 的 SOI 之后插入带方向标记的 EXIF 段
+func withExif(t *testing.T, jpg []byte, orientation uint16) []byte {
+	t.Helper()
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08\x00\x01\x01\x12\x00\x03\x00\x00\x00\x01")
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, jpg[:2]...)
+	out = append(out, segment...)
+	return append(out, jpg[2:]...)
+}
+
+func TestJPEGMetadata(t *testing.T) {
+	jpg, err := Encode(testImage(4, 2), "image/jpeg", 90)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tagged := withExif(t, jpg, 6)
+
+	if got := Orientation(tagged); got != 6 {
+		t.Fatalf("Orientation = %d, want 6", got)
+	}
+	if w, h := ImageSize(tagged); w != 2 || h != 4 {
+		t.Errorf("ImageSize = %dx%d, want 2x4", w, h)
+	}
+
+	stripped, err := StripMetadata(tagged, "image/jpeg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(stripped, jpg) {
+		t.Errorf("StripMetadata did not restore original jpeg")
+	}
+
+	normalized, err := Normalize(tagged, "image/jpeg", 90, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Orientation(normalized) != 1 {
+		t.Errorf("Normalize kept orientation tag")
+	}
+	if w, h := ImageSize(normalized); w != 2 || h != 4 {
+		t.Errorf("normalized size = %dx%d, want 2x4", w, h)
+	}
+}
+
+func TestPNGMetadata(t *testing.T) {
+	pngData, err := Encode(testImage(3, 3), "image/png", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("GPS\x00somewhere")
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(data)))
+	chunk = append(chunk, "tEXt"...)
+	chunk = append(chunk, data...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+
+	tagged := append([]byte{}, pngData[:33]...) // 签名与 IHDR 块
+	tagged = append(tagged, chunk...)
+	tagged = append(tagged, pngData[33:]...)
+
+	stripped, err := StripMetadata(tagged, "image/png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(stripped, pngData) {
+		t.Errorf("StripMetadata did not remove tEXt chunk")
+	}
+}
+
+func TestPlanVariants(t *testing.T) {
+	variants := PlanVariants("ab/cd/abcd.jpg", "image/jpeg", true, 1000, 500, VariantOptions{
+		Widths: []int{640, 320, 1280, 640},
+		WebP:   true,
+	})
+
+	want := []Variant{
+		{Width: 320, Height: 160, MimeType: "image/jpeg", Key: "ab/cd/abcd_w320.jpg"},
+		{Width: 320, Height: 160, MimeType: "image/webp", Key: "ab/cd/abcd_w320.webp"},
+		{Width: 640, Height: 320, MimeType: "image/jpeg", Key: "ab/cd/abcd_w640.jpg"},
+		{Width: 640, Height: 320, MimeType: "image/webp", Key: "ab/cd/abcd_w640.webp"},
+		{Width: 1000, Height: 500, MimeType: "image/webp", Key: "ab/cd/abcd.webp"},
+	}
+	if len(variants) != len(want) {
+		t.Fatalf("PlanVariants returned %d variants, want %d: %+v", len(variants), len(want), variants)
+	}
+	for i := range want {
+		if variants[i] != want[i] {
+			t.Errorf("variant %d = %+v, want %+v", i, variants[i], want[i])
+		}
+	}
+
+	if got := PlanVariants("a.png", "image/png", false, 200, 100, VariantOptions{Widths: []int{320}}); len(got) != 0 {
+		t.Errorf("PlanVariants for small image = %+v, want none", got)
+	}
+}
+
+func TestResize(t *testing.T) {
+	img := Resize(testImage(10, 5), 4)
+	if b := img.Bounds(); b.Dx() != 4 || b.Dy() != 2 {
+		t.Errorf("Resize bounds = %v, want 4x2", b)
+	}
+}
+
+func TestCheckPixels(t *testing.T) {
+	pngData, err := Encode(testImage(100, 50), "image/png", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckPixels(pngData, 5000); err != nil {
+		t.Errorf("CheckPixels at limit = %v, want nil", err)
+	}
+	if _, err := Decode(pngData, 4999); !errors.Is(err, ErrTooManyPixels) {
+		t.Errorf("Decode over limit err = %v, want ErrTooManyPixels", err)
+	}
+
+	// 画布尺寸大而帧很小的动图：按帧数累计像素，且判断动图时不解码
+	anim := &gif.GIF{Config: image.Config{Width: 4000, Height: 4000, ColorModel: color.Palette(palette.Plan9)}}
+	for i := 0; i < 3; i++ {
+		anim.Image = append(anim.Image, image.NewPaletted(image.Rect(0, 0, 1, 1), palette.Plan9))
+		anim.Delay = append(anim.Delay, 10)
+	}
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, anim); err != nil {
+		t.Fatal(err)
+	}
+	if got := gifFrames(buf.Bytes()); got != 3 {
+		t.Errorf("gifFrames = %d, want 3", got)
+	}
+	if !IsAnimated(buf.Bytes(), "image/gif") {
+		t.Error("IsAnimated = false, want true")
+	}
+	if err := CheckPixels(buf.Bytes(), 3*4000*4000-1); !errors.Is(err, ErrTooManyPixels) {
+		t.Errorf("CheckPixels for animated gif err = %v, want ErrTooManyPixels", err)
+	}
+}
@@ -0,0 +1,76 @@
+// Package media 提供媒体文件识别与存储键生成工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package media
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+)
+
+// WebPEncoderAvailable 判断 WebP 编码器（cwebp）是否可用
+// Go 标准库与 x/image 仅提供 WebP 解码，编码通过外部 cwebp 完成
+// 参数：
+//   - encoder: cwebp 可执行文件路径或名称
+//
+// 返回值：
+//   - bool: 是否可用
+func WebPEncoderAvailable(encoder string) bool {
+	if encoder == "" {
+		return false
+	}
+	_, err := exec.LookPath(encoder)
+	return err == nil
+}
+
+// EncodeWebP 调用 cwebp 将图片编码为 WebP，输出不包含任何元数据
+// 参数：
+//   - ctx: 上下文，用于控制超时
+//   - encoder: cwebp 可执行文件路径或名称
+//   - img: 图片
+//   - quality: 编码质量（1-100）
+//
+// 返回值：
+//   - []byte: WebP 内容
+//   - error: 编码过程中的错误
+func EncodeWebP(ctx context.Context, encoder string, img image.Image, quality int) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "jank-webp-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "input.png")
+	output := filepath.Join(dir, "output.webp")
+
+	file, err := os.Create(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file: %w", err)
+	}
+	// 以无损 PNG 作为中间格式，避免二次有损压缩
+	pngEncoder := png.Encoder{CompressionLevel: png.NoCompression}
+	if err := pngEncoder.Encode(file, img); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close temp file: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, encoder, "-quiet", "-metadata", "none", "-q", strconv.Itoa(quality), input, "-o", output)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("failed to run webp encoder: %w: %s", err, out)
+	}
+
+	content, err := os.ReadFile(output)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webp output: %w", err)
+	}
+	return content, nil
+}
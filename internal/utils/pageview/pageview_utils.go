@@ -0,0 +1,88 @@
+// Package pageview 提供文章浏览量统计工具，访客以加盐哈希标识，不保存原始 IP
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package pageview
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// visitorHashLength 访客标识长度（十六进制字符数）
+const visitorHashLength = 32
+
+// NewSalt 生成随机盐值
+// 返回值：
+//   - string: 十六进制编码的随机盐值
+//   - error: 操作过程中的错误
+func NewSalt() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// VisitorHash 根据盐值、客户端 IP 与 User-Agent 生成访客标识，盐值轮换后同一访客的标识随之改变
+// 参数：
+//   - salt: 盐值
+//   - ip: 客户端 IP
+//   - userAgent: 客户端 User-Agent
+//
+// 返回值：
+//   - string: 访客标识
+func VisitorHash(salt, ip, userAgent string) string {
+	sum := sha256.Sum256([]byte(salt + "\n" + ip + "\n" + userAgent))
+	return hex.EncodeToString(sum[:])[:visitorHashLength]
+}
+
+// DayStart 获取指定时间所在日期零点（本地时区）的 Unix 时间戳
+// 参数：
+//   - t: 时间
+//
+// 返回值：
+//   - int64: 当日零点的 Unix 时间戳
+func DayStart(t time.Time) int64 {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location()).Unix()
+}
+
+// Field 生成浏览量缓冲计数的字段名
+// 参数：
+//   - postID: 文章 ID
+//   - day: 统计日期（当日零点的 Unix 时间戳）
+//
+// 返回值：
+//   - string: 字段名，格式为 {postID}:{day}
+func Field(postID, day int64) string {
+	return strconv.FormatInt(postID, 10) + ":" + strconv.FormatInt(day, 10)
+}
+
+// ParseField 解析浏览量缓冲计数的字段名
+// 参数：
+//   - field: 字段名
+//
+// 返回值：
+//   - int64: 文章 ID
+//   - int64: 统计日期（当日零点的 Unix 时间戳）
+//   - error: 字段名格式错误
+func ParseField(field string) (int64, int64, error) {
+	rawID, rawDay, ok := strings.Cut(field, ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid view count field: %s", field)
+	}
+	postID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid post ID in view count field '%s': %w", field, err)
+	}
+	day, err := strconv.ParseInt(rawDay, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid day in view count field '%s': %w", field, err)
+	}
+	return postID, day, nil
+}
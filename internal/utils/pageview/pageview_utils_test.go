@@ -0,0 +1,47 @@
+package pageview
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVisitorHash(t *testing.T) {
+	a := VisitorHash("salt", "203.0.113.7", "Mozilla/5.0")
+	if len(a) != visitorHashLength {
+		t.Fatalf("len(VisitorHash) = %d, want %d", len(a), visitorHashLength)
+	}
+	if b := VisitorHash("salt", "203.0.113.7", "Mozilla/5.0"); a != b {
+		t.Errorf("VisitorHash not stable: %q != %q", a, b)
+	}
+	if b := VisitorHash("other", "203.0.113.7", "Mozilla/5.0"); a == b {
+		t.Errorf("VisitorHash unchanged after salt rotation: %q", a)
+	}
+	if b := VisitorHash("salt", "203.0.113.8", "Mozilla/5.0"); a == b {
+		t.Errorf("VisitorHash equal for different IPs: %q", a)
+	}
+}
+
+func TestDayStart(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	got := DayStart(time.Date(2026, 10, 17, 23, 59, 59, 0, loc))
+	want := time.Date(2026, 10, 17, 0, 0, 0, 0, loc).Unix()
+	if got != want {
+		t.Errorf("DayStart = %d, want %d", got, want)
+	}
+}
+
+func TestParseField(t *testing.T) {
+	postID, day, err := ParseField(Field(2111512973188009984, 1792166400))
+	if err != nil {
+		t.Fatalf("ParseField: %v", err)
+	}
+	if postID != 2111512973188009984 || day != 1792166400 {
+		t.Errorf("ParseField = (%d, %d), want (2111512973188009984, 1792166400)", postID, day)
+	}
+
+	for _, field := range []string{"", "123", "abc:1", "1:abc"} {
+		if _, _, err := ParseField(field); err == nil {
+			t.Errorf("ParseField(%q) succeeded, want error", field)
+		}
+	}
+}
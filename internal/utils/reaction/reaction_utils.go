@@ -0,0 +1,92 @@
+// Package reaction 提供文章表态工具，包括表态者标识生成与文章可用表态类型解析
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package reaction
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strconv"
+)
+
+const (
+	userReactorPrefix      = "user:" // 登录用户表态者标识前缀
+	anonymousReactorPrefix = "anon:" // 匿名访客表态者标识前缀
+	anonymousHashLength    = 32      // 匿名访客标识哈希长度（十六进制字符数）
+)
+
+// UserReactor 生成登录用户的表态者标识
+// 参数：
+//   - userID: 用户 ID
+//
+// 返回值：
+//   - string: 表态者标识，格式为 user:{userID}
+func UserReactor(userID int64) string {
+	return userReactorPrefix + strconv.FormatInt(userID, 10)
+}
+
+// AnonymousReactor 生成匿名访客的表态者标识，以密钥对客户端指纹做 HMAC，不保存原始指纹或 IP；
+// 指纹为空时改用客户端 IP 与 User-Agent
+// 参数：
+//   - secret: HMAC 密钥
+//   - fingerprint: 客户端指纹（可为空）
+//   - ip: 客户端 IP
+//   - userAgent: 客户端 User-Agent
+//
+// 返回值：
+//   - string: 表态者标识，格式为 anon:{hash}
+func AnonymousReactor(secret, fingerprint, ip, userAgent string) string {
+	source := "fp\n" + fingerprint
+	if fingerprint == "" {
+		source = "ip\n" + ip + "\n" + userAgent
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(source))
+	return anonymousReactorPrefix + hex.EncodeToString(mac.Sum(nil))[:anonymousHashLength]
+}
+
+// DecodeTypes 解析文章扩展字段中保存的表态类型列表
+// 参数：
+//   - raw: 扩展字段值，JSON 解码后为 []any
+//
+// 返回值：
+//   - []string: 表态类型列表
+//   - bool: 是否设置过表态类型，未设置时文章启用全部已配置类型
+func DecodeTypes(raw any) ([]string, bool) {
+	switch v := raw.(type) {
+	case []string:
+		return v, true
+	case []any:
+		types := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types, true
+	default:
+		return nil, false
+	}
+}
+
+// EnabledTypes 获取文章实际可用的表态类型，按已配置的顺序返回
+// 参数：
+//   - configured: 已配置的表态类型
+//   - selected: 文章选择的表态类型，为空时启用全部已配置类型
+//
+// 返回值：
+//   - []string: 可用的表态类型，已从配置中移除的类型不包含在内
+func EnabledTypes(configured, selected []string) []string {
+	if len(selected) == 0 {
+		return configured
+	}
+	enabled := make([]string, 0, len(selected))
+	for _, t := range configured {
+		if slices.Contains(selected, t) {
+			enabled = append(enabled, t)
+		}
+	}
+	return enabled
+}
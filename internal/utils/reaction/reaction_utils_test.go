@@ -0,0 +1,51 @@
+package reaction
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestAnonymousReactor(t *testing.T) {
+	a := AnonymousReactor("secret", "fp-1", "203.0.113.7", "Mozilla/5.0")
+	if !strings.HasPrefix(a, anonymousReactorPrefix) || len(a) != len(anonymousReactorPrefix)+anonymousHashLength {
+		t.Fatalf("AnonymousReactor = %q, want %s prefix and %d hash chars", a, anonymousReactorPrefix, anonymousHashLength)
+	}
+	if b := AnonymousReactor("secret", "fp-1", "198.51.100.1", "curl/8.0"); a != b {
+		t.Errorf("AnonymousReactor changed with IP although fingerprint is set: %q != %q", a, b)
+	}
+	if b := AnonymousReactor("other", "fp-1", "203.0.113.7", "Mozilla/5.0"); a == b {
+		t.Errorf("AnonymousReactor unchanged with different secret: %q", a)
+	}
+
+	ipA := AnonymousReactor("secret", "", "203.0.113.7", "Mozilla/5.0")
+	if ipB := AnonymousReactor("secret", "", "203.0.113.8", "Mozilla/5.0"); ipA == ipB {
+		t.Errorf("AnonymousReactor equal for different IPs without fingerprint: %q", ipA)
+	}
+	if ipA == a {
+		t.Errorf("AnonymousReactor equal with and without fingerprint: %q", a)
+	}
+}
+
+func TestDecodeTypes(t *testing.T) {
+	if _, ok := DecodeTypes(nil); ok {
+		t.Errorf("DecodeTypes(nil) reported types set")
+	}
+	got, ok := DecodeTypes([]any{"like", 1, "clap"})
+	if !ok || !slices.Equal(got, []string{"like", "clap"}) {
+		t.Errorf("DecodeTypes = (%v, %v), want ([like clap], true)", got, ok)
+	}
+	if got, ok := DecodeTypes([]any{}); !ok || len(got) != 0 {
+		t.Errorf("DecodeTypes(empty) = (%v, %v), want ([], true)", got, ok)
+	}
+}
+
+func TestEnabledTypes(t *testing.T) {
+	configured := []string{"like", "clap", "heart"}
+	if got := EnabledTypes(configured, nil); !slices.Equal(got, configured) {
+		t.Errorf("EnabledTypes(nil) = %v, want %v", got, configured)
+	}
+	if got := EnabledTypes(configured, []string{"heart", "removed", "like"}); !slices.Equal(got, []string{"like", "heart"}) {
+		t.Errorf("EnabledTypes = %v, want [like heart]", got)
+	}
+}
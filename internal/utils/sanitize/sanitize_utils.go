@@ -0,0 +1,94 @@
+// Package sanitize 提供基于白名单的 HTML 清洗工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package sanitize
+
+import (
+	"regexp"
+	"slices"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+var (
+	classPattern = regexp.MustCompile(`^[\w\- ]+$`) // class 属性允许的取值
+	idPattern    = regexp.MustCompile(`^[\w\-]+$`)  // id 属性允许的取值
+)
+
+// Policy 清洗策略，在内置白名单（常见排版元素、表格、脚注、任务列表等）基础上追加
+type Policy struct {
+	AllowedElements   []string // 额外允许的元素
+	AllowedAttributes []string // 额外允许的全局属性
+	AllowedURLSchemes []string // 允许的链接协议，为空时使用 http、https、mailto
+}
+
+// NewPolicy 根据清洗策略创建 HTML 清洗器，批量清洗时应复用同一清洗器
+// 参数：
+//   - p: 清洗策略
+//
+// 返回值：
+//   - *bluemonday.Policy: HTML 清洗器
+func NewPolicy(p Policy) *bluemonday.Policy {
+	policy := bluemonday.UGCPolicy()
+
+	// 代码高亮、公式、图表等渲染扩展依赖 class，标题锚点依赖 id
+	policy.AllowAttrs("class").Matching(classPattern).Globally()
+	policy.AllowAttrs("id").Matching(idPattern).Globally()
+
+	// 代码高亮输出的内联样式
+	policy.AllowStyles(
+		"color", "background-color", "font-weight", "font-style", "text-decoration",
+		"display", "white-space", "user-select", "margin-right", "padding",
+	).OnElements("pre", "code", "span")
+
+	// GFM 任务列表复选框
+	policy.AllowElements("input")
+	policy.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+
+	// 脚注
+	policy.AllowAttrs("role").Matching(regexp.MustCompile(`^(doc-noteref|doc-backlink|doc-endnotes)$`)).OnElements("a", "div", "section")
+
+	if len(p.AllowedURLSchemes) > 0 {
+		policy.AllowURLSchemes(p.AllowedURLSchemes...)
+	}
+	if len(p.AllowedElements) > 0 {
+		policy.AllowElements(p.AllowedElements...)
+	}
+	if len(p.AllowedAttributes) > 0 {
+		policy.AllowAttrs(p.AllowedAttributes...).Globally()
+	}
+
+	return policy
+}
+
+// HTML 按清洗策略清洗 HTML，移除脚本、事件属性及不在白名单中的元素和属性
+// 参数：
+//   - html: 待清洗的 HTML
+//   - p: 清洗策略
+//
+// 返回值：
+//   - string: 清洗后的 HTML
+func HTML(html string, p Policy) string {
+	return NewPolicy(p).Sanitize(html)
+}
+
+// Trusted 判断参与撰写内容的所有用户是否均具备受信任角色，用于决定内容能否跳过清洗；
+// 编辑他人文章时作者与编辑者都需受信任，避免不受信任的用户借受信任作者的文章注入脚本
+// 参数：
+//   - trustedRoles: 受信任的角色列表
+//   - writerRoles: 每位参与撰写用户的角色列表
+//
+// 返回值：
+//   - bool: 所有用户均受信任时返回 true，未提供用户时返回 false
+func Trusted(trustedRoles []string, writerRoles ...[]string) bool {
+	if len(writerRoles) == 0 {
+		return false
+	}
+	for _, roles := range writerRoles {
+		if !slices.ContainsFunc(roles, func(role string) bool { return slices.Contains(trustedRoles, role) }) {
+			return false
+		}
+	}
+	return true
+}
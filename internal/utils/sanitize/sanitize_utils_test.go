@@ -0,0 +1,59 @@
+package sanitize
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHTML(t *testing.T) {
+	input := `<h2 id="intro">Intro</h2><script>alert(1)</script>` +
+		`<p onclick="x()">text <a href="javascript:alert(1)">bad</a></p>` +
+		`<ul><li><input type="checkbox" checked disabled> done</li></ul>` +
+		`<pre><code class="language-go">fmt.Println()</code></pre>`
+
+	got := HTML(input, Policy{})
+
+	for _, banned := range []string{"<script", "onclick", "javascript:"} {
+		if strings.Contains(got, banned) {
+			t.Errorf("HTML() kept %q: %s", banned, got)
+		}
+	}
+	for _, kept := range []string{`id="intro"`, `type="checkbox"`, `class="language-go"`} {
+		if !strings.Contains(got, kept) {
+			t.Errorf("HTML() dropped %q: %s", kept, got)
+		}
+	}
+
+	got = HTML(`<iframe src="https://example.com"></iframe>`, Policy{AllowedElements: []string{"iframe"}, AllowedAttributes: []string{"src"}})
+	if !strings.Contains(got, "<iframe") {
+		t.Errorf("HTML() with extra elements = %s", got)
+	}
+}
+
+func TestTrusted(t *testing.T) {
+	trusted := []string{"super_admin", "editor"}
+	admin := []string{"super_admin"}
+	user := []string{"user"}
+
+	if !Trusted(trusted, admin) {
+		t.Errorf("Trusted(admin) = false, want true")
+	}
+	if !Trusted(trusted, []string{"user", "editor"}, admin) {
+		t.Errorf("Trusted(editor, admin) = false, want true")
+	}
+	if Trusted(trusted) {
+		t.Errorf("Trusted() without writers = true, want false")
+	}
+	if Trusted(nil, admin) {
+		t.Errorf("Trusted(admin) without trusted roles = true, want false")
+	}
+
+	// 不受信任的用户编辑受信任作者的文章时，内容仍需清洗
+	if Trusted(trusted, user, admin) {
+		t.Fatalf("Trusted(untrusted editor, trusted author) = true, want false")
+	}
+	got := HTML(`<p>edit</p><script>alert(1)</script>`, Policy{})
+	if strings.Contains(got, "<script") {
+		t.Errorf("HTML() kept <script> for untrusted editor: %s", got)
+	}
+}
@@ -0,0 +1,208 @@
+// Package search 提供全文检索分词与高亮工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	maxTermLength = 64 // 单个词项最大长度（字符）
+	maxQueryTerms = 16 // 查询最多使用的词项数量
+)
+
+// 字段权重，用于相关度排序
+const (
+	WeightTitle       = 10 // 标题权重
+	WeightDescription = 3  // 描述权重
+	WeightBody        = 1  // 正文权重
+)
+
+// Tokenize 将文本切分为检索词项
+// 拉丁字母与数字按连续片段切分并转为小写；中日韩文字按相邻二元组（bigram）切分，单个孤立汉字保留为单字词项
+// 参数：
+//   - text: 待切分文本
+//
+// 返回值：
+//   - []string: 词项列表（可能重复）
+func Tokenize(text string) []string {
+	var terms []string
+	var word []rune
+	var cjk []rune
+
+	flushWord := func() {
+		if len(word) > 0 && len(word) <= maxTermLength {
+			terms = append(terms, string(word))
+		}
+		word = word[:0]
+	}
+	flushCJK := func() {
+		switch {
+		case len(cjk) == 1:
+			terms = append(terms, string(cjk))
+		case len(cjk) > 1:
+			for i := 0; i+1 < len(cjk); i++ {
+				terms = append(terms, string(cjk[i:i+2]))
+			}
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, unicode.ToLower(r))
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+
+	return terms
+}
+
+// BuildTermWeights 计算文章各词项的加权词频
+// 参数：
+//   - title: 标题
+//   - description: 描述
+//   - body: 正文纯文本
+//
+// 返回值：
+//   - map[string]int: 词项到加权词频的映射
+func BuildTermWeights(title, description, body string) map[string]int {
+	weights := make(map[string]int)
+	for _, t := range Tokenize(title) {
+		weights[t] += WeightTitle
+	}
+	for _, t := range Tokenize(description) {
+		weights[t] += WeightDescription
+	}
+	for _, t := range Tokenize(body) {
+		weights[t] += WeightBody
+	}
+	return weights
+}
+
+// QueryTerms 将查询语句切分为去重后的词项，最多返回 maxQueryTerms 个
+// 参数：
+//   - query: 查询语句
+//
+// 返回值：
+//   - []string: 词项列表
+func QueryTerms(query string) []string {
+	seen := make(map[string]bool)
+	var terms []string
+	for _, t := range Tokenize(query) {
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		terms = append(terms, t)
+		if len(terms) >= maxQueryTerms {
+			break
+		}
+	}
+	return terms
+}
+
+// Highlight 截取文本中首个关键字附近的片段，并以 <mark> 标签高亮所有关键字，其余内容做 HTML 转义
+// 参数：
+//   - text: 原始纯文本
+//   - query: 查询语句，按空白切分为关键字，匹配时忽略大小写
+//   - maxLen: 片段最大长度（字符），小于等于 0 时不截取
+//
+// 返回值：
+//   - string: 高亮后的 HTML 片段
+func Highlight(text, query string, maxLen int) string {
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	var keywords [][]rune
+	for _, k := range strings.Fields(query) {
+		keywords = append(keywords, []rune(strings.ToLower(k)))
+	}
+
+	start, end := 0, len(runes)
+	if maxLen > 0 && len(runes) > maxLen {
+		first := -1
+		for _, k := range keywords {
+			if i := indexRunes(lower, k, 0); i >= 0 && (first < 0 || i < first) {
+				first = i
+			}
+		}
+		if first > maxLen/4 {
+			start = first - maxLen/4
+		}
+		end = min(start+maxLen, len(runes))
+	}
+
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString("...")
+	}
+	for i := start; i < end; {
+		matched := 0
+		for _, k := range keywords {
+			if len(k) > matched && i+len(k) <= end && equalRunes(lower[i:i+len(k)], k) {
+				matched = len(k)
+			}
+		}
+		if matched > 0 {
+			sb.WriteString("<mark>")
+			sb.WriteString(html.EscapeString(string(runes[i : i+matched])))
+			sb.WriteString("</mark>")
+			i += matched
+			continue
+		}
+		sb.WriteString(html.EscapeString(string(runes[i])))
+		i++
+	}
+	if end < len(runes) {
+		sb.WriteString("...")
+	}
+
+	return sb.String()
+}
+
+// isCJK 判断字符是否为中日韩文字
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+// indexRunes 在 s 中从 from 开始查找 sub 首次出现的位置
+func indexRunes(s, sub []rune, from int) int {
+	if len(sub) == 0 {
+		return -1
+	}
+	for i := from; i+len(sub) <= len(s); i++ {
+		if equalRunes(s[i:i+len(sub)], sub) {
+			return i
+		}
+	}
+	return -1
+}
+
+// equalRunes 判断两个字符切片是否相等
+func equalRunes(a, b []rune) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"Hello, Go-Lang 1.24!", []string{"hello", "go", "lang", "1", "24"}},
+		{"全文检索", []string{"全文", "文检", "检索"}},
+		{"使用 Go 搜索", []string{"使用", "go", "搜索"}},
+		{"字", []string{"字"}},
+	}
+	for _, tt := range tests {
+		if got := Tokenize(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Tokenize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	got := Highlight("Learn <Go> with go examples", "go", 0)
+	want := "Learn &lt;<mark>Go</mark>&gt; with <mark>go</mark> examples"
+	if got != want {
+		t.Errorf("Highlight() = %q, want %q", got, want)
+	}
+
+	got = Highlight("aaaa bbbb cccc dddd target eeee", "target", 12)
+	want = "...dd <mark>target</mark> ee..."
+	if got != want {
+		t.Errorf("Highlight() with maxLen = %q, want %q", got, want)
+	}
+}
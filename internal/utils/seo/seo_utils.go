@@ -0,0 +1,155 @@
+// Package seo 提供页面 SEO 元数据生成与注入工具，包括 Open Graph、Twitter Card 与 JSON-LD 结构化数据
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package seo
+
+import (
+	"encoding/json"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	titlePattern     = regexp.MustCompile(`(?is)<title[^>]*>.*?</title>`)
+	headClosePattern = regexp.MustCompile(`(?i)</head>`)
+)
+
+// Article 文章页面 SEO 元数据
+type Article struct {
+	Title       string    // 分享标题
+	Description string    // 页面描述
+	Canonical   string    // 规范链接（绝对地址）
+	Image       string    // 分享图片（绝对地址），为空时不输出
+	NoIndex     bool      // 是否禁止搜索引擎收录
+	SiteName    string    // 站点名称
+	Locale      string    // 站点语言，如 zh-CN
+	Author      string    // 作者名称，为空时不输出
+	Section     string    // 所属分类，为空时不输出
+	Tags        []string  // 标签
+	Published   time.Time // 发布时间，零值时不输出
+	Modified    time.Time // 修改时间，零值时不输出
+	WordCount   int       // 字数
+}
+
+// HeadHTML 生成文章页面 <head> 中的元数据标签：描述、规范链接、robots、Open Graph、Twitter Card 与 BlogPosting 结构化数据
+// 返回值：
+//   - string: 以换行分隔的 HTML 标签
+func (a *Article) HeadHTML() string {
+	var tags []string
+	meta := func(attr, key, value string) {
+		if value != "" {
+			tags = append(tags, `<meta `+attr+`="`+key+`" content="`+html.EscapeString(value)+`">`)
+		}
+	}
+
+	meta("name", "description", a.Description)
+	if a.Canonical != "" {
+		tags = append(tags, `<link rel="canonical" href="`+html.EscapeString(a.Canonical)+`">`)
+	}
+	if a.NoIndex {
+		meta("name", "robots", "noindex, nofollow")
+	}
+
+	meta("property", "og:type", "article")
+	meta("property", "og:title", a.Title)
+	meta("property", "og:description", a.Description)
+	meta("property", "og:url", a.Canonical)
+	meta("property", "og:image", a.Image)
+	meta("property", "og:site_name", a.SiteName)
+	meta("property", "og:locale", strings.ReplaceAll(a.Locale, "-", "_"))
+	meta("property", "article:published_time", formatTime(a.Published))
+	meta("property", "article:modified_time", formatTime(a.Modified))
+	meta("property", "article:author", a.Author)
+	meta("property", "article:section", a.Section)
+	for _, tag := range a.Tags {
+		meta("property", "article:tag", tag)
+	}
+
+	card := "summary"
+	if a.Image != "" {
+		card = "summary_large_image"
+	}
+	meta("name", "twitter:card", card)
+	meta("name", "twitter:title", a.Title)
+	meta("name", "twitter:description", a.Description)
+	meta("name", "twitter:image", a.Image)
+
+	if ld, err := a.JSONLD(); err == nil {
+		tags = append(tags, `<script type="application/ld+json">`+string(ld)+`</script>`)
+	}
+	return strings.Join(tags, "\n")
+}
+
+// JSONLD 生成 schema.org BlogPosting 结构化数据
+// 返回值：
+//   - []byte: JSON 内容，其中的 <、>、& 已转义，可直接嵌入 <script>
+//   - error: 编码失败时返回错误
+func (a *Article) JSONLD() ([]byte, error) {
+	data := map[string]any{
+		"@context": "https://schema.org",
+		"@type":    "BlogPosting",
+		"headline": a.Title,
+	}
+	set := func(key string, value any, ok bool) {
+		if ok {
+			data[key] = value
+		}
+	}
+
+	set("description", a.Description, a.Description != "")
+	set("url", a.Canonical, a.Canonical != "")
+	set("mainEntityOfPage", map[string]string{"@type": "WebPage", "@id": a.Canonical}, a.Canonical != "")
+	set("image", []string{a.Image}, a.Image != "")
+	set("datePublished", formatTime(a.Published), !a.Published.IsZero())
+	set("dateModified", formatTime(a.Modified), !a.Modified.IsZero())
+	set("author", map[string]string{"@type": "Person", "name": a.Author}, a.Author != "")
+	set("publisher", map[string]string{"@type": "Organization", "name": a.SiteName}, a.SiteName != "")
+	set("articleSection", a.Section, a.Section != "")
+	set("keywords", strings.Join(a.Tags, ", "), len(a.Tags) > 0)
+	set("wordCount", a.WordCount, a.WordCount > 0)
+	set("inLanguage", a.Locale, a.Locale != "")
+
+	return json.Marshal(data)
+}
+
+// InjectHead 替换页面标题并在 </head> 前插入内容
+// 参数：
+//   - page: 页面 HTML
+//   - title: 页面标题，为空时保留原标题
+//   - head: 插入 <head> 的 HTML，为空时不插入
+//
+// 返回值：
+//   - []byte: 处理后的页面 HTML
+func InjectHead(page []byte, title, head string) []byte {
+	content := string(page)
+
+	if title != "" {
+		tag := "<title>" + html.EscapeString(title) + "</title>"
+		if titlePattern.MatchString(content) {
+			content = titlePattern.ReplaceAllLiteralString(content, tag)
+		} else if head != "" {
+			head = tag + "\n" + head
+		} else {
+			head = tag
+		}
+	}
+
+	if head == "" {
+		return []byte(content)
+	}
+	head = "    " + strings.ReplaceAll(head, "\n", "\n    ") + "\n"
+	if loc := headClosePattern.FindStringIndex(content); loc != nil {
+		return []byte(content[:loc[0]] + head + content[loc[0]:])
+	}
+	return []byte(head + content)
+}
+
+// formatTime 按 ISO 8601 格式化时间，零值时返回空字符串
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,109 @@
+package seo
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestArticleHeadHTML(t *testing.T) {
+	a := &Article{
+		Title:       `Hello "World"`,
+		Description: "A post about <tags>",
+		Canonical:   "https://example.com/post/1",
+		Image:       "https://example.com/cover.jpg",
+		NoIndex:     true,
+		SiteName:    "Jank",
+		Locale:      "zh-CN",
+		Author:      "Done-0",
+		Section:     "Notes",
+		Tags:        []string{"go", "web"},
+		Published:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		WordCount:   120,
+	}
+	head := a.HeadHTML()
+
+	for _, want := range []string{
+		`<meta name="description" content="A post about &lt;tags&gt;">`,
+		`<link rel="canonical" href="https://example.com/post/1">`,
+		`<meta name="robots" content="noindex, nofollow">`,
+		`<meta property="og:type" content="article">`,
+		`<meta property="og:title" content="Hello &#34;World&#34;">`,
+		`<meta property="og:image" content="https://example.com/cover.jpg">`,
+		`<meta property="og:locale" content="zh_CN">`,
+		`<meta property="article:published_time" content="2024-01-02T03:04:05Z">`,
+		`<meta property="article:tag" content="go">`,
+		`<meta property="article:tag" content="web">`,
+		`<meta name="twitter:card" content="summary_large_image">`,
+		`<script type="application/ld+json">`,
+	} {
+		if !strings.Contains(head, want) {
+			t.Errorf("head missing %s:\n%s", want, head)
+		}
+	}
+	if strings.Contains(head, "article:modified_time") {
+		t.Errorf("head contains zero modified time:\n%s", head)
+	}
+}
+
+func TestArticleWithoutImage(t *testing.T) {
+	head := (&Article{Title: "T"}).HeadHTML()
+	if !strings.Contains(head, `<meta name="twitter:card" content="summary">`) {
+		t.Errorf("expected summary card:\n%s", head)
+	}
+	for _, unwanted := range []string{"robots", "og:image", "canonical", "description"} {
+		if strings.Contains(head, unwanted) {
+			t.Errorf("head contains %s:\n%s", unwanted, head)
+		}
+	}
+}
+
+func TestArticleJSONLD(t *testing.T) {
+	a := &Article{
+		Title:     "</script><script>alert(1)</script>",
+		Canonical: "https://example.com/post/1",
+		Author:    "Done-0",
+		Modified:  time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC),
+	}
+	raw, err := a.JSONLD()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), "</script>") {
+		t.Errorf("JSON-LD not escaped: %s", raw)
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal(raw, &data); err != nil {
+		t.Fatal(err)
+	}
+	if data["@type"] != "BlogPosting" || data["headline"] != a.Title || data["dateModified"] != "2024-02-03T00:00:00Z" {
+		t.Errorf("data = %v", data)
+	}
+	if author, _ := data["author"].(map[string]any); author["name"] != "Done-0" {
+		t.Errorf("author = %v", data["author"])
+	}
+	if _, ok := data["image"]; ok {
+		t.Errorf("image present without value: %v", data)
+	}
+}
+
+func TestInjectHead(t *testing.T) {
+	page := []byte("<html>\n<head>\n    <title>Blog</title>\n</head>\n<body></body>\n</html>")
+
+	got := string(InjectHead(page, "Post & More", "<meta a>\n<meta b>"))
+	want := "<html>\n<head>\n    <title>Post &amp; More</title>\n    <meta a>\n    <meta b>\n</head>\n<body></body>\n</html>"
+	if got != want {
+		t.Errorf("InjectHead =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := string(InjectHead(page, "", "")); got != string(page) {
+		t.Errorf("InjectHead changed page without input:\n%s", got)
+	}
+
+	got = string(InjectHead([]byte("<head></head>"), "New", ""))
+	if got != "<head>    <title>New</title>\n</head>" {
+		t.Errorf("InjectHead without title tag = %q", got)
+	}
+}
@@ -0,0 +1,141 @@
+// Package site 提供站点链接生成工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package site
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Done-0/jank/configs"
+)
+
+// AbsoluteURL 将站内路径拼接为绝对链接
+// 参数：
+//   - siteConfig: 站点配置
+//   - path: 站内路径
+//
+// 返回值：
+//   - string: 绝对链接
+func AbsoluteURL(siteConfig configs.SiteConfig, path string) string {
+	if path != "" && !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return strings.TrimRight(siteConfig.URL, "/") + path
+}
+
+// PostURL 生成文章页面绝对链接
+// 参数：
+//   - siteConfig: 站点配置
+//   - postID: 文章 ID
+//   - postSlug: 文章别名，为空时 {slug} 占位符回退为文章 ID
+//
+// 返回值：
+//   - string: 文章页面绝对链接
+func PostURL(siteConfig configs.SiteConfig, postID int64, postSlug string) string {
+	id := strconv.FormatInt(postID, 10)
+	if postSlug == "" {
+		postSlug = id
+	}
+	path := strings.NewReplacer("{id}", id, "{slug}", postSlug).Replace(pathOrDefault(siteConfig.PostPath, "/post/{id}"))
+	return AbsoluteURL(siteConfig, path)
+}
+
+// CategoryURL 生成分类页面绝对链接
+// 参数：
+//   - siteConfig: 站点配置
+//   - categoryID: 分类 ID
+//
+// 返回值：
+//   - string: 分类页面绝对链接
+func CategoryURL(siteConfig configs.SiteConfig, categoryID int64) string {
+	path := strings.ReplaceAll(pathOrDefault(siteConfig.CategoryPath, "/category/{id}"), "{id}", strconv.FormatInt(categoryID, 10))
+	return AbsoluteURL(siteConfig, path)
+}
+
+// TagURL 生成标签页面绝对链接
+// 参数：
+//   - siteConfig: 站点配置
+//   - tagID: 标签 ID
+//
+// 返回值：
+//   - string: 标签页面绝对链接
+func TagURL(siteConfig configs.SiteConfig, tagID int64) string {
+	path := strings.ReplaceAll(pathOrDefault(siteConfig.TagPath, "/tag/{id}"), "{id}", strconv.FormatInt(tagID, 10))
+	return AbsoluteURL(siteConfig, path)
+}
+
+// MatchPostPath 将站内路径与文章页面路径模板匹配
+// 参数：
+//   - siteConfig: 站点配置
+//   - path: 站内路径
+//
+// 返回值：
+//   - map[string]string: 占位符名称（id、slug）-> 路径中的值
+//   - bool: 是否匹配
+func MatchPostPath(siteConfig configs.SiteConfig, path string) (map[string]string, bool) {
+	return matchPath(pathOrDefault(siteConfig.PostPath, "/post/{id}"), path)
+}
+
+// MatchCategoryPath 将站内路径与分类页面路径模板匹配
+// 参数：
+//   - siteConfig: 站点配置
+//   - path: 站内路径
+//
+// 返回值：
+//   - string: 分类 ID
+//   - bool: 是否匹配
+func MatchCategoryPath(siteConfig configs.SiteConfig, path string) (string, bool) {
+	params, ok := matchPath(pathOrDefault(siteConfig.CategoryPath, "/category/{id}"), path)
+	return params["id"], ok
+}
+
+// pathPatterns 路径模板 -> 编译后的正则表达式，模板来自配置，数量有限
+var pathPatterns sync.Map
+
+// matchPath 按路径模板匹配站内路径，{id} 匹配数字，{slug} 匹配单个路径段，忽略末尾斜杠
+func matchPath(pattern, path string) (map[string]string, bool) {
+	re := pathRegexp(pattern)
+	if re == nil {
+		return nil, false
+	}
+
+	match := re.FindStringSubmatch(path)
+	if match == nil {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, name := range re.SubexpNames() {
+		if name != "" {
+			params[name] = match[i]
+		}
+	}
+	return params, true
+}
+
+// pathRegexp 获取路径模板对应的正则表达式，每个模板仅编译一次，编译失败时返回 nil
+func pathRegexp(pattern string) *regexp.Regexp {
+	if re, ok := pathPatterns.Load(pattern); ok {
+		return re.(*regexp.Regexp)
+	}
+
+	expr := regexp.QuoteMeta(strings.TrimRight(pattern, "/"))
+	expr = strings.ReplaceAll(expr, regexp.QuoteMeta("{id}"), `(?P<id>[0-9]+)`)
+	expr = strings.ReplaceAll(expr, regexp.QuoteMeta("{slug}"), `(?P<slug>[^/]+)`)
+	re, err := regexp.Compile("^" + expr + "/?$")
+	if err != nil {
+		re = nil
+	}
+	pathPatterns.Store(pattern, re)
+	return re
+}
+
+// pathOrDefault 路径模板未配置时使用默认值
+func pathOrDefault(path, fallback string) string {
+	if path == "" {
+		return fallback
+	}
+	return path
+}
@@ -0,0 +1,51 @@
+package site
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Done-0/jank/configs"
+)
+
+func TestMatchPostPath(t *testing.T) {
+	cases := []struct {
+		pattern string
+		path    string
+		want    map[string]string
+		ok      bool
+	}{
+		{"", "/post/123", map[string]string{"id": "123"}, true},
+		{"", "/post/123/", map[string]string{"id": "123"}, true},
+		{"", "/post/abc", nil, false},
+		{"", "/post/123/comments", nil, false},
+		{"/posts/{slug}", "/posts/hello-world", map[string]string{"slug": "hello-world"}, true},
+		{"/{slug}.html", "/hello.html", map[string]string{"slug": "hello"}, true},
+		{"/{slug}.html", "/hellohtml", nil, false},
+		{"/p/{id}/{slug}/", "/p/7/hello", map[string]string{"id": "7", "slug": "hello"}, true},
+	}
+	for _, tc := range cases {
+		got, ok := MatchPostPath(configs.SiteConfig{PostPath: tc.pattern}, tc.path)
+		if ok != tc.ok || (ok && !reflect.DeepEqual(got, tc.want)) {
+			t.Errorf("MatchPostPath(%q, %q) = %v, %v; want %v, %v", tc.pattern, tc.path, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestMatchCategoryPath(t *testing.T) {
+	if id, ok := MatchCategoryPath(configs.SiteConfig{}, "/category/42"); !ok || id != "42" {
+		t.Errorf("MatchCategoryPath = %q, %v", id, ok)
+	}
+	if _, ok := MatchCategoryPath(configs.SiteConfig{CategoryPath: "/c/{id}"}, "/category/42"); ok {
+		t.Errorf("MatchCategoryPath matched a different template")
+	}
+}
+
+func TestPostURLRoundTrip(t *testing.T) {
+	cfg := configs.SiteConfig{URL: "https://example.com/", PostPath: "/posts/{slug}"}
+	if got := PostURL(cfg, 9, ""); got != "https://example.com/posts/9" {
+		t.Errorf("PostURL = %s", got)
+	}
+	if params, ok := MatchPostPath(cfg, "/posts/9"); !ok || params["slug"] != "9" {
+		t.Errorf("MatchPostPath = %v, %v", params, ok)
+	}
+}
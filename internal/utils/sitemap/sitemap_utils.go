@@ -0,0 +1,111 @@
+// Package sitemap 提供 XML 站点地图生成工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package sitemap
+
+import (
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+// MaxURLs 单个站点地图文件允许的最大链接数量（sitemaps.org 协议限制）
+const MaxURLs = 50000
+
+// ContentType 站点地图内容类型
+const ContentType = "application/xml; charset=utf-8"
+
+// xmlns 站点地图命名空间
+const xmlns = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// URL 站点地图链接
+type URL struct {
+	Loc        string    // 页面绝对链接
+	LastMod    time.Time // 最近修改时间，零值时不输出
+	ChangeFreq string    // 更新频率，为空时不输出
+	Priority   float64   // 优先级（0.0 ~ 1.0），为 0 时不输出
+}
+
+// urlSet 站点地图根节点
+type urlSet struct {
+	XMLName xml.Name  `xml:"urlset"`
+	XMLNS   string    `xml:"xmlns,attr"`
+	URLs    []*xmlURL `xml:"url"`
+}
+
+// xmlURL 站点地图链接节点
+type xmlURL struct {
+	Loc        string `xml:"loc"`
+	LastMod    string `xml:"lastmod,omitempty"`
+	ChangeFreq string `xml:"changefreq,omitempty"`
+	Priority   string `xml:"priority,omitempty"`
+}
+
+// sitemapIndex 站点地图索引根节点
+type sitemapIndex struct {
+	XMLName  xml.Name      `xml:"sitemapindex"`
+	XMLNS    string        `xml:"xmlns,attr"`
+	Sitemaps []*xmlSitemap `xml:"sitemap"`
+}
+
+// xmlSitemap 站点地图索引条目
+type xmlSitemap struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// RenderURLSet 生成站点地图
+// 参数：
+//   - urls: 链接列表，不超过 MaxURLs 个
+//
+// 返回值：
+//   - []byte: XML 内容
+//   - error: 生成过程中的错误
+func RenderURLSet(urls []*URL) ([]byte, error) {
+	set := &urlSet{XMLNS: xmlns, URLs: make([]*xmlURL, 0, len(urls))}
+	for _, u := range urls {
+		xu := &xmlURL{Loc: u.Loc, ChangeFreq: u.ChangeFreq}
+		if !u.LastMod.IsZero() {
+			xu.LastMod = u.LastMod.UTC().Format(time.RFC3339)
+		}
+		if u.Priority > 0 {
+			xu.Priority = formatPriority(u.Priority)
+		}
+		set.URLs = append(set.URLs, xu)
+	}
+	return marshalXML(set)
+}
+
+// RenderIndex 生成站点地图索引
+// 参数：
+//   - locs: 各分页站点地图的绝对链接
+//   - lastMod: 站点最近修改时间，零值时不输出
+//
+// 返回值：
+//   - []byte: XML 内容
+//   - error: 生成过程中的错误
+func RenderIndex(locs []string, lastMod time.Time) ([]byte, error) {
+	index := &sitemapIndex{XMLNS: xmlns, Sitemaps: make([]*xmlSitemap, 0, len(locs))}
+	for _, loc := range locs {
+		s := &xmlSitemap{Loc: loc}
+		if !lastMod.IsZero() {
+			s.LastMod = lastMod.UTC().Format(time.RFC3339)
+		}
+		index.Sitemaps = append(index.Sitemaps, s)
+	}
+	return marshalXML(index)
+}
+
+// formatPriority 格式化优先级，保留一位小数
+func formatPriority(p float64) string {
+	return strconv.FormatFloat(min(max(p, 0), 1), 'f', 1, 64)
+}
+
+// marshalXML 序列化 XML 并添加声明头
+func marshalXML(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
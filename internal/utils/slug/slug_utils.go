@@ -0,0 +1,37 @@
+// Package slug 提供文章 URL 别名生成工具
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package slug
+
+import (
+	"strings"
+
+	gosimpleslug "github.com/gosimple/slug"
+)
+
+// MaxLength 别名最大长度
+const MaxLength = 100
+
+// Generate 根据文本生成 URL 别名，中日韩等非拉丁字符会被音译为拉丁字母
+// 参数：
+//   - text: 原始文本，通常为文章标题
+//
+// 返回值：
+//   - string: 生成的别名，无法生成时返回空字符串
+func Generate(text string) string {
+	s := gosimpleslug.Make(text)
+	if len(s) > MaxLength {
+		s = strings.TrimRight(s[:MaxLength], "-")
+	}
+	return s
+}
+
+// IsValid 检查别名是否合法（仅包含小写字母、数字与连字符，且不以连字符开头或结尾）
+// 参数：
+//   - s: 待检查的别名
+//
+// 返回值：
+//   - bool: 是否合法
+func IsValid(s string) bool {
+	return len(s) <= MaxLength && gosimpleslug.IsSlug(s)
+}
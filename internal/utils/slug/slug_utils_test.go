@@ -0,0 +1,33 @@
+package slug
+
+import "testing"
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"Hello, World!", "hello-world"},
+		{"  Go 1.24 Release Notes  ", "go-1-24-release-notes"},
+		{"你好世界", "ni-hao-shi-jie"},
+		{"!!!", ""},
+	}
+	for _, tt := range tests {
+		if got := Generate(tt.in); got != tt.want {
+			t.Errorf("Generate(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	for _, s := range []string{"hello-world", "a1"} {
+		if !IsValid(s) {
+			t.Errorf("IsValid(%q) = false, want true", s)
+		}
+	}
+	for _, s := range []string{"", "Hello", "-a", "a b"} {
+		if IsValid(s) {
+			t.Errorf("IsValid(%q) = true, want false", s)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+/**
+ * 静态站点 API 适配脚本，由 jank build-static 生成
+ * 拦截主题对公开只读接口的请求，改为读取构建时导出的 JSON 文件，使主题无需服务端即可运行
+ */
+(function () {
+  var API_PREFIX = "/api/v1";
+  var originalFetch = window.fetch.bind(window);
+  var cache = {};
+
+  /**
+   * 读取导出的 JSON 文件，同一文件仅请求一次
+   * @param {string} path - 文件路径
+   * @returns {Promise<Object>} 接口响应
+   */
+  function load(path) {
+    if (!cache[path]) {
+      cache[path] = originalFetch(path).then(function (response) {
+        if (!response.ok) {
+          throw new Error("HTTP error! status: " + response.status);
+        }
+        return response.json();
+      });
+    }
+    return cache[path];
+  }
+
+  /**
+   * 按查询参数对列表分页，与服务端分页响应格式一致
+   * @param {Object} result - 包含完整列表的接口响应
+   * @param {Array} list - 筛选后的列表
+   * @param {URLSearchParams} params - 查询参数
+   * @returns {Object} 分页后的接口响应
+   */
+  function paginate(result, list, params) {
+    var pageNo = Math.max(parseInt(params.get("page_no"), 10) || 1, 1);
+    var pageSize = Math.max(parseInt(params.get("page_size"), 10) || list.length || 1, 1);
+    var start = (pageNo - 1) * pageSize;
+    return Object.assign({}, result, {
+      data: {
+        total: list.length,
+        page_no: pageNo,
+        page_size: pageSize,
+        list: list.slice(start, start + pageSize)
+      }
+    });
+  }
+
+  /**
+   * 单个资源对应的 JSON 文件路径
+   * @param {string} endpoint - 接口路径
+   * @param {string|null} key - 资源 ID 或别名
+   * @returns {string} 文件路径
+   */
+  function entry(endpoint, key) {
+    return API_PREFIX + endpoint + "/" + encodeURIComponent(key || "") + ".json";
+  }
+
+  var routes = {};
+
+  routes[API_PREFIX + "/post/list-published"] = function (params) {
+    return load(API_PREFIX + "/post/list-published.json").then(function (result) {
+      var categoryId = params.get("category_id");
+      var tagId = params.get("tag_id");
+      var list = result.data.list.filter(function (post) {
+        if (categoryId && post.category_id !== categoryId) return false;
+        if (tagId && !(post.tags || []).some(function (tag) { return tag.id === tagId; })) return false;
+        return true;
+      });
+      return paginate(result, list, params);
+    });
+  };
+
+  routes[API_PREFIX + "/post/get"] = function (params) {
+    return load(entry("/post/get", params.get("id")));
+  };
+
+  routes[API_PREFIX + "/post/get-by-slug"] = function (params) {
+    return load(entry("/post/get-by-slug", params.get("slug")));
+  };
+
+  routes[API_PREFIX + "/category/list"] = function (params) {
+    return load(API_PREFIX + "/category/list.json").then(function (result) {
+      var parentId = params.get("parent_id");
+      var isActive = params.get("is_active");
+      var list = result.data.list.filter(function (category) {
+        if (parentId && category.parent_id !== parentId) return false;
+        if (isActive !== null && String(category.is_active) !== isActive) return false;
+        return true;
+      });
+      return paginate(result, list, params);
+    });
+  };
+
+  routes[API_PREFIX + "/category/get"] = function (params) {
+    return load(entry("/category/get", params.get("id")));
+  };
+
+  window.fetch = function (input, init) {
+    var url = new URL(typeof input === "string" ? input : input.url, window.location.href);
+    var handler = url.origin === window.location.origin ? routes[url.pathname] : null;
+    if (!handler) {
+      return originalFetch(input, init);
+    }
+
+    return handler(url.searchParams).then(function (result) {
+      return new Response(JSON.stringify(result), {
+        status: 200,
+        headers: { "Content-Type": "application/json" }
+      });
+    }, function () {
+      return new Response(JSON.stringify({ error: { code: "404", message: "resource not found" } }), {
+        status: 404,
+        headers: { "Content-Type": "application/json" }
+      });
+    });
+  };
+})();
@@ -0,0 +1,160 @@
+// Package staticsite 提供静态站点生成工具，包括页面路径转换、主题首页模板注入与目录复制
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package staticsite
+
+import (
+	_ "embed"
+	"fmt"
+	"html"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Done-0/jank/internal/utils/seo"
+)
+
+// APIScriptPath 静态 API 适配脚本的站内路径
+const APIScriptPath = "/static-api.js"
+
+// APIScript 静态 API 适配脚本：拦截主题对公开只读接口的请求，改为读取构建时导出的 JSON 文件
+//
+//go:embed staticsite_api.js
+var APIScript []byte
+
+var bodyPattern = regexp.MustCompile(`(?i)<body[^>]*>`)
+
+// PageMeta 页面元信息
+type PageMeta struct {
+	Title       string // 页面标题
+	Description string // 页面描述，为空时不输出
+	Canonical   string // 规范链接，为空时不输出
+	Head        string // 额外注入 <head> 的 HTML，如文章 SEO 元数据，为空时不输出
+	Fallback    string // 未启用脚本时显示的 HTML 内容，为空时不输出
+}
+
+// PagePath 将站内路径转换为输出目录中的文件路径，无扩展名的路径输出为目录下的 index.html
+// 参数：
+//   - urlPath: 站内路径，可带查询参数
+//
+// 返回值：
+//   - string: 以 / 分隔的相对文件路径
+//   - error: 路径越出输出目录时返回错误
+func PagePath(urlPath string) (string, error) {
+	if i := strings.IndexAny(urlPath, "?#"); i >= 0 {
+		urlPath = urlPath[:i]
+	}
+	if strings.Contains(urlPath, "..") {
+		return "", fmt.Errorf("invalid page path: %s", urlPath)
+	}
+
+	cleaned := strings.TrimPrefix(path.Clean("/"+urlPath), "/")
+	switch {
+	case cleaned == "":
+		return "index.html", nil
+	case path.Ext(cleaned) != "":
+		return cleaned, nil
+	default:
+		return cleaned + "/index.html", nil
+	}
+}
+
+// RenderPage 以主题首页为模板生成页面：替换标题，在 </head> 前注入描述、规范链接、额外元数据与静态 API 适配脚本，并在正文开头输出 noscript 内容
+// 参数：
+//   - index: 主题首页内容
+//   - meta: 页面元信息
+//
+// 返回值：
+//   - []byte: 页面内容
+func RenderPage(index []byte, meta *PageMeta) []byte {
+	var head []string
+	if meta.Description != "" {
+		head = append(head, `<meta name="description" content="`+html.EscapeString(meta.Description)+`">`)
+	}
+	if meta.Canonical != "" {
+		head = append(head, `<link rel="canonical" href="`+html.EscapeString(meta.Canonical)+`">`)
+	}
+	if meta.Head != "" {
+		head = append(head, meta.Head)
+	}
+	head = append(head, `<script src="`+APIScriptPath+`"></script>`)
+	page := string(seo.InjectHead(index, meta.Title, strings.Join(head, "\n")))
+
+	if meta.Fallback != "" {
+		fallback := "\n    <noscript>" + meta.Fallback + "</noscript>"
+		if loc := bodyPattern.FindStringIndex(page); loc != nil {
+			page = page[:loc[1]] + fallback + page[loc[1]:]
+		} else {
+			page += fallback
+		}
+	}
+	return []byte(page)
+}
+
+// CopyDir 递归复制目录，跳过隐藏文件，目标目录不存在时创建
+// 参数：
+//   - src: 源目录
+//   - dst: 目标目录
+//
+// 返回值：
+//   - int: 复制的文件数
+//   - error: 复制过程中的错误
+func CopyDir(src, dst string) (int, error) {
+	count := 0
+	err := filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p != src && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		if err := copyFile(p, target); err != nil {
+			return err
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		return count, fmt.Errorf("failed to copy '%s' to '%s': %w", src, dst, err)
+	}
+	return count, nil
+}
+
+// copyFile 复制单个文件
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,104 @@
+package staticsite
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPagePath(t *testing.T) {
+	cases := map[string]string{
+		"/":                  "index.html",
+		"":                   "index.html",
+		"/posts/hello":       "posts/hello/index.html",
+		"/posts/hello/":      "posts/hello/index.html",
+		"/posts/hello?x=1#a": "posts/hello/index.html",
+		"/404.html":          "404.html",
+		"/sitemap.xml":       "sitemap.xml",
+	}
+	for in, want := range cases {
+		got, err := PagePath(in)
+		if err != nil || got != want {
+			t.Errorf("PagePath(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+
+	if _, err := PagePath("/../etc/passwd"); err == nil {
+		t.Errorf("PagePath accepted path traversal")
+	}
+}
+
+func TestRenderPage(t *testing.T) {
+	index := []byte("<!doctype html>\n<html>\n  <head>\n    <meta charset=\"UTF-8\">\n    <title>Jank</title>\n  </head>\n  <body class=\"app\">\n    <div id=\"root\"></div>\n  </body>\n</html>")
+	page := string(RenderPage(index, &PageMeta{
+		Title:       "Hello & <World>",
+		Description: "A \"quoted\" post",
+		Canonical:   "https://example.com/posts/hello",
+		Fallback:    "<article><h1>Hello</h1></article>",
+	}))
+
+	for _, want := range []string{
+		"<title>Hello &amp; &lt;World&gt;</title>",
+		`<meta name="description" content="A &#34;quoted&#34; post">`,
+		`<link rel="canonical" href="https://example.com/posts/hello">`,
+		`<script src="/static-api.js"></script>`,
+		"<body class=\"app\">\n    <noscript><article><h1>Hello</h1></article></noscript>",
+	} {
+		if !strings.Contains(page, want) {
+			t.Errorf("page missing %q:\n%s", want, page)
+		}
+	}
+	if strings.Count(page, "<title>") != 1 {
+		t.Errorf("page has duplicate titles:\n%s", page)
+	}
+	if strings.Index(page, `<script src="/static-api.js">`) > strings.Index(page, "</head>") {
+		t.Errorf("script not injected into head:\n%s", page)
+	}
+}
+
+func TestRenderPageWithoutTitle(t *testing.T) {
+	page := string(RenderPage([]byte("<html><head></head><body></body></html>"), &PageMeta{Title: "Home"}))
+	if !strings.Contains(page, "<title>Home</title>") || strings.Contains(page, "noscript") || strings.Contains(page, "description") {
+		t.Errorf("page = %s", page)
+	}
+}
+
+func TestCopyDir(t *testing.T) {
+	src, dst := t.TempDir(), filepath.Join(t.TempDir(), "out")
+	files := map[string]string{
+		"app.js":            "js",
+		"css/style.css":     "css",
+		".DS_Store":         "hidden",
+		".git/config":       "hidden",
+		"fonts/.keep":       "hidden",
+		"fonts/inter.woff2": "font",
+	}
+	for name, content := range files {
+		p := filepath.Join(src, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	count, err := CopyDir(src, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("count = %d, want 3", count)
+	}
+	for name, content := range files {
+		data, err := os.ReadFile(filepath.Join(dst, name))
+		hidden := content == "hidden"
+		if hidden && err == nil {
+			t.Errorf("hidden file %s copied", name)
+		}
+		if !hidden && string(data) != content {
+			t.Errorf("%s = %q, %v; want %q", name, data, err, content)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Package theme 主题构建工具函数
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package theme
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"strings"
+)
+
+// Templates 已解析的主题页面模板
+type Templates struct {
+	pages map[string]*template.Template
+}
+
+// LoadTemplates 解析主题声明的页面模板，每个页面模板与公共模板一同解析，公共模板可通过 define/block 定义布局与片段
+// 参数：
+//   - themePath: 主题目录
+//   - pages: 页面名称 -> 模板文件相对路径，路径为空的页面视为未提供模板
+//   - partials: 公共模板文件相对路径，支持通配符
+//   - funcs: 模板函数
+//
+// 返回值：
+//   - *Templates: 已解析的页面模板
+//   - error: 模板文件越出主题目录或解析失败时返回错误
+func LoadTemplates(themePath string, pages map[string]string, partials []string, funcs template.FuncMap) (*Templates, error) {
+	var partialFiles []string
+	for _, pattern := range partials {
+		resolved, err := resolveThemeFile(themePath, pattern)
+		if err != nil {
+			return nil, err
+		}
+		matches, err := filepath.Glob(resolved)
+		if err != nil {
+			return nil, fmt.Errorf("invalid partial template pattern '%s': %w", pattern, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("partial template '%s' matches no files", pattern)
+		}
+		partialFiles = append(partialFiles, matches...)
+	}
+
+	t := &Templates{pages: make(map[string]*template.Template, len(pages))}
+	for name, file := range pages {
+		if file == "" {
+			continue
+		}
+		resolved, err := resolveThemeFile(themePath, file)
+		if err != nil {
+			return nil, err
+		}
+
+		files := append([]string{resolved}, partialFiles...)
+		page, err := template.New(filepath.Base(resolved)).Funcs(funcs).ParseFiles(files...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s template '%s': %w", name, file, err)
+		}
+		t.pages[name] = page
+	}
+	return t, nil
+}
+
+// Has 判断是否提供了指定页面的模板
+// 参数：
+//   - page: 页面名称
+//
+// 返回值：
+//   - bool: 是否提供模板
+func (t *Templates) Has(page string) bool {
+	return t != nil && t.pages[page] != nil
+}
+
+// Render 渲染页面模板
+// 参数：
+//   - page: 页面名称
+//   - data: 模板数据
+//
+// 返回值：
+//   - []byte: 渲染结果
+//   - error: 未提供模板或渲染失败时返回错误
+func (t *Templates) Render(page string, data any) ([]byte, error) {
+	if !t.Has(page) {
+		return nil, fmt.Errorf("theme provides no %s template", page)
+	}
+
+	var buf bytes.Buffer
+	if err := t.pages[page].Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to render %s template: %w", page, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// resolveThemeFile 将主题内相对路径转换为文件路径，拒绝越出主题目录的路径
+func resolveThemeFile(themePath, rel string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(strings.TrimPrefix(rel, "/")))
+	if cleaned == "." || filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("template path '%s' is outside the theme directory", rel)
+	}
+	return filepath.Join(themePath, cleaned), nil
+}
@@ -0,0 +1,94 @@
+package theme
+
+import (
+	"html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeThemeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLoadTemplates(t *testing.T) {
+	dir := writeThemeFiles(t, map[string]string{
+		"templates/layout/base.html":  `{{define "base"}}<html><title>{{.Title}}</title><body>{{template "content" .}}</body></html>{{end}}`,
+		"templates/layout/nav.html":   `{{define "nav"}}<nav>{{upper .Title}}</nav>{{end}}`,
+		"templates/index.html":        `{{template "base" .}}{{define "content"}}{{template "nav" .}}<p>{{.Body}}</p>{{end}}`,
+		"templates/post.html":         `{{template "base" .}}{{define "content"}}<article>{{.Body}}</article>{{end}}`,
+		"templates/ignored/note.html": `{{.Missing}}`,
+	})
+	funcs := template.FuncMap{"upper": strings.ToUpper}
+
+	tmpl, err := LoadTemplates(dir, map[string]string{
+		"index":    "templates/index.html",
+		"post":     "/templates/post.html",
+		"category": "",
+	}, []string{"templates/layout/*.html"}, funcs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !tmpl.Has("index") || !tmpl.Has("post") || tmpl.Has("category") {
+		t.Fatalf("unexpected pages: %v", tmpl.pages)
+	}
+
+	data := struct{ Title, Body string }{"Home", "<b>hi</b>"}
+	out, err := tmpl.Render("index", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "<html><title>Home</title><body><nav>HOME</nav><p>&lt;b&gt;hi&lt;/b&gt;</p></body></html>"; string(out) != want {
+		t.Errorf("index = %s, want %s", out, want)
+	}
+
+	// 各页面的 content 定义互不覆盖
+	out, err = tmpl.Render("post", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), "<article>") || strings.Contains(string(out), "<nav>") {
+		t.Errorf("post = %s", out)
+	}
+
+	if _, err := tmpl.Render("category", data); err == nil {
+		t.Errorf("Render succeeded for missing template")
+	}
+}
+
+func TestLoadTemplatesErrors(t *testing.T) {
+	dir := writeThemeFiles(t, map[string]string{
+		"index.html": `{{.Title}`,
+		"ok.html":    `ok`,
+	})
+
+	cases := []struct {
+		name     string
+		pages    map[string]string
+		partials []string
+	}{
+		{"syntax error", map[string]string{"index": "index.html"}, nil},
+		{"missing file", map[string]string{"index": "missing.html"}, nil},
+		{"outside theme", map[string]string{"index": "../ok.html"}, nil},
+		{"partial outside theme", map[string]string{"index": "ok.html"}, []string{"../*.html"}},
+		{"partial matches nothing", map[string]string{"index": "ok.html"}, []string{"partials/*.html"}},
+	}
+	for _, tc := range cases {
+		if _, err := LoadTemplates(dir, tc.pages, tc.partials, nil); err == nil {
+			t.Errorf("%s: expected error", tc.name)
+		}
+	}
+}
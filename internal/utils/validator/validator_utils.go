@@ -3,7 +3,18 @@
 // 创建时间：2025-08-05
 package validator
 
-import "github.com/go-playground/validator/v10"
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/Done-0/jank/internal/storage"
+)
+
+// MediaURLTag 媒体地址校验标签，接受绝对地址或本地存储访问路径前缀下的站内路径
+const MediaURLTag = "media_url"
 
 // ValidErrRes 验证错误结果结构体
 type ValidErrRes struct {
@@ -14,7 +25,30 @@ type ValidErrRes struct {
 }
 
 // NewValidator 全局验证器实例
-var NewValidator = validator.New()
+var NewValidator = newValidator()
+
+// urlValidator 校验绝对地址使用的内置验证器
+var urlValidator = validator.New()
+
+// newValidator 创建验证器并注册自定义校验标签
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterValidation(MediaURLTag, validateMediaURL)
+	return v
+}
+
+// validateMediaURL 校验媒体地址：本地存储返回的是以访问路径前缀开头的站内路径，需与绝对地址一并接受
+func validateMediaURL(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if prefix := storage.LocalURLPrefix(); prefix != "" {
+		u, err := url.Parse(value)
+		if err == nil && u.Scheme == "" && u.Host == "" && u.RawQuery == "" && u.Fragment == "" &&
+			u.Path == path.Clean(u.Path) && strings.HasPrefix(u.Path, strings.TrimSuffix(prefix, "/")+"/") {
+			return true
+		}
+	}
+	return urlValidator.Var(value, "url") == nil
+}
 
 // Validate 参数验证器
 // 参数：
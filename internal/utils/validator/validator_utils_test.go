@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/storage"
+)
+
+func TestMediaURL(t *testing.T) {
+	type request struct {
+		Image string `validate:"omitempty,media_url"`
+	}
+
+	cases := map[string]bool{
+		"https://cdn.example.com/a.png": true,
+		"/uploads/2026/10/a.png":        true,
+		"":                              true,
+		"/uploads/../configs/a.yaml":    false,
+		"/static/a.png":                 false,
+		"//evil.example.com/a.png":      false,
+		"uploads/a.png":                 false,
+	}
+
+	previous := storage.GlobalStorage
+	t.Cleanup(func() { storage.GlobalStorage = previous })
+	local, err := storage.NewStorage(configs.StorageConfig{Local: configs.LocalStorageConfig{Root: t.TempDir(), URLPrefix: "/uploads"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	storage.GlobalStorage = local
+
+	for value, want := range cases {
+		if got := len(Validate(&request{Image: value})) == 0; got != want {
+			t.Errorf("media_url(%q) = %v, want %v", value, got, want)
+		}
+	}
+
+	// 非本地存储时仅接受绝对地址
+	storage.GlobalStorage = nil
+	if len(Validate(&request{Image: "/uploads/2026/10/a.png"})) == 0 {
+		t.Error("media_url accepted a site path without local storage")
+	}
+}
@@ -4,9 +4,29 @@
 package main
 
 import (
+	"os"
+
 	"github.com/Done-0/jank/cmd"
 )
 
 func main() {
+	// 子命令：jank import 导入文章，jank export / jank restore 导出与恢复站点，jank build-static 生成静态站点
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "import":
+			cmd.Import(os.Args[2:])
+			return
+		case "export":
+			cmd.Export(os.Args[2:])
+			return
+		case "restore":
+			cmd.Restore(os.Args[2:])
+			return
+		case "build-static":
+			cmd.BuildStatic(os.Args[2:])
+			return
+		}
+	}
+
 	cmd.Start()
 }
@@ -28,12 +28,33 @@ func New(app *server.Hertz) {
 	// 注册分类相关的路由
 	routes.RegisterCategoryRoutes(api)
 
+	// 注册标签相关的路由
+	routes.RegisterTagRoutes(api)
+
+	// 注册文章系列相关的路由
+	routes.RegisterSeriesRoutes(api)
+
 	// 注册文章相关的路由
 	routes.RegisterPostRoutes(api)
 
+	// 注册文章表态相关的路由
+	routes.RegisterReactionRoutes(api)
+
+	// 注册评论相关的路由
+	routes.RegisterCommentRoutes(api)
+
 	// 注册插件相关的路由
 	routes.RegisterPluginRoutes(api)
 
+	// 注册订阅源相关的路由
+	routes.RegisterFeedRoutes(app)
+
+	// 注册站点地图与 robots.txt 路由
+	routes.RegisterSitemapRoutes(app)
+
+	// 注册媒体文件相关的路由
+	routes.RegisterMediaRoutes(app, api)
+
 	// 注册主题相关的路由
 	routes.RegisterThemeRoutes(app, api)
 }
@@ -0,0 +1,34 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package routes
+
+import (
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/route"
+
+	"github.com/Done-0/jank/internal/middleware/jwt"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterCommentRoutes 注册评论相关路由
+func RegisterCommentRoutes(r *route.RouterGroup) {
+	commentController, err := wire.NewCommentController()
+	if err != nil {
+		log.Fatalf("Failed to initialize comment controller: %v", err)
+	}
+
+	// 评论路由组
+	commentGroup := r.Group("/comment")
+	{
+		// 公开接口（匿名或登录用户均可访问）
+		commentGroup.GET("/list", commentController.ListComments)                 // 获取文章评论列表
+		commentGroup.POST("/create", jwt.NewOptional(), commentController.Create) // 创建评论（具备免审核权限的登录用户直接公开）
+
+		// 管理接口（需具备审核评论权限）
+		commentGroup.GET("/list-by-status", jwt.New(), commentController.ListCommentsByStatus) // 根据审核状态获取评论列表
+		commentGroup.POST("/moderate", jwt.New(), commentController.Moderate)                  // 审核评论
+		commentGroup.POST("/delete", jwt.New(), commentController.Delete)                      // 删除评论
+	}
+}
@@ -0,0 +1,24 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package routes
+
+import (
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterFeedRoutes 注册订阅源路由（站点根路径，支持 category_id、tag_id 查询参数筛选）
+func RegisterFeedRoutes(h *server.Hertz) {
+	feedController, err := wire.NewFeedController()
+	if err != nil {
+		log.Fatalf("Failed to initialize feed controller: %v", err)
+	}
+
+	h.GET("/feed.xml", feedController.RSS)   // RSS 2.0 订阅源
+	h.GET("/atom.xml", feedController.Atom)  // Atom 1.0 订阅源
+	h.GET("/feed.json", feedController.JSON) // JSON Feed 1.1 订阅源
+}
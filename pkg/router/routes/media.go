@@ -0,0 +1,38 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package routes
+
+import (
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/route"
+
+	"github.com/Done-0/jank/internal/middleware/jwt"
+	"github.com/Done-0/jank/internal/storage"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterMediaRoutes 注册媒体文件与图片变体访问路由，使用本地存储时同时注册文件访问路由
+func RegisterMediaRoutes(h *server.Hertz, r *route.RouterGroup) {
+	mediaController, err := wire.NewMediaController()
+	if err != nil {
+		log.Fatalf("Failed to initialize media controller: %v", err)
+	}
+
+	// 媒体文件路由组
+	mediaGroup := r.Group("/media", jwt.New())
+	{
+		mediaGroup.POST("/upload", mediaController.Upload) // 上传媒体文件（multipart/form-data，字段名 file）
+		mediaGroup.GET("/list", mediaController.List)      // 获取媒体文件列表（具备管理权限时返回所有用户的文件）
+		mediaGroup.POST("/delete", mediaController.Delete) // 删除媒体文件
+	}
+
+	h.GET(consts.MediaVariantPathPrefix+"/*filepath", mediaController.ServeVariant) // 图片变体访问（尚未生成时按需生成）
+
+	if prefix := storage.LocalURLPrefix(); prefix != "" {
+		h.GET(prefix+"/*filepath", mediaController.ServeLocalFile) // 本地存储文件访问
+	}
+}
@@ -22,11 +22,30 @@ func RegisterPostRoutes(r *route.RouterGroup) {
 	// 文章路由组
 	postGroup := r.Group("/post")
 	{
-		postGroup.GET("/get", postController.GetPost)                                 // 获取单篇文章
-		postGroup.GET("/list-published", postController.ListPublishedPosts)           // 获取已发布文章列表
-		postGroup.GET("/list-by-status", jwt.New(), postController.ListPostsByStatus) // 根据状态获取文章列表（支持管理员查询所有文章）
-		postGroup.POST("/create", jwt.New(), postController.Create)                   // 创建文章
-		postGroup.POST("/update", jwt.New(), postController.Update)                   // 更新文章
-		postGroup.POST("/delete", jwt.New(), postController.Delete)                   // 删除文章
+		postGroup.GET("/get", jwt.NewOptional(), postController.GetPost)               // 获取单篇文章（草稿、私有及定时发布文章仅作者与管理员可见）
+		postGroup.GET("/get-by-slug", jwt.NewOptional(), postController.GetPostBySlug) // 根据别名获取文章
+		postGroup.GET("/list-published", postController.ListPublishedPosts)            // 获取已发布文章列表
+		postGroup.GET("/list-by-author", postController.ListPostsByAuthor)             // 获取指定作者的已发布文章列表
+		postGroup.GET("/search", postController.Search)                                // 全文检索已发布文章
+		postGroup.GET("/list-by-status", jwt.New(), postController.ListPostsByStatus)  // 根据状态获取文章列表（支持管理员查询所有文章）
+		postGroup.POST("/create", jwt.New(), postController.Create)                    // 创建文章
+		postGroup.POST("/update", jwt.New(), postController.Update)                    // 更新文章
+		postGroup.POST("/delete", jwt.New(), postController.Delete)                    // 删除文章
+		postGroup.POST("/rerender", jwt.New(), postController.RerenderPosts)           // 按当前渲染与清洗配置重新渲染全部文章（需具备重新渲染权限）
+		postGroup.POST("/import", jwt.New(), postController.Import)                    // 从 Markdown、zip 或 WordPress WXR 文件导入文章（需具备导入权限）
+		postGroup.POST("/pin", jwt.New(), postController.Pin)                          // 设置或取消文章置顶（需具备置顶权限）
+		postGroup.POST("/pin/reorder", jwt.New(), postController.ReorderPins)          // 调整置顶文章顺序（需具备置顶权限）
+		postGroup.POST("/feature", jwt.New(), postController.Feature)                  // 设置或取消文章推荐（需具备置顶权限）
+		postGroup.GET("/list-pinned", postController.ListPinnedPosts)                  // 获取置顶文章列表
+		postGroup.GET("/popular/week", postController.ListPopularWeek)                 // 获取近 7 天浏览量最多的文章
+		postGroup.GET("/popular/all-time", postController.ListPopularAllTime)          // 获取总浏览量最多的文章
+	}
+
+	// 文章修订路由组
+	revisionGroup := postGroup.Group("/revision", jwt.New())
+	{
+		revisionGroup.GET("/list", postController.ListRevisions)       // 获取文章修订列表
+		revisionGroup.GET("/diff", postController.DiffRevisions)       // 对比文章修订
+		revisionGroup.POST("/restore", postController.RestoreRevision) // 恢复文章修订
 	}
 }
@@ -0,0 +1,30 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package routes
+
+import (
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/route"
+
+	"github.com/Done-0/jank/internal/middleware/jwt"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterReactionRoutes 注册文章表态相关路由
+func RegisterReactionRoutes(r *route.RouterGroup) {
+	reactionController, err := wire.NewReactionController()
+	if err != nil {
+		log.Fatalf("Failed to initialize reaction controller: %v", err)
+	}
+
+	// 文章表态路由组
+	reactionGroup := r.Group("/reaction")
+	{
+		reactionGroup.GET("/types", reactionController.ListTypes)                     // 获取已配置的表态类型
+		reactionGroup.GET("/get", jwt.NewOptional(), reactionController.GetReactions) // 获取文章表态数量及当前用户或访客已表态的类型
+		reactionGroup.POST("/toggle", jwt.NewOptional(), reactionController.Toggle)   // 切换文章表态（登录用户按账号去重，匿名访客按客户端指纹去重）
+		reactionGroup.GET("/report", jwt.New(), reactionController.Report)            // 获取表态最多的文章报表
+	}
+}
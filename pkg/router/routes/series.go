@@ -0,0 +1,31 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package routes
+
+import (
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/route"
+
+	"github.com/Done-0/jank/internal/middleware/jwt"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterSeriesRoutes 注册文章系列相关路由
+func RegisterSeriesRoutes(r *route.RouterGroup) {
+	seriesController, err := wire.NewSeriesController()
+	if err != nil {
+		log.Fatalf("Failed to initialize series controller: %v", err)
+	}
+
+	// 文章系列路由组
+	seriesGroup := r.Group("/series")
+	{
+		seriesGroup.GET("/get", seriesController.GetSeries)             // 获取单个系列及其文章
+		seriesGroup.GET("/list", seriesController.ListSeries)           // 获取系列列表
+		seriesGroup.POST("/create", jwt.New(), seriesController.Create) // 创建系列
+		seriesGroup.POST("/update", jwt.New(), seriesController.Update) // 更新系列
+		seriesGroup.POST("/delete", jwt.New(), seriesController.Delete) // 删除系列
+	}
+}
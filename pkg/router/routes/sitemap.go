@@ -0,0 +1,23 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package routes
+
+import (
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterSitemapRoutes 注册站点地图与 robots.txt 路由（站点根路径，优先于主题静态资源回退处理）
+func RegisterSitemapRoutes(h *server.Hertz) {
+	sitemapController, err := wire.NewSitemapController()
+	if err != nil {
+		log.Fatalf("Failed to initialize sitemap controller: %v", err)
+	}
+
+	h.GET("/sitemap.xml", sitemapController.Sitemap) // 站点地图，链接数超过上限时返回站点地图索引，分页通过 page 查询参数获取
+	h.GET("/robots.txt", sitemapController.Robots)   // robots.txt
+}
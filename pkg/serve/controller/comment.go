@@ -0,0 +1,171 @@
+// Package controller 评论控制器
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/errorx"
+	"github.com/Done-0/jank/internal/utils/validator"
+	"github.com/Done-0/jank/internal/utils/vo"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+)
+
+// CommentController 评论控制器
+type CommentController struct {
+	commentService service.CommentService
+}
+
+// NewCommentController 创建评论控制器
+func NewCommentController(commentService service.CommentService) *CommentController {
+	return &CommentController{
+		commentService: commentService,
+	}
+}
+
+// ListComments 获取文章评论列表
+// @Router /api/v1/comment/list [get]
+func (cc *CommentController) ListComments(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListCommentsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := cc.commentService.ListComments(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrCommentListFailed, errorx.KV("msg", "list comments failed"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListCommentsByStatus 根据审核状态获取评论列表
+// @Router /api/v1/comment/list-by-status [get]
+func (cc *CommentController) ListCommentsByStatus(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListCommentsByStatusRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := cc.commentService.ListCommentsByStatus(c, req)
+	if err != nil {
+		if isPermissionDenied(err) {
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "comment moderation"))))
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrCommentListFailed, errorx.KV("msg", "list comments by status failed"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Create 创建评论
+// @Router /api/v1/comment/create [post]
+func (cc *CommentController) Create(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.CreateCommentRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := cc.commentService.Create(c, req)
+	if err != nil {
+		// 检查是否是评论权限相关错误
+		if strings.Contains(err.Error(), "only allowed on published posts") ||
+			strings.Contains(err.Error(), "cannot be replied to") ||
+			strings.Contains(err.Error(), "required for anonymous comments") {
+			c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrCommentCreateFailed, errorx.KV("post_id", req.PostID))))
+			return
+		}
+
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrCommentCreateFailed, errorx.KV("post_id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Moderate 审核评论
+// @Router /api/v1/comment/moderate [post]
+func (cc *CommentController) Moderate(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ModerateCommentRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := cc.commentService.Moderate(c, req)
+	if err != nil {
+		if isPermissionDenied(err) {
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "comment moderation"))))
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrCommentModerateFailed, errorx.KV("id", strings.Join(req.IDs, ",")))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Delete 删除评论
+// @Router /api/v1/comment/delete [post]
+func (cc *CommentController) Delete(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.DeleteCommentRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := cc.commentService.Delete(c, req)
+	if err != nil {
+		if isPermissionDenied(err) {
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "comment moderation"))))
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrCommentDeleteFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
@@ -0,0 +1,10 @@
+// Package dto 提供订阅源相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package dto
+
+// GetFeedRequest 获取订阅源请求
+type GetFeedRequest struct {
+	CategoryID *int64 `query:"category_id" validate:"omitempty,min=1"` // 分类 ID，为空时不按分类筛选
+	TagID      *int64 `query:"tag_id" validate:"omitempty,min=1"`      // 标签 ID，为空时不按标签筛选
+}
@@ -0,0 +1,23 @@
+// Package dto 提供媒体文件相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package dto
+
+import "mime/multipart"
+
+// UploadMediaRequest 上传媒体文件请求（multipart/form-data）
+type UploadMediaRequest struct {
+	File *multipart.FileHeader `form:"file" validate:"required"` // 上传的文件
+}
+
+// ListMediaRequest 获取媒体文件列表请求
+type ListMediaRequest struct {
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+	MimeType string `query:"mime_type" validate:"omitempty,max=100"`      // MIME 类型前缀筛选，如 image/，为空时不筛选
+}
+
+// DeleteMediaRequest 删除媒体文件请求
+type DeleteMediaRequest struct {
+	ID string `json:"id" validate:"required"` // 媒体文件 ID
+}
@@ -3,14 +3,28 @@
 // 创建时间：2025-08-13
 package dto
 
+import "mime/multipart"
+
 // CreatePostRequest 创建文章请求
 type CreatePostRequest struct {
-	Title       string `json:"title" validate:"required,min=1,max=255"`                            // 文章标题
-	Description string `json:"description" validate:"omitempty,max=500"`                           // 文章描述/摘要
-	Image       string `json:"image" validate:"omitempty,url"`                                     // 文章封面图片
-	Status      string `json:"status" validate:"omitempty,oneof=draft published private archived"` // 文章状态
-	CategoryID  string `json:"category_id" validate:"omitempty"`                                   // 分类 ID
-	Markdown    string `json:"markdown" validate:"omitempty,max=100000"`                           // Markdown 内容
+	Title       string   `json:"title" validate:"required,min=1,max=255"`                                      // 文章标题
+	Slug        string   `json:"slug" validate:"omitempty,max=100"`                                            // URL 别名，为空时根据标题自动生成
+	Description string   `json:"description" validate:"omitempty,max=500"`                                     // 文章描述/摘要
+	Image       string   `json:"image" validate:"omitempty,media_url"`                                         // 文章封面图片
+	Status      string   `json:"status" validate:"omitempty,oneof=draft published private archived scheduled"` // 文章状态
+	CategoryID  string   `json:"category_id" validate:"omitempty"`                                             // 分类 ID
+	PublishAt   string   `json:"publish_at" validate:"omitempty,datetime=2006-01-02 15:04:05"`                 // 发布时间，定时发布（scheduled）时必填且须晚于当前时间
+	Tags        []string `json:"tags" validate:"omitempty,max=20,dive,min=1,max=64"`                           // 标签名称列表，不存在的标签将自动创建
+	Markdown    string   `json:"markdown" validate:"omitempty,max=100000"`                                     // Markdown 内容
+
+	// SEO 相关，为空时使用文章对应字段
+	MetaTitle       string `json:"meta_title" validate:"omitempty,max=255"`         // SEO 标题
+	MetaDescription string `json:"meta_description" validate:"omitempty,max=500"`   // SEO 描述
+	CanonicalURL    string `json:"canonical_url" validate:"omitempty,url,max=500"`  // 规范链接
+	NoIndex         bool   `json:"no_index"`                                        // 是否禁止搜索引擎收录
+	OGImage         string `json:"og_image" validate:"omitempty,media_url,max=255"` // 分享卡片图片
+
+	ReactionTypes []string `json:"reaction_types" validate:"omitempty,max=20,dive,min=1,max=32"` // 启用的表态类型，须为已配置的类型，为空时启用全部已配置类型
 }
 
 // DeletePostRequest 删除文章请求
@@ -23,28 +37,121 @@ type GetPostRequest struct {
 	ID string `query:"id" validate:"required"` // 文章 ID
 }
 
+// GetPostBySlugRequest 根据别名获取文章请求
+type GetPostBySlugRequest struct {
+	Slug string `query:"slug" validate:"required,max=255"` // URL 别名
+}
+
 // UpdatePostRequest 更新文章请求
 type UpdatePostRequest struct {
-	ID          string `json:"id" validate:"required"`                                             // 文章 ID
-	Title       string `json:"title" validate:"omitempty,min=1,max=255"`                           // 文章标题
-	Description string `json:"description" validate:"omitempty,max=500"`                           // 文章描述/摘要
-	Image       string `json:"image" validate:"omitempty,url"`                                     // 文章封面图片
-	Status      string `json:"status" validate:"omitempty,oneof=draft published private archived"` // 文章状态
-	CategoryID  string `json:"category_id" validate:"omitempty"`                                   // 分类 ID
-	Markdown    string `json:"markdown" validate:"omitempty,max=100000"`                           // Markdown内容
+	ID          string   `json:"id" validate:"required"`                                                       // 文章 ID
+	Title       string   `json:"title" validate:"omitempty,min=1,max=255"`                                     // 文章标题
+	Slug        string   `json:"slug" validate:"omitempty,max=100"`                                            // URL 别名，修改后旧别名将保留用于重定向
+	Description string   `json:"description" validate:"omitempty,max=500"`                                     // 文章描述/摘要
+	Image       string   `json:"image" validate:"omitempty,media_url"`                                         // 文章封面图片
+	Status      string   `json:"status" validate:"omitempty,oneof=draft published private archived scheduled"` // 文章状态
+	CategoryID  string   `json:"category_id" validate:"omitempty"`                                             // 分类 ID
+	PublishAt   string   `json:"publish_at" validate:"omitempty,datetime=2006-01-02 15:04:05"`                 // 发布时间，定时发布（scheduled）时必填且须晚于当前时间
+	Tags        []string `json:"tags" validate:"omitempty,max=20,dive,min=1,max=64"`                           // 标签名称列表，为 null 时不修改，为空数组时清空标签
+	Markdown    string   `json:"markdown" validate:"omitempty,max=100000"`                                     // Markdown内容
+
+	// SEO 相关，为 null 时不修改，为空字符串时清空（回退为文章对应字段）
+	MetaTitle       *string `json:"meta_title" validate:"omitempty,max=255"`         // SEO 标题
+	MetaDescription *string `json:"meta_description" validate:"omitempty,max=500"`   // SEO 描述
+	CanonicalURL    *string `json:"canonical_url" validate:"omitempty,url,max=500"`  // 规范链接
+	NoIndex         *bool   `json:"no_index"`                                        // 是否禁止搜索引擎收录
+	OGImage         *string `json:"og_image" validate:"omitempty,media_url,max=255"` // 分享卡片图片
+
+	ReactionTypes []string `json:"reaction_types" validate:"omitempty,max=20,dive,min=1,max=32"` // 启用的表态类型，须为已配置的类型，为 null 时不修改，为空数组时启用全部已配置类型
 }
 
 // ListPublishedPostsRequest 获取文章列表请求
 type ListPublishedPostsRequest struct {
-	PageNo     int64  `query:"page_no" validate:"required,min=1"`           // 页码
-	PageSize   int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
-	CategoryID *int64 `query:"category_id" validate:"omitempty"`            // 分类ID，为空时不按分类筛选
+	PageNo     int64  `query:"page_no" validate:"required,min=1"`                                      // 页码
+	PageSize   int64  `query:"page_size" validate:"required,min=1,max=100"`                            // 每页数量
+	CategoryID *int64 `query:"category_id" validate:"omitempty"`                                       // 分类ID，为空时不按分类筛选
+	TagID      *int64 `query:"tag_id" validate:"omitempty"`                                            // 标签ID，为空时不按标签筛选
+	SeriesID   *int64 `query:"series_id" validate:"omitempty"`                                         // 系列ID，为空时不按系列筛选，有值时按系列顺序排列
+	Featured   *bool  `query:"featured" validate:"omitempty"`                                          // 是否推荐，为空时不按推荐筛选
+	Sort       string `query:"sort" validate:"omitempty,oneof=newest oldest most_viewed last_updated"` // 排序方式：newest、oldest、most_viewed、last_updated，为空时按最新发布；未指定排序方式且未按标签、系列或推荐筛选时置顶文章优先
+}
+
+// ListPostsByAuthorRequest 获取作者文章列表请求
+type ListPostsByAuthorRequest struct {
+	AuthorID int64  `query:"author_id" validate:"required,min=1"`                                    // 作者用户 ID
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`                                      // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"`                            // 每页数量
+	Sort     string `query:"sort" validate:"omitempty,oneof=newest oldest most_viewed last_updated"` // 排序方式：newest（默认）、oldest、most_viewed、last_updated
+}
+
+// SearchPostsRequest 检索文章请求
+type SearchPostsRequest struct {
+	Keyword  string `query:"keyword" validate:"required,min=1,max=100"`   // 检索关键字，多个关键字以空格分隔
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
 }
 
 // ListPostsByStatusRequest 根据状态获取文章列表请求
 type ListPostsByStatusRequest struct {
-	PageNo     int64  `query:"page_no" validate:"required,min=1"`                                  // 页码
-	PageSize   int64  `query:"page_size" validate:"required,min=1,max=100"`                        // 每页数量
-	Status     string `query:"status" validate:"omitempty,oneof=draft published private archived"` // 文章状态，为空时获取所有文章
-	CategoryID *int64 `query:"category_id" validate:"omitempty"`                                   // 分类ID，为空时不按分类筛选，有值时必须大于0
+	PageNo     int64  `query:"page_no" validate:"required,min=1"`                                            // 页码
+	PageSize   int64  `query:"page_size" validate:"required,min=1,max=100"`                                  // 每页数量
+	Status     string `query:"status" validate:"omitempty,oneof=draft published private archived scheduled"` // 文章状态，为空时获取所有文章
+	CategoryID *int64 `query:"category_id" validate:"omitempty"`                                             // 分类ID，为空时不按分类筛选，有值时必须大于0
+	SeriesID   *int64 `query:"series_id" validate:"omitempty"`                                               // 系列ID，为空时不按系列筛选，有值时按系列顺序排列
+	Featured   *bool  `query:"featured" validate:"omitempty"`                                                // 是否推荐，为空时不按推荐筛选
+	Sort       string `query:"sort" validate:"omitempty,oneof=newest oldest most_viewed last_updated"`       // 排序方式：newest（默认）、oldest、most_viewed、last_updated
+}
+
+// PinPostRequest 置顶文章请求
+type PinPostRequest struct {
+	ID       string `json:"id" validate:"required"`                                      // 文章 ID
+	Scope    string `json:"scope" validate:"required,oneof=none global category"`        // 置顶范围：none（取消置顶）、global（全局置顶）、category（分类置顶）
+	ExpireAt string `json:"expire_at" validate:"omitempty,datetime=2006-01-02 15:04:05"` // 置顶到期时间，为空时永久置顶
+}
+
+// FeaturePostRequest 推荐文章请求
+type FeaturePostRequest struct {
+	ID       string `json:"id" validate:"required"`       // 文章 ID
+	Featured *bool  `json:"featured" validate:"required"` // 是否推荐
+}
+
+// ListPopularPostsRequest 获取热门文章列表请求
+type ListPopularPostsRequest struct {
+	Limit int64 `query:"limit" validate:"omitempty,min=1,max=50"` // 返回数量，为空时默认 10
+}
+
+// ListPinnedPostsRequest 获取置顶文章列表请求
+type ListPinnedPostsRequest struct {
+	Scope      string `query:"scope" validate:"required,oneof=global category"`   // 置顶范围
+	CategoryID string `query:"category_id" validate:"required_if=Scope category"` // 分类 ID，分类置顶时必填
+}
+
+// ReorderPinsRequest 调整置顶顺序请求
+type ReorderPinsRequest struct {
+	Scope      string   `json:"scope" validate:"required,oneof=global category"`          // 置顶范围
+	CategoryID string   `json:"category_id" validate:"required_if=Scope category"`        // 分类 ID，分类置顶时必填
+	PostIDs    []string `json:"post_ids" validate:"required,min=1,max=100,dive,required"` // 该范围内全部未到期置顶文章的 ID，按期望顺序排列（靠前者优先）
+}
+
+// ListPostRevisionsRequest 获取文章修订列表请求
+type ListPostRevisionsRequest struct {
+	PostID   string `query:"post_id" validate:"required"`                 // 文章 ID
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+}
+
+// DiffPostRevisionsRequest 对比文章修订请求
+type DiffPostRevisionsRequest struct {
+	FromID string `query:"from_id" validate:"required"` // 旧修订 ID
+	ToID   string `query:"to_id" validate:"required"`   // 新修订 ID
+}
+
+// RestorePostRevisionRequest 恢复文章修订请求
+type RestorePostRevisionRequest struct {
+	ID string `json:"id" validate:"required"` // 修订 ID
+}
+
+// ImportPostsRequest 导入文章请求（multipart/form-data）
+type ImportPostsRequest struct {
+	File *multipart.FileHeader `form:"file" validate:"required"` // 导入文件：Markdown 文件、Markdown 文件的 zip 压缩包或 WordPress 导出的 WXR（.xml）文件
 }
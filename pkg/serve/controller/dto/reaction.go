@@ -0,0 +1,24 @@
+// Package dto 提供文章表态相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package dto
+
+// ToggleReactionRequest 切换文章表态请求
+type ToggleReactionRequest struct {
+	PostID      string `json:"post_id" validate:"required"`              // 文章 ID
+	Type        string `json:"type" validate:"required,max=32"`          // 表态类型
+	Fingerprint string `json:"fingerprint" validate:"omitempty,max=128"` // 匿名访客客户端指纹，用于去重，为空时按 IP 与 User-Agent 去重；登录用户忽略
+}
+
+// GetReactionsRequest 获取文章表态请求
+type GetReactionsRequest struct {
+	PostID      string `query:"post_id" validate:"required"`              // 文章 ID
+	Fingerprint string `query:"fingerprint" validate:"omitempty,max=128"` // 匿名访客客户端指纹，与表态时一致
+}
+
+// ReactionReportRequest 表态最多文章报表请求
+type ReactionReportRequest struct {
+	Days  int64  `query:"days" validate:"omitempty,min=1,max=365"`  // 统计最近天数（含当日），为空时统计全部时间
+	Type  string `query:"type" validate:"omitempty,max=32"`         // 表态类型，为空时统计全部类型
+	Limit int64  `query:"limit" validate:"omitempty,min=1,max=100"` // 返回数量，为空时默认 20
+}
@@ -0,0 +1,36 @@
+// Package dto 提供文章系列相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package dto
+
+// CreateSeriesRequest 创建系列请求
+type CreateSeriesRequest struct {
+	Name        string   `json:"name" validate:"required,min=1,max=100"`              // 系列名称
+	Description string   `json:"description" validate:"omitempty,max=500"`            // 系列描述
+	PostIDs     []string `json:"post_ids" validate:"omitempty,max=500,dive,required"` // 文章 ID 列表，按系列顺序排列，文章将从原系列中移除
+}
+
+// DeleteSeriesRequest 删除系列请求
+type DeleteSeriesRequest struct {
+	ID string `json:"id" validate:"required"` // 系列 ID
+}
+
+// GetSeriesRequest 获取系列请求
+type GetSeriesRequest struct {
+	ID string `query:"id" validate:"required"` // 系列 ID
+}
+
+// UpdateSeriesRequest 更新系列请求
+type UpdateSeriesRequest struct {
+	ID          string   `json:"id" validate:"required"`                              // 系列 ID
+	Name        string   `json:"name" validate:"omitempty,min=1,max=100"`             // 系列名称
+	Description string   `json:"description" validate:"omitempty,max=500"`            // 系列描述
+	PostIDs     []string `json:"post_ids" validate:"omitempty,max=500,dive,required"` // 文章 ID 列表，按系列顺序排列，为 null 时不修改，为空数组时清空系列
+}
+
+// ListSeriesRequest 获取系列列表请求
+type ListSeriesRequest struct {
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+	Keyword  string `query:"keyword" validate:"omitempty,max=100"`        // 名称关键字，为空时获取所有系列
+}
@@ -0,0 +1,9 @@
+// Package dto 提供站点地图相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package dto
+
+// GetSitemapRequest 获取站点地图请求
+type GetSitemapRequest struct {
+	Page int64 `query:"page" validate:"omitempty,min=1"` // 分页站点地图页码，为空时返回站点地图（链接数超过上限时返回站点地图索引）
+}
@@ -0,0 +1,10 @@
+// Package dto 提供静态站点相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package dto
+
+// BuildStaticSiteRequest 生成静态站点请求
+type BuildStaticSiteRequest struct {
+	OutputDir string `json:"output_dir" validate:"required"` // 输出目录
+	Clean     bool   `json:"clean"`                          // 生成前是否清空输出目录
+}
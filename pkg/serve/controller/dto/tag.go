@@ -0,0 +1,39 @@
+// Package dto 提供标签相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package dto
+
+// CreateTagRequest 创建标签请求
+type CreateTagRequest struct {
+	Name        string `json:"name" validate:"required,min=1,max=64"`    // 标签名称
+	Description string `json:"description" validate:"omitempty,max=500"` // 标签描述
+}
+
+// DeleteTagRequest 删除标签请求
+type DeleteTagRequest struct {
+	ID string `json:"id" validate:"required"` // 标签 ID
+}
+
+// GetTagRequest 获取标签请求
+type GetTagRequest struct {
+	ID string `query:"id" validate:"required"` // 标签 ID
+}
+
+// UpdateTagRequest 更新标签请求
+type UpdateTagRequest struct {
+	ID          string `json:"id" validate:"required"`                   // 标签 ID
+	Name        string `json:"name" validate:"omitempty,min=1,max=64"`   // 标签名称
+	Description string `json:"description" validate:"omitempty,max=500"` // 标签描述
+}
+
+// ListTagsRequest 获取标签列表请求
+type ListTagsRequest struct {
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+	Keyword  string `query:"keyword" validate:"omitempty,max=64"`         // 名称关键字，为空时获取所有标签
+}
+
+// GetTagCloudRequest 获取标签云请求
+type GetTagCloudRequest struct {
+	Limit int64 `query:"limit" validate:"omitempty,min=1,max=500"` // 返回数量上限，为空时默认 100
+}
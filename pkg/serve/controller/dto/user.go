@@ -25,7 +25,7 @@ type RefreshTokenRequest struct {
 // UpdateRequest 更新用户信息请求
 type UpdateRequest struct {
 	Nickname string `json:"nickname" validate:"omitempty,min=2,max=20"` // 昵称
-	Avatar   string `json:"avatar" validate:"omitempty,url"`            // 头像
+	Avatar   string `json:"avatar" validate:"omitempty,media_url"`      // 头像
 }
 
 // ResetPasswordRequest 重置密码请求
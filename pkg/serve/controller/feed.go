@@ -0,0 +1,124 @@
+// Package controller 订阅源控制器
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/Done-0/jank/configs"
+	constants "github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/errorx"
+	"github.com/Done-0/jank/internal/utils/validator"
+	"github.com/Done-0/jank/internal/utils/vo"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+)
+
+// FeedController 订阅源控制器
+type FeedController struct {
+	feedService service.FeedService
+}
+
+// NewFeedController 创建订阅源控制器
+func NewFeedController(feedService service.FeedService) *FeedController {
+	return &FeedController{
+		feedService: feedService,
+	}
+}
+
+// RSS 获取 RSS 2.0 订阅源
+// @Router /feed.xml [get]
+func (fc *FeedController) RSS(ctx context.Context, c *app.RequestContext) {
+	fc.serveFeed(c, constants.FeedFormatRSS)
+}
+
+// Atom 获取 Atom 1.0 订阅源
+// @Router /atom.xml [get]
+func (fc *FeedController) Atom(ctx context.Context, c *app.RequestContext) {
+	fc.serveFeed(c, constants.FeedFormatAtom)
+}
+
+// JSON 获取 JSON Feed 1.1 订阅源
+// @Router /feed.json [get]
+func (fc *FeedController) JSON(ctx context.Context, c *app.RequestContext) {
+	fc.serveFeed(c, constants.FeedFormatJSON)
+}
+
+// serveFeed 生成并输出指定格式的订阅源
+func (fc *FeedController) serveFeed(c *app.RequestContext, format string) {
+	req := new(dto.GetFeedRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := fc.feedService.GetFeed(c, req, format)
+	if err != nil {
+		if isRecordNotFound(err) {
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "feed"), errorx.KV("id", string(c.URI().QueryString())))))
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrFeedGenerateFailed, errorx.KV("format", format))))
+		return
+	}
+
+	maxAge := 0
+	if cfgs, err := configs.GetConfig(); err == nil {
+		maxAge = cfgs.FeedConfig.MaxAge
+	}
+	writeCacheable(c, response.Content, response.ContentType, response.ETag, response.LastModified, maxAge)
+}
+
+// writeCacheable 输出支持条件请求（ETag / Last-Modified）的响应，客户端缓存仍有效时返回 304
+func writeCacheable(c *app.RequestContext, content []byte, contentType, etag string, lastModified time.Time, maxAge int) {
+	c.Header("ETag", etag)
+	if !lastModified.IsZero() {
+		c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+	if maxAge > 0 {
+		c.Header("Cache-Control", "public, max-age="+strconv.Itoa(maxAge))
+	}
+
+	if notModified(c, etag, lastModified) {
+		c.Status(consts.StatusNotModified)
+		return
+	}
+
+	c.Data(consts.StatusOK, contentType, content)
+}
+
+// notModified 判断客户端缓存是否仍有效，If-None-Match 优先于 If-Modified-Since
+func notModified(c *app.RequestContext, etag string, lastModified time.Time) bool {
+	if inm := string(c.GetHeader("If-None-Match")); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := string(c.GetHeader("If-Modified-Since")); ims != "" && !lastModified.IsZero() {
+		if t, err := http.ParseTime(ims); err == nil && !lastModified.Truncate(time.Second).After(t) {
+			return true
+		}
+	}
+
+	return false
+}
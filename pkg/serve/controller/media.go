@@ -0,0 +1,162 @@
+// Package controller 媒体文件控制器
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package controller
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/errorx"
+	"github.com/Done-0/jank/internal/utils/validator"
+	"github.com/Done-0/jank/internal/utils/vo"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+)
+
+// MediaController 媒体文件控制器
+type MediaController struct {
+	mediaService service.MediaService
+}
+
+// NewMediaController 创建媒体文件控制器
+func NewMediaController(mediaService service.MediaService) *MediaController {
+	return &MediaController{
+		mediaService: mediaService,
+	}
+}
+
+// Upload 上传媒体文件
+// @Router /api/v1/media/upload [post]
+func (mc *MediaController) Upload(ctx context.Context, c *app.RequestContext) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "missing upload file"))))
+		return
+	}
+	req := &dto.UploadMediaRequest{File: file}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := mc.mediaService.Upload(c, req)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "file too large"):
+			c.JSON(consts.StatusRequestEntityTooLarge, vo.Fail(c, err, errorx.New(errno.ErrMediaTooLarge, errorx.KV("msg", err.Error()))))
+		case strings.Contains(err.Error(), "media type not allowed"):
+			c.JSON(consts.StatusUnsupportedMediaType, vo.Fail(c, err, errorx.New(errno.ErrMediaTypeNotAllowed, errorx.KV("type", strings.TrimPrefix(err.Error(), "media type not allowed: ")))))
+		case isPermissionDenied(err):
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "media"))))
+		default:
+			c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrMediaUploadFailed, errorx.KV("msg", file.Filename))))
+		}
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// List 获取媒体文件列表
+// @Router /api/v1/media/list [get]
+func (mc *MediaController) List(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListMediaRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := mc.mediaService.List(c, req)
+	if err != nil {
+		if isPermissionDenied(err) {
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "media"))))
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrMediaListFailed, errorx.KV("msg", "list media failed"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Delete 删除媒体文件
+// @Router /api/v1/media/delete [post]
+func (mc *MediaController) Delete(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.DeleteMediaRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := mc.mediaService.Delete(c, req)
+	if err != nil {
+		switch {
+		case isRecordNotFound(err):
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "media"), errorx.KV("id", req.ID))))
+		case isPermissionDenied(err):
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "media"))))
+		default:
+			c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrMediaDeleteFailed, errorx.KV("id", req.ID))))
+		}
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ServeLocalFile 访问本地存储的媒体文件
+// @Router /uploads/*filepath [get]
+func (mc *MediaController) ServeLocalFile(ctx context.Context, c *app.RequestContext) {
+	key := c.Param("filepath")
+	path, err := mc.mediaService.ServeLocalFile(c, key)
+	if err != nil {
+		c.NotFound()
+		return
+	}
+	if info, err := os.Stat(path); err != nil || info.IsDir() {
+		c.NotFound()
+		return
+	}
+
+	// 文件键由内容哈希生成，内容不会变化，可长期缓存
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	c.File(path)
+}
+
+// ServeVariant 访问图片变体，尚未生成时按需生成后重定向到存储地址
+// @Router /media/variants/*filepath [get]
+func (mc *MediaController) ServeVariant(ctx context.Context, c *app.RequestContext) {
+	url, err := mc.mediaService.ServeVariant(c, c.Param("filepath"))
+	if err != nil {
+		if isRecordNotFound(err) {
+			c.NotFound()
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrMediaVariantFailed, errorx.KV("key", c.Param("filepath")))))
+		return
+	}
+
+	// 变体生成后内容不再变化，重定向结果可缓存
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Redirect(consts.StatusFound, []byte(url))
+}
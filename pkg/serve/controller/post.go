@@ -5,9 +5,14 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"gorm.io/gorm"
 
 	"github.com/Done-0/jank/internal/types/errno"
 	"github.com/Done-0/jank/internal/utils/errorx"
@@ -46,10 +51,53 @@ func (pc *PostController) GetPost(ctx context.Context, c *app.RequestContext) {
 
 	response, err := pc.postService.GetPost(c, req)
 	if err != nil {
+		if isRecordNotFound(err) {
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "post"), errorx.KV("id", req.ID))))
+			return
+		}
 		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostGetFailed, errorx.KV("id", req.ID))))
 		return
 	}
 
+	// 浏览量统计失败不影响文章获取，错误已由服务层记录
+	_ = pc.postService.RecordView(c, response)
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// GetPostBySlug 根据别名获取文章，历史别名将 301 重定向至最新别名
+// @Router /api/v1/post/get-by-slug [get]
+func (pc *PostController) GetPostBySlug(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.GetPostBySlugRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.GetPostBySlug(c, req)
+	if err != nil {
+		if isRecordNotFound(err) {
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "post"), errorx.KV("id", req.Slug))))
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostGetBySlugFailed, errorx.KV("slug", req.Slug))))
+		return
+	}
+
+	if response.Slug != req.Slug {
+		c.Redirect(consts.StatusMovedPermanently, []byte(string(c.URI().Path())+"?slug="+url.QueryEscape(response.Slug)))
+		return
+	}
+
+	// 浏览量统计失败不影响文章获取，错误已由服务层记录
+	_ = pc.postService.RecordView(c, response)
+
 	c.JSON(consts.StatusOK, vo.Success(c, response))
 }
 
@@ -77,6 +125,54 @@ func (pc *PostController) ListPublishedPosts(ctx context.Context, c *app.Request
 	c.JSON(consts.StatusOK, vo.Success(c, response))
 }
 
+// ListPostsByAuthor 获取指定作者的已发布文章列表
+// @Router /api/v1/post/list-by-author [get]
+func (pc *PostController) ListPostsByAuthor(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListPostsByAuthorRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ListPostsByAuthor(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostListByAuthorFailed, errorx.KV("author_id", strconv.FormatInt(req.AuthorID, 10)))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Search 全文检索已发布文章
+// @Router /api/v1/post/search [get]
+func (pc *PostController) Search(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.SearchPostsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.Search(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostSearchFailed, errorx.KV("keyword", req.Keyword))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
 // ListPostsByStatus 根据状态获取文章列表
 // @Router /api/v1/post/list-by-status [get]
 func (pc *PostController) ListPostsByStatus(ctx context.Context, c *app.RequestContext) {
@@ -172,3 +268,326 @@ func (pc *PostController) Delete(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, vo.Success(c, response))
 }
+
+// ListRevisions 获取文章修订列表
+// @Router /api/v1/post/revision/list [get]
+func (pc *PostController) ListRevisions(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListPostRevisionsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ListRevisions(c, req)
+	if err != nil {
+		if isRecordNotFound(err) {
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "post"), errorx.KV("id", req.PostID))))
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostRevisionListFailed, errorx.KV("post_id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// DiffRevisions 对比文章修订
+// @Router /api/v1/post/revision/diff [get]
+func (pc *PostController) DiffRevisions(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.DiffPostRevisionsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.DiffRevisions(c, req)
+	if err != nil {
+		if isRecordNotFound(err) {
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "post revision"), errorx.KV("id", req.FromID))))
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostRevisionDiffFailed, errorx.KV("from_id", req.FromID), errorx.KV("to_id", req.ToID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// RestoreRevision 恢复文章修订
+// @Router /api/v1/post/revision/restore [post]
+func (pc *PostController) RestoreRevision(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.RestorePostRevisionRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.RestoreRevision(c, req)
+	if err != nil {
+		switch {
+		case isPermissionDenied(err):
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "post revision"))))
+		case isRecordNotFound(err):
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "post revision"), errorx.KV("id", req.ID))))
+		default:
+			c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostRevisionRestoreFailed, errorx.KV("id", req.ID))))
+		}
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// RerenderPosts 按当前渲染与清洗配置重新渲染全部文章
+// @Router /api/v1/post/rerender [post]
+func (pc *PostController) RerenderPosts(ctx context.Context, c *app.RequestContext) {
+	response, err := pc.postService.RerenderPosts(c)
+	if err != nil {
+		if isPermissionDenied(err) {
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "post rerender"))))
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostRerenderFailed, errorx.KV("msg", "rerender posts failed"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Import 导入文章
+// @Router /api/v1/post/import [post]
+func (pc *PostController) Import(ctx context.Context, c *app.RequestContext) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "missing import file"))))
+		return
+	}
+	req := &dto.ImportPostsRequest{File: file}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.Import(c, req)
+	if err != nil {
+		switch {
+		case isPermissionDenied(err):
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "post import"))))
+		case strings.Contains(err.Error(), "invalid import file"):
+			c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrPostImportFailed, errorx.KV("msg", err.Error()))))
+		default:
+			c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostImportFailed, errorx.KV("msg", file.Filename))))
+		}
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListPopularWeek 获取近 7 天热门文章
+// @Router /api/v1/post/popular/week [get]
+func (pc *PostController) ListPopularWeek(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListPopularPostsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ListPopularWeek(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostPopularListFailed, errorx.KV("period", "week"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListPopularAllTime 获取总浏览量最多的文章
+// @Router /api/v1/post/popular/all-time [get]
+func (pc *PostController) ListPopularAllTime(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListPopularPostsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ListPopularAllTime(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostPopularListFailed, errorx.KV("period", "all-time"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Pin 设置或取消文章置顶
+// @Router /api/v1/post/pin [post]
+func (pc *PostController) Pin(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.PinPostRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.Pin(c, req)
+	if err != nil {
+		switch {
+		case isPermissionDenied(err):
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "post pin"))))
+		case isRecordNotFound(err):
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "post"), errorx.KV("id", req.ID))))
+		case isInvalidParams(err):
+			c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", err.Error()))))
+		default:
+			c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostPinFailed, errorx.KV("id", req.ID))))
+		}
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Feature 设置或取消文章推荐
+// @Router /api/v1/post/feature [post]
+func (pc *PostController) Feature(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.FeaturePostRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.Feature(c, req)
+	if err != nil {
+		switch {
+		case isPermissionDenied(err):
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "post feature"))))
+		case isRecordNotFound(err):
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "post"), errorx.KV("id", req.ID))))
+		case isInvalidParams(err):
+			c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", err.Error()))))
+		default:
+			c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostFeatureFailed, errorx.KV("id", req.ID))))
+		}
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListPinnedPosts 获取置顶文章列表
+// @Router /api/v1/post/list-pinned [get]
+func (pc *PostController) ListPinnedPosts(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListPinnedPostsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ListPinnedPosts(c, req)
+	if err != nil {
+		if isInvalidParams(err) {
+			c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", err.Error()))))
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostPinnedListFailed, errorx.KV("scope", req.Scope))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ReorderPins 调整置顶文章顺序
+// @Router /api/v1/post/pin/reorder [post]
+func (pc *PostController) ReorderPins(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ReorderPinsRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ReorderPins(c, req)
+	if err != nil {
+		switch {
+		case isPermissionDenied(err):
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "post pin"))))
+		case isInvalidParams(err):
+			c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", err.Error()))))
+		default:
+			c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostPinReorderFailed, errorx.KV("scope", req.Scope))))
+		}
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// isRecordNotFound 判断错误是否为记录不存在（含无权查看而按不存在处理的情况）
+func isRecordNotFound(err error) bool {
+	return errors.Is(err, gorm.ErrRecordNotFound)
+}
+
+// isPermissionDenied 判断错误是否为权限不足
+func isPermissionDenied(err error) bool {
+	return strings.Contains(err.Error(), "permission denied")
+}
+
+// isInvalidParams 判断错误是否为请求参数不合法
+func isInvalidParams(err error) bool {
+	return strings.HasPrefix(err.Error(), "invalid ")
+}
@@ -0,0 +1,140 @@
+// Package controller 文章表态控制器
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/errorx"
+	"github.com/Done-0/jank/internal/utils/validator"
+	"github.com/Done-0/jank/internal/utils/vo"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+)
+
+// ReactionController 文章表态控制器
+type ReactionController struct {
+	reactionService service.ReactionService
+}
+
+// NewReactionController 创建文章表态控制器
+func NewReactionController(reactionService service.ReactionService) *ReactionController {
+	return &ReactionController{
+		reactionService: reactionService,
+	}
+}
+
+// Toggle 切换文章表态
+// @Router /api/v1/reaction/toggle [post]
+func (rc *ReactionController) Toggle(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ToggleReactionRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := rc.reactionService.Toggle(c, req)
+	if err != nil {
+		switch {
+		case isRecordNotFound(err):
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "post"), errorx.KV("id", req.PostID))))
+		case isInvalidParams(err):
+			c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", err.Error()))))
+		case isRateLimited(err):
+			c.JSON(consts.StatusTooManyRequests, vo.Fail(c, err, errorx.New(errno.ErrReactionRateLimited, errorx.KV("msg", err.Error()))))
+		default:
+			c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrReactionToggleFailed, errorx.KV("post_id", req.PostID))))
+		}
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// GetReactions 获取文章表态
+// @Router /api/v1/reaction/get [get]
+func (rc *ReactionController) GetReactions(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.GetReactionsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := rc.reactionService.GetReactions(c, req)
+	if err != nil {
+		switch {
+		case isRecordNotFound(err):
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "post"), errorx.KV("id", req.PostID))))
+		case isInvalidParams(err):
+			c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", err.Error()))))
+		default:
+			c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrReactionGetFailed, errorx.KV("post_id", req.PostID))))
+		}
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListTypes 获取已配置的表态类型
+// @Router /api/v1/reaction/types [get]
+func (rc *ReactionController) ListTypes(ctx context.Context, c *app.RequestContext) {
+	response, err := rc.reactionService.ListTypes(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrReactionTypeListFailed, errorx.KV("msg", "list reaction types failed"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Report 获取表态最多的文章报表
+// @Router /api/v1/reaction/report [get]
+func (rc *ReactionController) Report(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ReactionReportRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := rc.reactionService.Report(c, req)
+	if err != nil {
+		if isPermissionDenied(err) {
+			c.JSON(consts.StatusForbidden, vo.Fail(c, err, errorx.New(errno.ErrForbidden, errorx.KV("resource", "reaction report"))))
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrReactionReportFailed, errorx.KV("msg", "get reaction report failed"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// isRateLimited 判断错误是否为表态过于频繁
+func isRateLimited(err error) bool {
+	return strings.HasPrefix(err.Error(), "too many reactions")
+}
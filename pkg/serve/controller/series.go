@@ -0,0 +1,150 @@
+// Package controller 文章系列控制器
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package controller
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/errorx"
+	"github.com/Done-0/jank/internal/utils/validator"
+	"github.com/Done-0/jank/internal/utils/vo"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+)
+
+// SeriesController 文章系列控制器
+type SeriesController struct {
+	seriesService service.SeriesService
+}
+
+// NewSeriesController 创建文章系列控制器
+func NewSeriesController(seriesService service.SeriesService) *SeriesController {
+	return &SeriesController{
+		seriesService: seriesService,
+	}
+}
+
+// GetSeries 获取单个系列
+// @Router /api/v1/series/get [get]
+func (sc *SeriesController) GetSeries(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.GetSeriesRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := sc.seriesService.GetSeries(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrSeriesGetFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListSeries 获取系列列表
+// @Router /api/v1/series/list [get]
+func (sc *SeriesController) ListSeries(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListSeriesRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := sc.seriesService.ListSeries(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrSeriesListFailed, errorx.KV("msg", "list series failed"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Create 创建系列
+// @Router /api/v1/series/create [post]
+func (sc *SeriesController) Create(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.CreateSeriesRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := sc.seriesService.Create(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrSeriesCreateFailed, errorx.KV("name", req.Name))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Update 更新系列
+// @Router /api/v1/series/update [post]
+func (sc *SeriesController) Update(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.UpdateSeriesRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := sc.seriesService.Update(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrSeriesUpdateFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Delete 删除系列
+// @Router /api/v1/series/delete [post]
+func (sc *SeriesController) Delete(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.DeleteSeriesRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := sc.seriesService.Delete(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrSeriesDeleteFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
@@ -0,0 +1,71 @@
+// Package controller 站点地图控制器
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/errorx"
+	"github.com/Done-0/jank/internal/utils/validator"
+	"github.com/Done-0/jank/internal/utils/vo"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+)
+
+// SitemapController 站点地图控制器
+type SitemapController struct {
+	sitemapService service.SitemapService
+}
+
+// NewSitemapController 创建站点地图控制器
+func NewSitemapController(sitemapService service.SitemapService) *SitemapController {
+	return &SitemapController{
+		sitemapService: sitemapService,
+	}
+}
+
+// Sitemap 获取站点地图
+// @Router /sitemap.xml [get]
+func (sc *SitemapController) Sitemap(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.GetSitemapRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := sc.sitemapService.GetSitemap(c, req)
+	if err != nil {
+		if isRecordNotFound(err) {
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "sitemap"), errorx.KV("id", strconv.FormatInt(req.Page, 10)))))
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrSitemapGenerateFailed, errorx.KV("page", strconv.FormatInt(req.Page, 10)))))
+		return
+	}
+
+	writeCacheable(c, response.Content, response.ContentType, response.ETag, response.LastModified, 0)
+}
+
+// Robots 获取 robots.txt
+// @Router /robots.txt [get]
+func (sc *SitemapController) Robots(ctx context.Context, c *app.RequestContext) {
+	response, err := sc.sitemapService.GetRobots(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrRobotsGenerateFailed, errorx.KV("msg", "generate robots.txt failed"))))
+		return
+	}
+
+	c.Data(consts.StatusOK, "text/plain; charset=utf-8", []byte(response.Content))
+}
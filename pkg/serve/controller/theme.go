@@ -16,6 +16,8 @@ import (
 	"github.com/Done-0/jank/internal/utils/vo"
 	"github.com/Done-0/jank/pkg/serve/controller/dto"
 	"github.com/Done-0/jank/pkg/serve/service"
+
+	themeVO "github.com/Done-0/jank/pkg/vo"
 )
 
 // ThemeController 主题控制器
@@ -105,6 +107,10 @@ func (tc *ThemeController) ListThemes(ctx context.Context, c *app.RequestContext
 // ServeHomePage 提供主题首页
 // @Router / [get]
 func (tc *ThemeController) ServeHomePage(ctx context.Context, c *app.RequestContext) {
+	if tc.renderPage(c, string(c.Path())) {
+		return
+	}
+
 	homePagePath, err := tc.themeService.ServeHomePage(c)
 	if err != nil {
 		c.AbortWithStatus(consts.StatusInternalServerError)
@@ -117,11 +123,54 @@ func (tc *ThemeController) ServeHomePage(ctx context.Context, c *app.RequestCont
 // ServeStaticResource 提供静态资源文件
 // @Router /* [get]
 func (tc *ThemeController) ServeStaticResource(ctx context.Context, c *app.RequestContext) {
+	if tc.renderPage(c, string(c.Path())) {
+		return
+	}
+
 	staticResourcePath, err := tc.themeService.ServeStaticResource(c, string(c.Path()))
 	if err != nil {
-		c.AbortWithStatus(consts.StatusNotFound)
+		tc.renderNotFound(c)
 		return
 	}
 
 	c.File(staticResourcePath)
 }
+
+// renderPage 前端主题声明服务端渲染模板时渲染页面，返回请求是否已处理
+func (tc *ThemeController) renderPage(c *app.RequestContext, requestPath string) bool {
+	page, err := tc.themeService.RenderPage(c, requestPath)
+	switch {
+	case err != nil && isRecordNotFound(err):
+		tc.renderNotFound(c)
+		return true
+	case err != nil:
+		c.AbortWithStatus(consts.StatusInternalServerError)
+		return true
+	case page == nil:
+		return false
+	}
+
+	writeThemePage(c, page)
+	return true
+}
+
+// renderNotFound 前端主题提供 404 模板时渲染 404 页面，否则仅返回状态码
+func (tc *ThemeController) renderNotFound(c *app.RequestContext) {
+	page, err := tc.themeService.RenderNotFound(c)
+	if err != nil || page == nil {
+		c.AbortWithStatus(consts.StatusNotFound)
+		return
+	}
+
+	writeThemePage(c, page)
+}
+
+// writeThemePage 输出服务端渲染的页面或重定向
+func writeThemePage(c *app.RequestContext, page *themeVO.RenderThemePageResponse) {
+	if page.RedirectURL != "" {
+		c.Redirect(page.StatusCode, []byte(page.RedirectURL))
+		return
+	}
+
+	c.Data(page.StatusCode, "text/html; charset=utf-8", page.Body)
+}
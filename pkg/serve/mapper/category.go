@@ -12,6 +12,7 @@ import (
 // CategoryMapper 分类数据访问接口
 type CategoryMapper interface {
 	GetCategoryByID(c *app.RequestContext, categoryID int64) (*category.Category, error)                                                   // 根据 ID 获取分类
+	GetCategoryByName(c *app.RequestContext, name string) (*category.Category, error)                                                      // 根据名称获取分类，同名时返回最早创建的分类
 	ListCategories(c *app.RequestContext, pageNo, pageSize int64, parentID *int64, isActive *bool) ([]*category.Category, int64, error) // 获取分类列表，支持按父分类和状态筛选
 	CreateCategory(c *app.RequestContext, category *category.Category) error                                                             // 创建分类
 	UpdateCategory(c *app.RequestContext, category *category.Category) error                                                             // 更新分类
@@ -0,0 +1,21 @@
+// Package mapper 提供评论相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/comment"
+)
+
+// CommentMapper 评论数据访问接口
+type CommentMapper interface {
+	GetCommentByID(c *app.RequestContext, commentID int64) (*comment.Comment, error)                                                     // 根据 ID 获取评论
+	ListRootComments(c *app.RequestContext, postID, pageNo, pageSize int64, status string) ([]*comment.Comment, int64, error)            // 获取文章的顶级评论列表
+	ListRepliesByRootIDs(c *app.RequestContext, rootIDs []int64, status string) ([]*comment.Comment, error)                              // 获取指定顶级评论下的所有回复
+	ListCommentsByStatus(c *app.RequestContext, pageNo, pageSize int64, status string, postID *int64) ([]*comment.Comment, int64, error) // 根据审核状态获取评论列表，status为空时获取所有评论，postID为空时不按文章筛选
+	CreateComment(c *app.RequestContext, comment *comment.Comment) error                                                                 // 创建评论
+	UpdateCommentStatus(c *app.RequestContext, commentIDs []int64, status string) (int64, error)                                         // 批量更新评论审核状态
+	DeleteComment(c *app.RequestContext, commentID int64) error                                                                          // 删除评论及其所有回复
+}
@@ -33,6 +33,16 @@ func (m *CategoryMapperImpl) GetCategoryByID(c *app.RequestContext, categoryID i
 	return &cat, nil
 }
 
+// GetCategoryByName 根据名称获取分类，同名时返回最早创建的分类
+func (m *CategoryMapperImpl) GetCategoryByName(c *app.RequestContext, name string) (*category.Category, error) {
+	var cat category.Category
+	err := db.GetDBFromContext(c).Where("name = ? AND deleted = ?", name, false).Order("gmt_created ASC").First(&cat).Error
+	if err != nil {
+		return nil, err
+	}
+	return &cat, nil
+}
+
 // ListCategories 获取分类列表，支持按父分类和状态筛选
 func (m *CategoryMapperImpl) ListCategories(c *app.RequestContext, pageNo, pageSize int64, parentID *int64, isActive *bool) ([]*category.Category, int64, error) {
 	var categories []*category.Category
@@ -0,0 +1,130 @@
+// Package impl 提供评论相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/comment"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// CommentMapperImpl 评论数据访问实现
+type CommentMapperImpl struct{}
+
+// NewCommentMapper 创建评论数据访问实例
+func NewCommentMapper() mapper.CommentMapper {
+	return &CommentMapperImpl{}
+}
+
+// GetCommentByID 根据ID获取评论
+func (m *CommentMapperImpl) GetCommentByID(c *app.RequestContext, commentID int64) (*comment.Comment, error) {
+	var cm comment.Comment
+	err := db.GetDBFromContext(c).Where("id = ? AND deleted = ?", commentID, false).First(&cm).Error
+	if err != nil {
+		return nil, err
+	}
+	return &cm, nil
+}
+
+// ListRootComments 获取文章的顶级评论列表
+func (m *CommentMapperImpl) ListRootComments(c *app.RequestContext, postID, pageNo, pageSize int64, status string) ([]*comment.Comment, int64, error) {
+	var comments []*comment.Comment
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&comment.Comment{}).Where("post_id = ? AND parent_id = ? AND status = ? AND deleted = ?", postID, 0, status, false)
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// ListRepliesByRootIDs 获取指定顶级评论下的所有回复
+func (m *CommentMapperImpl) ListRepliesByRootIDs(c *app.RequestContext, rootIDs []int64, status string) ([]*comment.Comment, error) {
+	var replies []*comment.Comment
+	if len(rootIDs) == 0 {
+		return replies, nil
+	}
+
+	err := db.GetDBFromContext(c).
+		Where("root_id IN ? AND status = ? AND deleted = ?", rootIDs, status, false).
+		Order("id ASC").
+		Find(&replies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return replies, nil
+}
+
+// ListCommentsByStatus 根据审核状态获取评论列表，status 为空时获取所有评论，postID 为空时不按文章筛选
+func (m *CommentMapperImpl) ListCommentsByStatus(c *app.RequestContext, pageNo, pageSize int64, status string, postID *int64) ([]*comment.Comment, int64, error) {
+	var comments []*comment.Comment
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&comment.Comment{}).Where("deleted = ?", false)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if postID != nil {
+		query = query.Where("post_id = ?", *postID)
+	}
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// CreateComment 创建评论
+func (m *CommentMapperImpl) CreateComment(c *app.RequestContext, cm *comment.Comment) error {
+	return db.GetDBFromContext(c).Create(cm).Error
+}
+
+// UpdateCommentStatus 批量更新评论审核状态
+func (m *CommentMapperImpl) UpdateCommentStatus(c *app.RequestContext, commentIDs []int64, status string) (int64, error) {
+	result := db.GetDBFromContext(c).Model(&comment.Comment{}).Where("id IN ? AND deleted = ?", commentIDs, false).Update("status", status)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// DeleteComment 删除评论（软删除，级联删除所有回复）
+func (m *CommentMapperImpl) DeleteComment(c *app.RequestContext, commentID int64) error {
+	dbConn := db.GetDBFromContext(c)
+
+	allCommentIDs := []int64{commentID}
+	currentLevelIDs := []int64{commentID}
+
+	for len(currentLevelIDs) > 0 {
+		var childIDs []int64
+		if err := dbConn.Model(&comment.Comment{}).Where("parent_id IN ? AND deleted = ?", currentLevelIDs, false).Pluck("id", &childIDs).Error; err != nil {
+			return err
+		}
+
+		allCommentIDs = append(allCommentIDs, childIDs...)
+		currentLevelIDs = childIDs
+	}
+
+	return dbConn.Model(&comment.Comment{}).Where("id IN ? AND deleted = ?", allCommentIDs, false).Update("deleted", true).Error
+}
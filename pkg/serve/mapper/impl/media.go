@@ -0,0 +1,111 @@
+// Package impl 提供媒体文件相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/media"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// MediaMapperImpl 媒体文件数据访问实现
+type MediaMapperImpl struct{}
+
+// NewMediaMapper 创建媒体文件数据访问实例
+func NewMediaMapper() mapper.MediaMapper {
+	return &MediaMapperImpl{}
+}
+
+// GetMediaByID 根据 ID 获取媒体文件
+func (m *MediaMapperImpl) GetMediaByID(c *app.RequestContext, mediaID int64) (*media.Media, error) {
+	var item media.Media
+	if err := db.GetDBFromContext(c).Where("id = ? AND deleted = ?", mediaID, false).First(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// GetMediaByOwnerAndHash 根据上传者与内容哈希获取媒体文件
+func (m *MediaMapperImpl) GetMediaByOwnerAndHash(c *app.RequestContext, ownerID int64, hash string) (*media.Media, error) {
+	var item media.Media
+	if err := db.GetDBFromContext(c).Where("owner_id = ? AND hash = ? AND deleted = ?", ownerID, hash, false).First(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// GetMediaByHash 根据内容哈希获取任一用户上传的媒体文件
+func (m *MediaMapperImpl) GetMediaByHash(c *app.RequestContext, hash string) (*media.Media, error) {
+	var item media.Media
+	if err := db.GetDBFromContext(c).Where("hash = ? AND deleted = ?", hash, false).Order("id ASC").First(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// ListMediaByURLs 根据访问地址批量获取媒体文件
+func (m *MediaMapperImpl) ListMediaByURLs(c *app.RequestContext, urls []string) ([]*media.Media, error) {
+	var items []*media.Media
+	if len(urls) == 0 {
+		return items, nil
+	}
+	if err := db.GetDBFromContext(c).Where("url IN ? AND deleted = ?", urls, false).Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ListMedia 获取媒体文件列表，ownerID 为空时获取所有用户的文件，mimePrefix 非空时按 MIME 类型前缀筛选
+func (m *MediaMapperImpl) ListMedia(c *app.RequestContext, ownerID *int64, mimePrefix string, pageNo, pageSize int64) ([]*media.Media, int64, error) {
+	var items []*media.Media
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&media.Media{}).Where("deleted = ?", false)
+	if ownerID != nil {
+		query = query.Where("owner_id = ?", *ownerID)
+	}
+	if mimePrefix != "" {
+		query = query.Where("mime_type LIKE ?", mimePrefix+"%")
+	}
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&items).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
+
+// CreateMedia 创建媒体文件记录
+func (m *MediaMapperImpl) CreateMedia(c *app.RequestContext, item *media.Media) error {
+	return db.GetDBFromContext(c).Create(item).Error
+}
+
+// DeleteMedia 删除媒体文件记录（软删除）
+func (m *MediaMapperImpl) DeleteMedia(c *app.RequestContext, mediaID int64) error {
+	return db.GetDBFromContext(c).Model(&media.Media{}).Where("id = ? AND deleted = ?", mediaID, false).Update("deleted", true).Error
+}
+
+// CountMediaByStorageKey 统计引用同一存储文件的媒体记录数量
+func (m *MediaMapperImpl) CountMediaByStorageKey(c *app.RequestContext, storage, storageKey string) (int64, error) {
+	var count int64
+	err := db.GetDBFromContext(c).Model(&media.Media{}).Where("storage = ? AND storage_key = ? AND deleted = ?", storage, storageKey, false).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// UpdateVariantsByStorageKey 更新引用同一存储文件的媒体记录的图片变体
+func (m *MediaMapperImpl) UpdateVariantsByStorageKey(c *app.RequestContext, storage, storageKey, variants string) error {
+	return db.GetDBFromContext(c).Model(&media.Media{}).Where("storage = ? AND storage_key = ? AND deleted = ?", storage, storageKey, false).Update("variants", variants).Error
+}
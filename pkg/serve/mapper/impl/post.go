@@ -4,9 +4,16 @@
 package impl
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/series"
+	"github.com/Done-0/jank/internal/model/tag"
 	"github.com/Done-0/jank/internal/types/consts"
 	"github.com/Done-0/jank/internal/utils/db"
 	"github.com/Done-0/jank/pkg/serve/mapper"
@@ -30,16 +37,94 @@ func (m *PostMapperImpl) GetPostByID(c *app.RequestContext, postID int64) (*post
 	return &p, nil
 }
 
-// ListPublishedPosts 获取已发布文章列表，categoryID 为空时不按分类筛选
-func (m *PostMapperImpl) ListPublishedPosts(c *app.RequestContext, pageNo, pageSize int64, categoryID *int64) ([]*post.Post, int64, error) {
+// GetPostBySlug 根据别名获取文章
+func (m *PostMapperImpl) GetPostBySlug(c *app.RequestContext, slug string) (*post.Post, error) {
+	var p post.Post
+	err := db.GetDBFromContext(c).Where("slug = ? AND deleted = ?", slug, false).First(&p).Error
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// GetSlugHistoryBySlug 根据历史别名获取别名记录，同一别名存在多条记录时返回最新一条
+func (m *PostMapperImpl) GetSlugHistoryBySlug(c *app.RequestContext, slug string) (*post.SlugHistory, error) {
+	var h post.SlugHistory
+	err := db.GetDBFromContext(c).Where("slug = ? AND deleted = ?", slug, false).Order("id DESC").First(&h).Error
+	if err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// IsSlugTaken 检查别名是否已被其他文章（含历史别名）占用，已删除的文章仍占用别名唯一索引
+func (m *PostMapperImpl) IsSlugTaken(c *app.RequestContext, slug string, excludePostID int64) (bool, error) {
+	var count int64
+	if err := db.GetDBFromContext(c).Model(&post.Post{}).Where("slug = ? AND id <> ?", slug, excludePostID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return true, nil
+	}
+
+	if err := db.GetDBFromContext(c).Model(&post.SlugHistory{}).Where("slug = ? AND post_id <> ? AND deleted = ?", slug, excludePostID, false).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CreateSlugHistory 创建历史别名记录
+func (m *PostMapperImpl) CreateSlugHistory(c *app.RequestContext, h *post.SlugHistory) error {
+	if err := db.GetDBFromContext(c).Create(h).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListPublishedPosts 获取已发布文章列表，未指定排序方式且未按标签、系列或推荐筛选时置顶文章优先：
+// 未按分类筛选时全局置顶文章优先，按分类筛选时该分类下的全局置顶与分类置顶文章优先
+func (m *PostMapperImpl) ListPublishedPosts(c *app.RequestContext, pageNo, pageSize int64, filter *mapper.PostFilter) ([]*post.Post, int64, error) {
 	var posts []*post.Post
 	var total int64
 
 	query := db.GetDBFromContext(c).Model(&post.Post{}).Where("deleted = ? AND status = ?", false, consts.PostStatusPublished)
-	if categoryID != nil {
-		query = query.Where("category_id = ?", *categoryID)
+	query = applyPostFilter(c, query, filter)
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := clause.OrderBy{Expression: clause.Expr{SQL: postOrder(filter)}}
+	if filter.Sort == "" && filter.TagID == nil && filter.SeriesID == nil && filter.Featured == nil {
+		scopes := []string{consts.PostPinScopeGlobal}
+		if filter.CategoryID != nil {
+			scopes = append(scopes, consts.PostPinScopeCategory)
+		}
+		active := "pin_scope IN ? AND (pin_expire_at IS NULL OR pin_expire_at > ?)"
+		now := time.Now().Unix()
+		order.Expression = clause.Expr{
+			SQL:  "CASE WHEN " + active + " THEN 1 ELSE 0 END DESC, CASE WHEN " + active + " THEN pin_order ELSE 0 END DESC, " + postOrder(filter),
+			Vars: []any{scopes, now, scopes, now},
+		}
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order(order).Offset(int(offset)).Limit(int(pageSize)).Find(&posts).Error; err != nil {
+		return nil, 0, err
 	}
 
+	return posts, total, nil
+}
+
+// ListPublishedPostsByAuthor 获取指定作者的已发布文章列表
+func (m *PostMapperImpl) ListPublishedPostsByAuthor(c *app.RequestContext, authorID, pageNo, pageSize int64, sort string) ([]*post.Post, int64, error) {
+	var posts []*post.Post
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&post.Post{}).Where("deleted = ? AND status = ? AND author_id = ?", false, consts.PostStatusPublished, authorID)
+
 	// 统计总数
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
@@ -47,15 +132,15 @@ func (m *PostMapperImpl) ListPublishedPosts(c *app.RequestContext, pageNo, pageS
 
 	// 分页查询
 	offset := (pageNo - 1) * pageSize
-	if err := query.Order("id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&posts).Error; err != nil {
+	if err := query.Order(postOrder(&mapper.PostFilter{Sort: sort})).Offset(int(offset)).Limit(int(pageSize)).Find(&posts).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return posts, total, nil
 }
 
-// ListPostsByStatus 根据状态获取文章列表，status 为空时获取所有文章，categoryID 为空时不按分类筛选
-func (m *PostMapperImpl) ListPostsByStatus(c *app.RequestContext, pageNo, pageSize int64, status string, categoryID *int64) ([]*post.Post, int64, error) {
+// ListPostsByStatus 根据状态获取文章列表，status 为空时获取所有文章
+func (m *PostMapperImpl) ListPostsByStatus(c *app.RequestContext, pageNo, pageSize int64, status string, filter *mapper.PostFilter) ([]*post.Post, int64, error) {
 	var posts []*post.Post
 	var total int64
 
@@ -63,9 +148,7 @@ func (m *PostMapperImpl) ListPostsByStatus(c *app.RequestContext, pageNo, pageSi
 	if status != "" {
 		query = query.Where("status = ?", status)
 	}
-	if categoryID != nil {
-		query = query.Where("category_id = ?", *categoryID)
-	}
+	query = applyPostFilter(c, query, filter)
 
 	// 统计总数
 	if err := query.Count(&total).Error; err != nil {
@@ -74,13 +157,51 @@ func (m *PostMapperImpl) ListPostsByStatus(c *app.RequestContext, pageNo, pageSi
 
 	// 分页查询
 	offset := (pageNo - 1) * pageSize
-	if err := query.Order("id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&posts).Error; err != nil {
+	if err := query.Order(postOrder(filter)).Offset(int(offset)).Limit(int(pageSize)).Find(&posts).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return posts, total, nil
 }
 
+// applyPostFilter 按分类、标签、系列与推荐状态筛选文章
+func applyPostFilter(c *app.RequestContext, query *gorm.DB, filter *mapper.PostFilter) *gorm.DB {
+	if filter.CategoryID != nil {
+		query = query.Where("category_id = ?", *filter.CategoryID)
+	}
+	if filter.TagID != nil {
+		query = query.Where("id IN (?)", db.GetDBFromContext(c).Model(&tag.PostTag{}).Select("post_id").Where("tag_id = ?", *filter.TagID))
+	}
+	if filter.SeriesID != nil {
+		query = query.Where("id IN (?)", db.GetDBFromContext(c).Model(&series.SeriesPost{}).Select("post_id").Where("series_id = ?", *filter.SeriesID))
+	}
+	if filter.Featured != nil {
+		query = query.Where("featured = ?", *filter.Featured)
+	}
+	return query
+}
+
+// publishedAtOrder 文章发布时间排序字段，未设置发布时间的文章以创建时间为准，同一时间按 ID 排序
+const publishedAtOrder = "COALESCE(publish_at, gmt_created)"
+
+// postOrder 获取文章列表排序方式，未指定排序时按系列筛选则按系列顺序升序，否则按发布时间降序（最新发布）
+func postOrder(filter *mapper.PostFilter) string {
+	switch filter.Sort {
+	case consts.PostSortOldest:
+		return publishedAtOrder + " ASC, id ASC"
+	case consts.PostSortMostViewed:
+		return "view_count DESC, id DESC"
+	case consts.PostSortLastUpdated:
+		return "gmt_modified DESC, id DESC"
+	case consts.PostSortNewest:
+		return publishedAtOrder + " DESC, id DESC"
+	}
+	if filter.SeriesID != nil {
+		return "(SELECT position FROM series_posts WHERE series_posts.post_id = posts.id) ASC, id ASC"
+	}
+	return publishedAtOrder + " DESC, id DESC"
+}
+
 // ListPublicPosts 获取公开文章（已发布+已归档）
 func (m *PostMapperImpl) ListPublicPosts(c *app.RequestContext, pageNo, pageSize int64) ([]*post.Post, int64, error) {
 	var posts []*post.Post
@@ -126,3 +247,302 @@ func (m *PostMapperImpl) DeletePost(c *app.RequestContext, postID int64) error {
 	}
 	return nil
 }
+
+// GetRevisionByID 根据 ID 获取文章修订
+func (m *PostMapperImpl) GetRevisionByID(c *app.RequestContext, revisionID int64) (*post.Revision, error) {
+	var r post.Revision
+	err := db.GetDBFromContext(c).Where("id = ? AND deleted = ?", revisionID, false).First(&r).Error
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ListRevisions 获取文章修订列表，按版本号降序
+func (m *PostMapperImpl) ListRevisions(c *app.RequestContext, postID, pageNo, pageSize int64) ([]*post.Revision, int64, error) {
+	var revisions []*post.Revision
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&post.Revision{}).Where("post_id = ? AND deleted = ?", postID, false)
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询，列表不返回完整内容
+	offset := (pageNo - 1) * pageSize
+	if err := query.Omit("markdown").Order("version DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&revisions).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return revisions, total, nil
+}
+
+// GetLatestRevisionVersion 获取文章最新修订版本号，无修订时返回 0
+func (m *PostMapperImpl) GetLatestRevisionVersion(c *app.RequestContext, postID int64) (int64, error) {
+	var version int64
+	err := db.GetDBFromContext(c).Model(&post.Revision{}).Where("post_id = ? AND deleted = ?", postID, false).Select("COALESCE(MAX(version), 0)").Scan(&version).Error
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// ListRevisionEditorIDs 获取文章所有修订的编辑者 ID（去重，不含编辑者未知的修订）
+func (m *PostMapperImpl) ListRevisionEditorIDs(c *app.RequestContext, postID int64) ([]int64, error) {
+	var editorIDs []int64
+	err := db.GetDBFromContext(c).Model(&post.Revision{}).
+		Where("post_id = ? AND editor_id IS NOT NULL AND deleted = ?", postID, false).
+		Distinct().Pluck("editor_id", &editorIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return editorIDs, nil
+}
+
+// CreateRevision 创建文章修订
+func (m *PostMapperImpl) CreateRevision(c *app.RequestContext, r *post.Revision) error {
+	if err := db.GetDBFromContext(c).Create(r).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// CountPublishedPostsForSitemap 统计允许收录的已发布文章数量
+func (m *PostMapperImpl) CountPublishedPostsForSitemap(c *app.RequestContext) (int64, error) {
+	var total int64
+	if err := db.GetDBFromContext(c).Model(&post.Post{}).Where("deleted = ? AND status = ? AND no_index = ?", false, consts.PostStatusPublished, false).Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// ListPublishedPostsForSitemap 按 ID 升序获取允许收录的已发布文章的链接信息（仅 ID、别名与修改时间）
+func (m *PostMapperImpl) ListPublishedPostsForSitemap(c *app.RequestContext, offset, limit int64) ([]*post.Post, error) {
+	var posts []*post.Post
+	if err := db.GetDBFromContext(c).Model(&post.Post{}).
+		Select("id", "slug", "gmt_modified").
+		Where("deleted = ? AND status = ? AND no_index = ?", false, consts.PostStatusPublished, false).
+		Order("id ASC").
+		Offset(int(offset)).
+		Limit(int(limit)).
+		Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// GetLatestModifiedTime 获取已发布文章的最近修改时间，无文章时返回 0
+func (m *PostMapperImpl) GetLatestModifiedTime(c *app.RequestContext) (int64, error) {
+	var latest int64
+	err := db.GetDBFromContext(c).Model(&post.Post{}).Where("deleted = ? AND status = ?", false, consts.PostStatusPublished).Select("COALESCE(MAX(gmt_modified), 0)").Scan(&latest).Error
+	if err != nil {
+		return 0, err
+	}
+	return latest, nil
+}
+
+// ListPostsAfterID 按 ID 升序获取指定 ID 之后的文章（含所有状态），用于批量处理
+func (m *PostMapperImpl) ListPostsAfterID(c *app.RequestContext, afterID, limit int64) ([]*post.Post, error) {
+	var posts []*post.Post
+	if err := db.GetDBFromContext(c).Where("id > ? AND deleted = ?", afterID, false).Order("id ASC").Limit(int(limit)).Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// UpdatePostRendered 仅更新文章渲染结果（HTML、目录、字数与阅读时长），不修改更新时间
+func (m *PostMapperImpl) UpdatePostRendered(c *app.RequestContext, p *post.Post) error {
+	if err := db.GetDBFromContext(c).Model(&post.Post{}).Where("id = ? AND deleted = ?", p.ID, false).UpdateColumns(map[string]any{
+		"html":         p.HTML,
+		"toc":          p.TOC,
+		"word_count":   p.WordCount,
+		"reading_time": p.ReadingTime,
+	}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdatePostSEO 更新文章 SEO 字段（含空值与 false）
+func (m *PostMapperImpl) UpdatePostSEO(c *app.RequestContext, p *post.Post) error {
+	if err := db.GetDBFromContext(c).Model(&post.Post{}).Where("id = ? AND deleted = ?", p.ID, false).UpdateColumns(map[string]any{
+		"meta_title":       p.MetaTitle,
+		"meta_description": p.MetaDescription,
+		"canonical_url":    p.CanonicalURL,
+		"no_index":         p.NoIndex,
+		"og_image":         p.OGImage,
+	}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdatePostTimestamps 设置文章创建与修改时间，用于导入时保留原始时间
+func (m *PostMapperImpl) UpdatePostTimestamps(c *app.RequestContext, postID, created, modified int64) error {
+	return db.GetDBFromContext(c).Model(&post.Post{}).Where("id = ? AND deleted = ?", postID, false).UpdateColumns(map[string]any{
+		"gmt_created":  created,
+		"gmt_modified": modified,
+	}).Error
+}
+
+// ListPostsByIDs 根据 ID 列表批量获取文章
+func (m *PostMapperImpl) ListPostsByIDs(c *app.RequestContext, postIDs []int64) ([]*post.Post, error) {
+	var posts []*post.Post
+	if len(postIDs) == 0 {
+		return posts, nil
+	}
+	if err := db.GetDBFromContext(c).Where("id IN ? AND deleted = ?", postIDs, false).Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// ListPinnedPosts 获取指定范围内未到期的置顶文章，status 为空时获取所有状态，按置顶权重降序
+func (m *PostMapperImpl) ListPinnedPosts(c *app.RequestContext, scope string, categoryID *int64, status string) ([]*post.Post, error) {
+	var posts []*post.Post
+	query := pinScopeQuery(c, scope, categoryID).Where("pin_expire_at IS NULL OR pin_expire_at > ?", time.Now().Unix())
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Order("pin_order DESC, id DESC").Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// GetMaxPinOrder 获取指定范围内置顶文章的最大置顶权重，无置顶文章时返回 0
+func (m *PostMapperImpl) GetMaxPinOrder(c *app.RequestContext, scope string, categoryID *int64) (int64, error) {
+	var maxOrder *int64
+	if err := pinScopeQuery(c, scope, categoryID).Select("MAX(pin_order)").Scan(&maxOrder).Error; err != nil {
+		return 0, err
+	}
+	if maxOrder == nil {
+		return 0, nil
+	}
+	return *maxOrder, nil
+}
+
+// pinScopeQuery 构造指定置顶范围内文章的查询，分类置顶按分类 ID 限定
+func pinScopeQuery(c *app.RequestContext, scope string, categoryID *int64) *gorm.DB {
+	query := db.GetDBFromContext(c).Model(&post.Post{}).Where("deleted = ? AND pin_scope = ?", false, scope)
+	if scope == consts.PostPinScopeCategory && categoryID != nil {
+		query = query.Where("category_id = ?", *categoryID)
+	}
+	return query
+}
+
+// UpdatePostPin 更新文章置顶范围、权重与到期时间（含空值），不修改更新时间
+func (m *PostMapperImpl) UpdatePostPin(c *app.RequestContext, p *post.Post) error {
+	return db.GetDBFromContext(c).Model(&post.Post{}).Where("id = ? AND deleted = ?", p.ID, false).UpdateColumns(map[string]any{
+		"pin_scope":     p.PinScope,
+		"pin_order":     p.PinOrder,
+		"pin_expire_at": p.PinExpireAt,
+	}).Error
+}
+
+// UpdatePinOrders 批量设置文章置顶权重
+func (m *PostMapperImpl) UpdatePinOrders(c *app.RequestContext, orders map[int64]int64) error {
+	return db.GetDBFromContext(c).Transaction(func(tx *gorm.DB) error {
+		for postID, order := range orders {
+			if err := tx.Model(&post.Post{}).Where("id = ? AND deleted = ?", postID, false).UpdateColumn("pin_order", order).Error; err != nil {
+				return fmt.Errorf("failed to update pin order of post %d: %w", postID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// UpdatePostFeatured 设置文章是否推荐，不修改更新时间
+func (m *PostMapperImpl) UpdatePostFeatured(c *app.RequestContext, postID int64, featured bool) error {
+	return db.GetDBFromContext(c).Model(&post.Post{}).Where("id = ? AND deleted = ?", postID, false).UpdateColumn("featured", featured).Error
+}
+
+// ListPopularPostViews 统计指定日期（含）以来已发布文章的浏览量，按浏览量降序
+func (m *PostMapperImpl) ListPopularPostViews(c *app.RequestContext, since, limit int64) ([]*mapper.PostViewStat, error) {
+	var stats []*mapper.PostViewStat
+	err := db.GetDBFromContext(c).Model(&post.DailyView{}).
+		Select("post_daily_views.post_id AS post_id, SUM(post_daily_views.views) AS views").
+		Joins("JOIN posts ON posts.id = post_daily_views.post_id").
+		Where("post_daily_views.day >= ? AND posts.deleted = ? AND posts.status = ?", since, false, consts.PostStatusPublished).
+		Group("post_daily_views.post_id").
+		Order("views DESC, post_daily_views.post_id DESC").
+		Limit(int(limit)).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// ReplaceSearchTerms 重建文章检索词项（覆盖原有词项）
+func (m *PostMapperImpl) ReplaceSearchTerms(c *app.RequestContext, postID int64, weights map[string]int) error {
+	return db.GetDBFromContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("post_id = ?", postID).Delete(&post.SearchTerm{}).Error; err != nil {
+			return fmt.Errorf("failed to clear search terms: %w", err)
+		}
+
+		if len(weights) == 0 {
+			return nil
+		}
+
+		terms := make([]*post.SearchTerm, 0, len(weights))
+		for term, weight := range weights {
+			terms = append(terms, &post.SearchTerm{PostID: postID, Term: term, Weight: int64(weight)})
+		}
+		if err := tx.CreateInBatches(terms, 500).Error; err != nil {
+			return fmt.Errorf("failed to create search terms: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// DeleteSearchTerms 删除文章检索词项
+func (m *PostMapperImpl) DeleteSearchTerms(c *app.RequestContext, postID int64) error {
+	if err := db.GetDBFromContext(c).Where("post_id = ?", postID).Delete(&post.SearchTerm{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// SearchPublishedPosts 检索包含全部词项的已发布文章，按相关度降序、ID 降序排列
+func (m *PostMapperImpl) SearchPublishedPosts(c *app.RequestContext, terms []string, pageNo, pageSize int64) ([]*mapper.PostSearchHit, int64, error) {
+	var hits []*mapper.PostSearchHit
+	var total int64
+	if len(terms) == 0 {
+		return hits, 0, nil
+	}
+
+	// 按文章聚合词项得分，仅保留命中全部词项的文章
+	newQuery := func() *gorm.DB {
+		tx := db.GetDBFromContext(c)
+		scores := tx.Model(&post.SearchTerm{}).
+			Select("post_id, SUM(weight) AS score").
+			Where("term IN ? AND deleted = ?", terms, false).
+			Group("post_id").
+			Having("COUNT(DISTINCT term) = ?", len(terms))
+		return tx.Table("(?) AS hits", scores).
+			Joins("JOIN posts ON posts.id = hits.post_id").
+			Where("posts.deleted = ? AND posts.status = ?", false, consts.PostStatusPublished)
+	}
+
+	// 统计总数
+	if err := newQuery().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := newQuery().
+		Select("hits.post_id, hits.score").
+		Order("hits.score DESC, hits.post_id DESC").
+		Offset(int(offset)).
+		Limit(int(pageSize)).
+		Scan(&hits).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return hits, total, nil
+}
@@ -0,0 +1,108 @@
+// Package impl 提供文章表态相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm/clause"
+
+	"github.com/Done-0/jank/internal/model/reaction"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// ReactionMapperImpl 文章表态数据访问实现
+type ReactionMapperImpl struct{}
+
+// NewReactionMapper 创建文章表态数据访问实例
+func NewReactionMapper() mapper.ReactionMapper {
+	return &ReactionMapperImpl{}
+}
+
+// GetReaction 获取表态者对文章的指定类型表态
+func (m *ReactionMapperImpl) GetReaction(c *app.RequestContext, postID int64, reactionType, reactor string) (*reaction.Reaction, error) {
+	var r reaction.Reaction
+	err := db.GetDBFromContext(c).Where("post_id = ? AND type = ? AND reactor = ?", postID, reactionType, reactor).First(&r).Error
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// CreateReaction 创建表态，同一表态者并发重复表态时忽略
+func (m *ReactionMapperImpl) CreateReaction(c *app.RequestContext, r *reaction.Reaction) error {
+	return db.GetDBFromContext(c).Clauses(clause.OnConflict{DoNothing: true}).Create(r).Error
+}
+
+// DeleteReaction 删除表态
+func (m *ReactionMapperImpl) DeleteReaction(c *app.RequestContext, reactionID int64) error {
+	return db.GetDBFromContext(c).Where("id = ?", reactionID).Delete(&reaction.Reaction{}).Error
+}
+
+// ListReactorTypes 获取表态者对文章已表态的类型
+func (m *ReactionMapperImpl) ListReactorTypes(c *app.RequestContext, postID int64, reactor string) ([]string, error) {
+	var types []string
+	err := db.GetDBFromContext(c).Model(&reaction.Reaction{}).
+		Where("post_id = ? AND reactor = ?", postID, reactor).
+		Pluck("type", &types).Error
+	if err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+// CountReactionsByPostIDs 批量统计文章各表态类型的数量，返回以文章 ID 为键、表态类型到数量映射为值的映射
+func (m *ReactionMapperImpl) CountReactionsByPostIDs(c *app.RequestContext, postIDs []int64) (map[int64]map[string]int64, error) {
+	result := make(map[int64]map[string]int64, len(postIDs))
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		PostID int64  `gorm:"column:post_id"`
+		Type   string `gorm:"column:type"`
+		Count  int64  `gorm:"column:count"`
+	}
+	err := db.GetDBFromContext(c).Model(&reaction.Reaction{}).
+		Select("post_id, type, COUNT(*) AS count").
+		Where("post_id IN ?", postIDs).
+		Group("post_id, type").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if result[row.PostID] == nil {
+			result[row.PostID] = make(map[string]int64)
+		}
+		result[row.PostID][row.Type] = row.Count
+	}
+	return result, nil
+}
+
+// ListMostReactedPosts 统计指定时间以来表态最多的已发布文章，按表态数量降序
+func (m *ReactionMapperImpl) ListMostReactedPosts(c *app.RequestContext, since int64, reactionType string, limit int64) ([]*mapper.ReactionStat, error) {
+	query := db.GetDBFromContext(c).Model(&reaction.Reaction{}).
+		Select("reactions.post_id AS post_id, COUNT(*) AS count").
+		Joins("JOIN posts ON posts.id = reactions.post_id").
+		Where("posts.deleted = ? AND posts.status = ?", false, consts.PostStatusPublished)
+	if since > 0 {
+		query = query.Where("reactions.gmt_created >= ?", since)
+	}
+	if reactionType != "" {
+		query = query.Where("reactions.type = ?", reactionType)
+	}
+
+	var stats []*mapper.ReactionStat
+	err := query.Group("reactions.post_id").
+		Order("count DESC, reactions.post_id DESC").
+		Limit(int(limit)).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,167 @@
+// Package impl 提供文章系列相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/model/series"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// SeriesMapperImpl 文章系列数据访问实现
+type SeriesMapperImpl struct{}
+
+// NewSeriesMapper 创建文章系列数据访问实例
+func NewSeriesMapper() mapper.SeriesMapper {
+	return &SeriesMapperImpl{}
+}
+
+// GetSeriesByID 根据ID获取系列
+func (m *SeriesMapperImpl) GetSeriesByID(c *app.RequestContext, seriesID int64) (*series.Series, error) {
+	var s series.Series
+	err := db.GetDBFromContext(c).Where("id = ? AND deleted = ?", seriesID, false).First(&s).Error
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// GetSeriesByName 根据名称获取系列
+func (m *SeriesMapperImpl) GetSeriesByName(c *app.RequestContext, name string) (*series.Series, error) {
+	var s series.Series
+	err := db.GetDBFromContext(c).Where("name = ? AND deleted = ?", name, false).First(&s).Error
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// ListSeries 获取系列列表，keyword 为空时获取所有系列
+func (m *SeriesMapperImpl) ListSeries(c *app.RequestContext, pageNo, pageSize int64, keyword string) ([]*series.Series, int64, error) {
+	var list []*series.Series
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&series.Series{}).Where("deleted = ?", false)
+	if keyword != "" {
+		query = query.Where("name LIKE ?", "%"+keyword+"%")
+	}
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return list, total, nil
+}
+
+// CreateSeries 创建系列
+func (m *SeriesMapperImpl) CreateSeries(c *app.RequestContext, s *series.Series) error {
+	return db.GetDBFromContext(c).Create(s).Error
+}
+
+// UpdateSeries 更新系列
+func (m *SeriesMapperImpl) UpdateSeries(c *app.RequestContext, s *series.Series) error {
+	return db.GetDBFromContext(c).Save(s).Error
+}
+
+// DeleteSeries 删除系列（软删除，并移除所有文章关联）
+func (m *SeriesMapperImpl) DeleteSeries(c *app.RequestContext, seriesID int64) error {
+	return db.GetDBFromContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("series_id = ?", seriesID).Delete(&series.SeriesPost{}).Error; err != nil {
+			return fmt.Errorf("failed to clear series post references: %w", err)
+		}
+
+		if err := tx.Model(&series.Series{}).Where("id = ? AND deleted = ?", seriesID, false).Update("deleted", true).Error; err != nil {
+			return fmt.Errorf("failed to delete series: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// CountSeriesPosts 批量统计系列包含的文章数量（不含已删除的文章）
+func (m *SeriesMapperImpl) CountSeriesPosts(c *app.RequestContext, seriesIDs []int64) (map[int64]int64, error) {
+	result := make(map[int64]int64, len(seriesIDs))
+	if len(seriesIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		SeriesID  int64
+		PostCount int64
+	}
+	err := db.GetDBFromContext(c).
+		Table("series_posts").
+		Select("series_posts.series_id AS series_id, COUNT(posts.id) AS post_count").
+		Joins("JOIN posts ON posts.id = series_posts.post_id AND posts.deleted = ?", false).
+		Where("series_posts.series_id IN ?", seriesIDs).
+		Group("series_posts.series_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.SeriesID] = row.PostCount
+	}
+	return result, nil
+}
+
+// ListSeriesPosts 获取系列的文章关联，按顺序升序
+func (m *SeriesMapperImpl) ListSeriesPosts(c *app.RequestContext, seriesID int64) ([]*series.SeriesPost, error) {
+	var seriesPosts []*series.SeriesPost
+	err := db.GetDBFromContext(c).Where("series_id = ?", seriesID).Order("position ASC, id ASC").Find(&seriesPosts).Error
+	if err != nil {
+		return nil, err
+	}
+	return seriesPosts, nil
+}
+
+// GetSeriesPostByPostID 获取文章所属系列的关联
+func (m *SeriesMapperImpl) GetSeriesPostByPostID(c *app.RequestContext, postID int64) (*series.SeriesPost, error) {
+	var sp series.SeriesPost
+	err := db.GetDBFromContext(c).Where("post_id = ?", postID).First(&sp).Error
+	if err != nil {
+		return nil, err
+	}
+	return &sp, nil
+}
+
+// SetSeriesPosts 按顺序设置系列的文章（覆盖原有关联，文章从其他系列中移除）
+func (m *SeriesMapperImpl) SetSeriesPosts(c *app.RequestContext, seriesID int64, postIDs []int64) error {
+	return db.GetDBFromContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("series_id = ?", seriesID).Delete(&series.SeriesPost{}).Error; err != nil {
+			return fmt.Errorf("failed to clear series posts: %w", err)
+		}
+
+		if len(postIDs) == 0 {
+			return nil
+		}
+
+		if err := tx.Where("post_id IN ?", postIDs).Delete(&series.SeriesPost{}).Error; err != nil {
+			return fmt.Errorf("failed to remove posts from other series: %w", err)
+		}
+
+		seriesPosts := make([]*series.SeriesPost, 0, len(postIDs))
+		for i, postID := range postIDs {
+			seriesPosts = append(seriesPosts, &series.SeriesPost{SeriesID: seriesID, PostID: postID, Position: int64(i + 1)})
+		}
+		if err := tx.Create(&seriesPosts).Error; err != nil {
+			return fmt.Errorf("failed to create series posts: %w", err)
+		}
+
+		return nil
+	})
+}
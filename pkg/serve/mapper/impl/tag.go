@@ -0,0 +1,178 @@
+// Package impl 提供标签相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm/clause"
+
+	"github.com/Done-0/jank/internal/model/tag"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// TagMapperImpl 标签数据访问实现
+type TagMapperImpl struct{}
+
+// NewTagMapper 创建标签数据访问实例
+func NewTagMapper() mapper.TagMapper {
+	return &TagMapperImpl{}
+}
+
+// GetTagByID 根据ID获取标签
+func (m *TagMapperImpl) GetTagByID(c *app.RequestContext, tagID int64) (*tag.Tag, error) {
+	var t tag.Tag
+	err := db.GetDBFromContext(c).Where("id = ? AND deleted = ?", tagID, false).First(&t).Error
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// GetTagByName 根据名称获取标签
+func (m *TagMapperImpl) GetTagByName(c *app.RequestContext, name string) (*tag.Tag, error) {
+	var t tag.Tag
+	err := db.GetDBFromContext(c).Where("name = ? AND deleted = ?", name, false).First(&t).Error
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// ListTags 获取标签列表，keyword 为空时获取所有标签
+func (m *TagMapperImpl) ListTags(c *app.RequestContext, pageNo, pageSize int64, keyword string) ([]*tag.Tag, int64, error) {
+	var tags []*tag.Tag
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&tag.Tag{}).Where("deleted = ?", false)
+	if keyword != "" {
+		query = query.Where("name LIKE ?", "%"+keyword+"%")
+	}
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&tags).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return tags, total, nil
+}
+
+// ListTagCloud 获取标签云，按已发布文章数量降序
+func (m *TagMapperImpl) ListTagCloud(c *app.RequestContext, limit int64) ([]*mapper.TagCount, error) {
+	var counts []*mapper.TagCount
+	err := db.GetDBFromContext(c).
+		Table("tags").
+		Select("tags.id AS id, tags.name AS name, COUNT(posts.id) AS post_count").
+		Joins("JOIN post_tags ON post_tags.tag_id = tags.id").
+		Joins("JOIN posts ON posts.id = post_tags.post_id AND posts.deleted = ? AND posts.status = ?", false, consts.PostStatusPublished).
+		Where("tags.deleted = ?", false).
+		Group("tags.id, tags.name").
+		Order("post_count DESC, tags.id ASC").
+		Limit(int(limit)).
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// FirstOrCreateTag 根据名称获取标签，不存在时创建，已删除时恢复；
+// 并发创建同名标签时由唯一索引去重，冲突方忽略插入后重新读取已有标签
+func (m *TagMapperImpl) FirstOrCreateTag(c *app.RequestContext, name string) (*tag.Tag, error) {
+	tx := db.GetDBFromContext(c)
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&tag.Tag{Name: name}).Error; err != nil {
+		return nil, err
+	}
+
+	var t tag.Tag
+	if err := tx.Where("name = ?", name).First(&t).Error; err != nil {
+		return nil, err
+	}
+
+	if t.Deleted {
+		if err := tx.Model(&t).Update("deleted", false).Error; err != nil {
+			return nil, fmt.Errorf("failed to restore tag: %w", err)
+		}
+	}
+	return &t, nil
+}
+
+// UpdateTag 更新标签
+func (m *TagMapperImpl) UpdateTag(c *app.RequestContext, t *tag.Tag) error {
+	return db.GetDBFromContext(c).Save(t).Error
+}
+
+// DeleteTag 删除标签（软删除，并移除所有文章关联），需在调用方事务中执行
+func (m *TagMapperImpl) DeleteTag(c *app.RequestContext, tagID int64) error {
+	tx := db.GetDBFromContext(c)
+	if err := tx.Where("tag_id = ?", tagID).Delete(&tag.PostTag{}).Error; err != nil {
+		return fmt.Errorf("failed to clear post tag references: %w", err)
+	}
+
+	if err := tx.Model(&tag.Tag{}).Where("id = ? AND deleted = ?", tagID, false).Update("deleted", true).Error; err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return nil
+}
+
+// ListTagsByPostIDs 批量获取文章关联的标签
+func (m *TagMapperImpl) ListTagsByPostIDs(c *app.RequestContext, postIDs []int64) (map[int64][]*tag.Tag, error) {
+	result := make(map[int64][]*tag.Tag, len(postIDs))
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		tag.Tag
+		PostID int64
+	}
+	err := db.GetDBFromContext(c).
+		Table("tags").
+		Select("tags.*, post_tags.post_id AS post_id").
+		Joins("JOIN post_tags ON post_tags.tag_id = tags.id").
+		Where("post_tags.post_id IN ? AND tags.deleted = ?", postIDs, false).
+		Order("post_tags.id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		t := rows[i].Tag
+		result[rows[i].PostID] = append(result[rows[i].PostID], &t)
+	}
+	return result, nil
+}
+
+// SetPostTags 设置文章关联的标签（覆盖原有关联），需在调用方事务中执行
+func (m *TagMapperImpl) SetPostTags(c *app.RequestContext, postID int64, tagIDs []int64) error {
+	tx := db.GetDBFromContext(c)
+	if err := tx.Where("post_id = ?", postID).Delete(&tag.PostTag{}).Error; err != nil {
+		return fmt.Errorf("failed to clear post tags: %w", err)
+	}
+
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	postTags := make([]*tag.PostTag, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		postTags = append(postTags, &tag.PostTag{PostID: postID, TagID: tagID})
+	}
+	if err := tx.Create(&postTags).Error; err != nil {
+		return fmt.Errorf("failed to create post tags: %w", err)
+	}
+
+	return nil
+}
@@ -41,6 +41,19 @@ func (m *UserMapperImpl) GetUserByID(c *app.RequestContext, userID int64) (*user
 	return &u, nil
 }
 
+// ListUsersByIDs 根据 ID 列表批量获取用户
+func (m *UserMapperImpl) ListUsersByIDs(c *app.RequestContext, userIDs []int64) ([]*user.User, error) {
+	var users []*user.User
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+	err := db.GetDBFromContext(c).Where("id IN ? AND deleted = ?", userIDs, false).Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
 // GetUserByNickname 根据昵称获取用户
 func (m *UserMapperImpl) GetUserByNickname(c *app.RequestContext, nickname string) (*user.User, error) {
 	var u user.User
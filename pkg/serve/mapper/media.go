@@ -0,0 +1,23 @@
+// Package mapper 提供媒体文件相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/media"
+)
+
+// MediaMapper 媒体文件数据访问接口
+type MediaMapper interface {
+	GetMediaByID(c *app.RequestContext, mediaID int64) (*media.Media, error)                                                   // 根据 ID 获取媒体文件
+	GetMediaByOwnerAndHash(c *app.RequestContext, ownerID int64, hash string) (*media.Media, error)                            // 根据上传者与内容哈希获取媒体文件
+	GetMediaByHash(c *app.RequestContext, hash string) (*media.Media, error)                                                   // 根据内容哈希获取任一用户上传的媒体文件
+	ListMediaByURLs(c *app.RequestContext, urls []string) ([]*media.Media, error)                                              // 根据访问地址批量获取媒体文件
+	ListMedia(c *app.RequestContext, ownerID *int64, mimePrefix string, pageNo, pageSize int64) ([]*media.Media, int64, error) // 获取媒体文件列表，ownerID 为空时获取所有用户的文件
+	CreateMedia(c *app.RequestContext, m *media.Media) error                                                                   // 创建媒体文件记录
+	DeleteMedia(c *app.RequestContext, mediaID int64) error                                                                    // 删除媒体文件记录（软删除）
+	CountMediaByStorageKey(c *app.RequestContext, storage, storageKey string) (int64, error)                                   // 统计引用同一存储文件的媒体记录数量
+	UpdateVariantsByStorageKey(c *app.RequestContext, storage, storageKey, variants string) error                              // 更新引用同一存储文件的媒体记录的图片变体
+}
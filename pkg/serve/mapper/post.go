@@ -9,13 +9,61 @@ import (
 	"github.com/Done-0/jank/internal/model/post"
 )
 
+// PostSearchHit 文章检索命中结果
+type PostSearchHit struct {
+	PostID int64 `gorm:"column:post_id"` // 文章 ID
+	Score  int64 `gorm:"column:score"`   // 相关度得分（命中词项加权词频之和）
+}
+
+// PostViewStat 文章浏览量统计结果
+type PostViewStat struct {
+	PostID int64 `gorm:"column:post_id"` // 文章 ID
+	Views  int64 `gorm:"column:views"`   // 统计周期内的浏览量
+}
+
+// PostFilter 文章列表筛选与排序条件，字段为空时不按该条件筛选
+type PostFilter struct {
+	CategoryID *int64 // 分类 ID
+	TagID      *int64 // 标签 ID
+	SeriesID   *int64 // 系列 ID
+	Featured   *bool  // 是否推荐
+	Sort       string // 排序方式，为空时按系列筛选则按系列顺序，否则按最新发布
+}
+
 // PostMapper 文章数据访问接口
 type PostMapper interface {
-	GetPostByID(c *app.RequestContext, postID int64) (*post.Post, error)                                                            // 根据 ID 获取文章
-	ListPublishedPosts(c *app.RequestContext, pageNo, pageSize int64, categoryID *int64) ([]*post.Post, int64, error)               // 获取已发布文章列表，categoryID为空时不按分类筛选
-	ListPostsByStatus(c *app.RequestContext, pageNo, pageSize int64, status string, categoryID *int64) ([]*post.Post, int64, error) // 根据状态获取文章列表，status为空时获取所有文章，categoryID为空时不按分类筛选
-	ListPublicPosts(c *app.RequestContext, pageNo, pageSize int64) ([]*post.Post, int64, error)                                     // 获取公开文章（已发布+已归档）
-	CreatePost(c *app.RequestContext, post *post.Post) error                                                                        // 创建文章
-	UpdatePost(c *app.RequestContext, post *post.Post) error                                                                        // 更新文章
-	DeletePost(c *app.RequestContext, postID int64) error                                                                           // 删除文章
+	GetPostByID(c *app.RequestContext, postID int64) (*post.Post, error)                                                             // 根据 ID 获取文章
+	GetPostBySlug(c *app.RequestContext, slug string) (*post.Post, error)                                                            // 根据别名获取文章
+	GetSlugHistoryBySlug(c *app.RequestContext, slug string) (*post.SlugHistory, error)                                              // 根据历史别名获取别名记录
+	IsSlugTaken(c *app.RequestContext, slug string, excludePostID int64) (bool, error)                                               // 检查别名是否已被其他文章（含历史别名）占用
+	CreateSlugHistory(c *app.RequestContext, history *post.SlugHistory) error                                                        // 创建历史别名记录
+	ListPublishedPosts(c *app.RequestContext, pageNo, pageSize int64, filter *PostFilter) ([]*post.Post, int64, error)               // 获取已发布文章列表，未指定排序方式且未按标签、系列或推荐筛选时置顶文章优先
+	ListPublishedPostsByAuthor(c *app.RequestContext, authorID, pageNo, pageSize int64, sort string) ([]*post.Post, int64, error)    // 获取指定作者的已发布文章列表
+	ListPostsByStatus(c *app.RequestContext, pageNo, pageSize int64, status string, filter *PostFilter) ([]*post.Post, int64, error) // 根据状态获取文章列表，status为空时获取所有文章
+	ListPublicPosts(c *app.RequestContext, pageNo, pageSize int64) ([]*post.Post, int64, error)                                      // 获取公开文章（已发布+已归档）
+	CreatePost(c *app.RequestContext, post *post.Post) error                                                                         // 创建文章
+	UpdatePost(c *app.RequestContext, post *post.Post) error                                                                         // 更新文章
+	DeletePost(c *app.RequestContext, postID int64) error                                                                            // 删除文章
+	GetRevisionByID(c *app.RequestContext, revisionID int64) (*post.Revision, error)                                                 // 根据 ID 获取文章修订
+	ListRevisions(c *app.RequestContext, postID, pageNo, pageSize int64) ([]*post.Revision, int64, error)                            // 获取文章修订列表，按版本号降序
+	GetLatestRevisionVersion(c *app.RequestContext, postID int64) (int64, error)                                                     // 获取文章最新修订版本号，无修订时返回 0
+	ListRevisionEditorIDs(c *app.RequestContext, postID int64) ([]int64, error)                                                      // 获取文章所有修订的编辑者 ID（去重）
+	CreateRevision(c *app.RequestContext, revision *post.Revision) error                                                             // 创建文章修订
+	CountPublishedPostsForSitemap(c *app.RequestContext) (int64, error)                                                              // 统计允许收录的已发布文章数量
+	ListPublishedPostsForSitemap(c *app.RequestContext, offset, limit int64) ([]*post.Post, error)                                   // 按 ID 升序获取允许收录的已发布文章的链接信息（仅 ID、别名与修改时间）
+	GetLatestModifiedTime(c *app.RequestContext) (int64, error)                                                                      // 获取已发布文章的最近修改时间，无文章时返回 0
+	ListPostsAfterID(c *app.RequestContext, afterID, limit int64) ([]*post.Post, error)                                              // 按 ID 升序获取指定 ID 之后的文章（含所有状态），用于批量处理
+	UpdatePostRendered(c *app.RequestContext, post *post.Post) error                                                                 // 仅更新文章渲染结果（HTML、目录、字数与阅读时长），不修改更新时间
+	UpdatePostTimestamps(c *app.RequestContext, postID, created, modified int64) error                                               // 设置文章创建与修改时间，用于导入时保留原始时间
+	UpdatePostSEO(c *app.RequestContext, post *post.Post) error                                                                      // 更新文章 SEO 字段（含空值与 false）
+	ListPostsByIDs(c *app.RequestContext, postIDs []int64) ([]*post.Post, error)                                                     // 根据 ID 列表批量获取文章
+	ReplaceSearchTerms(c *app.RequestContext, postID int64, weights map[string]int) error                                            // 重建文章检索词项（覆盖原有词项）
+	DeleteSearchTerms(c *app.RequestContext, postID int64) error                                                                     // 删除文章检索词项
+	SearchPublishedPosts(c *app.RequestContext, terms []string, pageNo, pageSize int64) ([]*PostSearchHit, int64, error)             // 检索包含全部词项的已发布文章，按相关度降序
+	ListPinnedPosts(c *app.RequestContext, scope string, categoryID *int64, status string) ([]*post.Post, error)                     // 获取指定范围内未到期的置顶文章，status为空时获取所有状态，按置顶权重降序
+	GetMaxPinOrder(c *app.RequestContext, scope string, categoryID *int64) (int64, error)                                            // 获取指定范围内置顶文章的最大置顶权重，无置顶文章时返回 0
+	UpdatePostPin(c *app.RequestContext, post *post.Post) error                                                                      // 更新文章置顶范围、权重与到期时间（含空值），不修改更新时间
+	UpdatePinOrders(c *app.RequestContext, orders map[int64]int64) error                                                             // 批量设置文章置顶权重
+	UpdatePostFeatured(c *app.RequestContext, postID int64, featured bool) error                                                     // 设置文章是否推荐，不修改更新时间
+	ListPopularPostViews(c *app.RequestContext, since, limit int64) ([]*PostViewStat, error)                                         // 统计指定日期（含）以来已发布文章的浏览量，按浏览量降序
 }
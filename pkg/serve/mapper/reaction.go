@@ -0,0 +1,26 @@
+// Package mapper 提供文章表态相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/reaction"
+)
+
+// ReactionStat 文章表态数量统计结果
+type ReactionStat struct {
+	PostID int64 `gorm:"column:post_id"` // 文章 ID
+	Count  int64 `gorm:"column:count"`   // 统计周期内的表态数量
+}
+
+// ReactionMapper 文章表态数据访问接口
+type ReactionMapper interface {
+	GetReaction(c *app.RequestContext, postID int64, reactionType, reactor string) (*reaction.Reaction, error)          // 获取表态者对文章的指定类型表态
+	CreateReaction(c *app.RequestContext, r *reaction.Reaction) error                                                   // 创建表态，已存在时忽略
+	DeleteReaction(c *app.RequestContext, reactionID int64) error                                                       // 删除表态
+	ListReactorTypes(c *app.RequestContext, postID int64, reactor string) ([]string, error)                             // 获取表态者对文章已表态的类型
+	CountReactionsByPostIDs(c *app.RequestContext, postIDs []int64) (map[int64]map[string]int64, error)                 // 批量统计文章各表态类型的数量
+	ListMostReactedPosts(c *app.RequestContext, since int64, reactionType string, limit int64) ([]*ReactionStat, error) // 统计指定时间以来表态最多的已发布文章，since 为 0 时不限时间，reactionType 为空时统计全部类型
+}
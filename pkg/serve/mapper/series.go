@@ -0,0 +1,24 @@
+// Package mapper 提供文章系列相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/series"
+)
+
+// SeriesMapper 文章系列数据访问接口
+type SeriesMapper interface {
+	GetSeriesByID(c *app.RequestContext, seriesID int64) (*series.Series, error)                               // 根据 ID 获取系列
+	GetSeriesByName(c *app.RequestContext, name string) (*series.Series, error)                                // 根据名称获取系列
+	ListSeries(c *app.RequestContext, pageNo, pageSize int64, keyword string) ([]*series.Series, int64, error) // 获取系列列表，keyword为空时获取所有系列
+	CreateSeries(c *app.RequestContext, s *series.Series) error                                                // 创建系列
+	UpdateSeries(c *app.RequestContext, s *series.Series) error                                                // 更新系列
+	DeleteSeries(c *app.RequestContext, seriesID int64) error                                                  // 删除系列及其文章关联
+	CountSeriesPosts(c *app.RequestContext, seriesIDs []int64) (map[int64]int64, error)                        // 批量统计系列包含的文章数量
+	ListSeriesPosts(c *app.RequestContext, seriesID int64) ([]*series.SeriesPost, error)                       // 获取系列的文章关联，按顺序升序
+	GetSeriesPostByPostID(c *app.RequestContext, postID int64) (*series.SeriesPost, error)                     // 获取文章所属系列的关联
+	SetSeriesPosts(c *app.RequestContext, seriesID int64, postIDs []int64) error                               // 按顺序设置系列的文章（覆盖原有关联，文章从其他系列中移除）
+}
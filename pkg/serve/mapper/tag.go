@@ -0,0 +1,30 @@
+// Package mapper 提供标签相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/tag"
+)
+
+// TagCount 标签及其已发布文章数量
+type TagCount struct {
+	ID        int64  // 标签 ID
+	Name      string // 标签名称
+	PostCount int64  // 已发布文章数量
+}
+
+// TagMapper 标签数据访问接口
+type TagMapper interface {
+	GetTagByID(c *app.RequestContext, tagID int64) (*tag.Tag, error)                                   // 根据 ID 获取标签
+	GetTagByName(c *app.RequestContext, name string) (*tag.Tag, error)                                 // 根据名称获取标签
+	ListTags(c *app.RequestContext, pageNo, pageSize int64, keyword string) ([]*tag.Tag, int64, error) // 获取标签列表，keyword为空时获取所有标签
+	ListTagCloud(c *app.RequestContext, limit int64) ([]*TagCount, error)                              // 获取标签云，按已发布文章数量降序
+	FirstOrCreateTag(c *app.RequestContext, name string) (*tag.Tag, error)                             // 根据名称获取标签，不存在时创建，已删除时恢复
+	UpdateTag(c *app.RequestContext, tag *tag.Tag) error                                               // 更新标签
+	DeleteTag(c *app.RequestContext, tagID int64) error                                                // 删除标签及其文章关联
+	ListTagsByPostIDs(c *app.RequestContext, postIDs []int64) (map[int64][]*tag.Tag, error)            // 批量获取文章关联的标签
+	SetPostTags(c *app.RequestContext, postID int64, tagIDs []int64) error                             // 设置文章关联的标签（覆盖原有关联）
+}
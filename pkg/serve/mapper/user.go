@@ -15,6 +15,7 @@ type UserMapper interface {
 	GetUserByEmail(c *app.RequestContext, email string) (*user.User, error)       // 根据邮箱获取用户
 	GetUserByID(c *app.RequestContext, userID int64) (*user.User, error)          // 根据 ID 获取用户
 	GetUserByNickname(c *app.RequestContext, nickname string) (*user.User, error) // 根据昵称获取用户
+	ListUsersByIDs(c *app.RequestContext, userIDs []int64) ([]*user.User, error)  // 根据 ID 列表批量获取用户
 
 	RegisterUser(c *app.RequestContext, user *user.User) error // 注册用户
 	UpdateUser(c *app.RequestContext, user *user.User) error   // 更新用户信息
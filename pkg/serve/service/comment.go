@@ -0,0 +1,17 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// CommentService 评论服务接口
+type CommentService interface {
+	ListComments(c *app.RequestContext, req *dto.ListCommentsRequest) (*vo.ListCommentsResponse, error)                         // 获取文章评论列表（树形结构）
+	ListCommentsByStatus(c *app.RequestContext, req *dto.ListCommentsByStatusRequest) (*vo.ListCommentsByStatusResponse, error) // 根据审核状态获取评论列表，供管理员审核使用
+	Create(c *app.RequestContext, req *dto.CreateCommentRequest) (*vo.CreateCommentResponse, error)                             // 创建评论
+	Moderate(c *app.RequestContext, req *dto.ModerateCommentRequest) (*vo.ModerateCommentResponse, error)                       // 审核评论
+	Delete(c *app.RequestContext, req *dto.DeleteCommentRequest) (*vo.DeleteCommentResponse, error)                             // 删除评论
+}
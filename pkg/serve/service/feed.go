@@ -0,0 +1,13 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// FeedService 订阅源服务接口
+type FeedService interface {
+	GetFeed(c *app.RequestContext, req *dto.GetFeedRequest, format string) (*vo.FeedResponse, error) // 生成指定格式的订阅源，支持按分类或标签筛选
+}
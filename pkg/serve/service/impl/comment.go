@@ -0,0 +1,381 @@
+// Package impl 评论服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/comment"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// CommentServiceImpl 评论服务实现
+type CommentServiceImpl struct {
+	commentMapper mapper.CommentMapper
+	postMapper    mapper.PostMapper
+	userMapper    mapper.UserMapper
+	rbacMapper    mapper.RBACMapper
+}
+
+// NewCommentService 创建评论服务实例
+func NewCommentService(commentMapperImpl mapper.CommentMapper, postMapperImpl mapper.PostMapper, userMapperImpl mapper.UserMapper, rbacMapperImpl mapper.RBACMapper) service.CommentService {
+	return &CommentServiceImpl{
+		commentMapper: commentMapperImpl,
+		postMapper:    postMapperImpl,
+		userMapper:    userMapperImpl,
+		rbacMapper:    rbacMapperImpl,
+	}
+}
+
+// ListComments 获取文章评论列表，按顶级评论分页，回复以树形结构嵌套返回
+func (cs *CommentServiceImpl) ListComments(c *app.RequestContext, req *dto.ListCommentsRequest) (*vo.ListCommentsResponse, error) {
+	postID, err := strconv.ParseInt(req.PostID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.PostID)
+		return nil, fmt.Errorf("invalid post ID format: %w", err)
+	}
+
+	if _, err := cs.postMapper.GetPostByID(c, postID); err != nil {
+		logger.BizLogger(c).Errorf("post with ID %s not found: %v", req.PostID, err)
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+
+	roots, total, err := cs.commentMapper.ListRootComments(c, postID, req.PageNo, req.PageSize, consts.CommentStatusApproved)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list comments for post %s: %v", req.PostID, err)
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	rootIDs := make([]int64, 0, len(roots))
+	for _, root := range roots {
+		rootIDs = append(rootIDs, root.ID)
+	}
+
+	replies, err := cs.commentMapper.ListRepliesByRootIDs(c, rootIDs, consts.CommentStatusApproved)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list comment replies for post %s: %v", req.PostID, err)
+		return nil, fmt.Errorf("failed to list comment replies: %w", err)
+	}
+
+	// 构建评论树：回复按 ID 升序返回，父评论总是先于子评论出现
+	avatars := make(map[int64]string)
+	itemMap := make(map[int64]*vo.CommentItem, len(roots)+len(replies))
+	commentItems := make([]*vo.CommentItem, 0, len(roots))
+	for _, root := range roots {
+		item := cs.toCommentItem(c, root, avatars)
+		itemMap[root.ID] = item
+		commentItems = append(commentItems, item)
+	}
+	for _, reply := range replies {
+		parent, ok := itemMap[reply.ParentID]
+		if !ok {
+			continue // 父评论未通过审核或已删除，隐藏其下的回复
+		}
+		item := cs.toCommentItem(c, reply, avatars)
+		itemMap[reply.ID] = item
+		parent.Children = append(parent.Children, item)
+	}
+
+	return &vo.ListCommentsResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     commentItems,
+	}, nil
+}
+
+// ListCommentsByStatus 根据审核状态获取评论列表，结果包含评论者邮箱、IP 等信息，需具备审核评论权限
+func (cs *CommentServiceImpl) ListCommentsByStatus(c *app.RequestContext, req *dto.ListCommentsByStatusRequest) (*vo.ListCommentsByStatusResponse, error) {
+	if err := cs.checkPermission(c, consts.CommentPermissionModerate, consts.CommentPermissionModerateAction); err != nil {
+		return nil, err
+	}
+
+	var postID *int64
+	if req.PostID != "" {
+		pid, err := strconv.ParseInt(req.PostID, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid post ID format: %s", req.PostID)
+			return nil, fmt.Errorf("invalid post ID format: %w", err)
+		}
+		postID = &pid
+	}
+
+	comments, total, err := cs.commentMapper.ListCommentsByStatus(c, req.PageNo, req.PageSize, req.Status, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list comments by status: %v", err)
+		return nil, fmt.Errorf("failed to list comments by status: %w", err)
+	}
+
+	postTitles := make(map[int64]string)
+	commentItems := make([]*vo.AdminCommentItem, 0, len(comments))
+	for _, cm := range comments {
+		postTitle, ok := postTitles[cm.PostID]
+		if !ok {
+			if p, err := cs.postMapper.GetPostByID(c, cm.PostID); err == nil {
+				postTitle = p.Title
+			}
+			postTitles[cm.PostID] = postTitle
+		}
+
+		var userIDStr string
+		if cm.UserID != nil {
+			userIDStr = strconv.FormatInt(*cm.UserID, 10)
+		}
+
+		commentItems = append(commentItems, &vo.AdminCommentItem{
+			ID:        strconv.FormatInt(cm.ID, 10),
+			PostID:    strconv.FormatInt(cm.PostID, 10),
+			PostTitle: postTitle,
+			ParentID:  strconv.FormatInt(cm.ParentID, 10),
+			UserID:    userIDStr,
+			Nickname:  cm.Nickname,
+			Email:     cm.Email,
+			Website:   cm.Website,
+			Content:   cm.Content,
+			Status:    cm.Status,
+			IP:        cm.IP,
+			UserAgent: cm.UserAgent,
+			CreatedAt: time.Unix(cm.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+			UpdatedAt: time.Unix(cm.GmtModified, 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &vo.ListCommentsByStatusResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     commentItems,
+	}, nil
+}
+
+// Create 创建评论
+func (cs *CommentServiceImpl) Create(c *app.RequestContext, req *dto.CreateCommentRequest) (*vo.CreateCommentResponse, error) {
+	postID, err := strconv.ParseInt(req.PostID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.PostID)
+		return nil, fmt.Errorf("invalid post ID format: %w", err)
+	}
+
+	p, err := cs.postMapper.GetPostByID(c, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("post with ID %s not found: %v", req.PostID, err)
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+
+	if p.Status != consts.PostStatusPublished {
+		logger.BizLogger(c).Warnf("attempted to comment on post %s with status %s", req.PostID, p.Status)
+		return nil, fmt.Errorf("comments are only allowed on published posts")
+	}
+
+	var parentID, rootID int64
+	if req.ParentID != "" && req.ParentID != "0" {
+		parsedParentID, err := strconv.ParseInt(req.ParentID, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid parent comment ID format: %s", req.ParentID)
+			return nil, fmt.Errorf("invalid parent comment ID format: %w", err)
+		}
+
+		parent, err := cs.commentMapper.GetCommentByID(c, parsedParentID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("parent comment with ID %d not found: %v", parsedParentID, err)
+			return nil, fmt.Errorf("parent comment not found: %w", err)
+		}
+
+		if parent.PostID != postID || parent.Status != consts.CommentStatusApproved {
+			logger.BizLogger(c).Warnf("parent comment %d is not replyable for post %d", parsedParentID, postID)
+			return nil, fmt.Errorf("parent comment %d cannot be replied to", parsedParentID)
+		}
+
+		parentID = parent.ID
+		rootID = parent.RootID
+		if rootID == 0 {
+			rootID = parent.ID
+		}
+	}
+
+	cm := &comment.Comment{
+		PostID:    postID,
+		ParentID:  parentID,
+		RootID:    rootID,
+		Nickname:  req.Nickname,
+		Email:     req.Email,
+		Website:   req.Website,
+		Content:   req.Content,
+		Status:    consts.CommentStatusPending,
+		IP:        c.ClientIP(),
+		UserAgent: truncateString(string(c.UserAgent()), 255),
+	}
+
+	// 已登录用户使用账户信息，具备免审核权限时直接公开；匿名用户必须提供昵称和邮箱并等待审核
+	if userID, exists := c.Get(consts.JWTSubjectClaim); exists {
+		u, err := cs.userMapper.GetUserByID(c, userID.(int64))
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to get user %d: %v", userID.(int64), err)
+			return nil, fmt.Errorf("failed to get user info: %w", err)
+		}
+
+		cm.UserID = &u.ID
+		cm.Nickname = u.Nickname
+		cm.Email = u.Email
+
+		allowed, err := cs.rbacMapper.CheckPermission(c, strconv.FormatInt(u.ID, 10), consts.CommentPermissionAutoApprove, consts.CommentPermissionAutoApproveAction)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to check comment auto-approve permission for user %d: %v", u.ID, err)
+			return nil, fmt.Errorf("failed to check permission: %w", err)
+		}
+		if allowed {
+			cm.Status = consts.CommentStatusApproved
+		}
+	} else if req.Nickname == "" || req.Email == "" {
+		logger.BizLogger(c).Warnf("anonymous comment on post %s missing nickname or email", req.PostID)
+		return nil, fmt.Errorf("nickname and email are required for anonymous comments")
+	}
+
+	if err := cs.commentMapper.CreateComment(c, cm); err != nil {
+		logger.BizLogger(c).Errorf("failed to create comment on post %s: %v", req.PostID, err)
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("comment created successfully with ID: %d (status: %s)", cm.ID, cm.Status)
+
+	message := "Comment created successfully"
+	if cm.Status == consts.CommentStatusPending {
+		message = "Comment submitted and awaiting moderation"
+	}
+
+	return &vo.CreateCommentResponse{
+		ID:       strconv.FormatInt(cm.ID, 10),
+		PostID:   strconv.FormatInt(cm.PostID, 10),
+		ParentID: strconv.FormatInt(cm.ParentID, 10),
+		Nickname: cm.Nickname,
+		Content:  cm.Content,
+		Status:   cm.Status,
+		Message:  message,
+	}, nil
+}
+
+// Moderate 审核评论，需具备审核评论权限
+func (cs *CommentServiceImpl) Moderate(c *app.RequestContext, req *dto.ModerateCommentRequest) (*vo.ModerateCommentResponse, error) {
+	if err := cs.checkPermission(c, consts.CommentPermissionModerate, consts.CommentPermissionModerateAction); err != nil {
+		return nil, err
+	}
+
+	commentIDs := make([]int64, 0, len(req.IDs))
+	for _, id := range req.IDs {
+		commentID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid comment ID format: %s", id)
+			return nil, fmt.Errorf("invalid comment ID format: %w", err)
+		}
+		commentIDs = append(commentIDs, commentID)
+	}
+
+	affected, err := cs.commentMapper.UpdateCommentStatus(c, commentIDs, req.Status)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to moderate comments %v: %v", req.IDs, err)
+		return nil, fmt.Errorf("failed to moderate comments: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("%d comments moderated to status: %s", affected, req.Status)
+
+	return &vo.ModerateCommentResponse{
+		Affected: affected,
+		Message:  "Comments moderated successfully",
+	}, nil
+}
+
+// Delete 删除评论，需具备审核评论权限
+func (cs *CommentServiceImpl) Delete(c *app.RequestContext, req *dto.DeleteCommentRequest) (*vo.DeleteCommentResponse, error) {
+	if err := cs.checkPermission(c, consts.CommentPermissionModerate, consts.CommentPermissionModerateAction); err != nil {
+		return nil, err
+	}
+
+	commentID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid comment ID format: %s", req.ID)
+		return nil, fmt.Errorf("invalid comment ID format: %w", err)
+	}
+
+	if _, err := cs.commentMapper.GetCommentByID(c, commentID); err != nil {
+		logger.BizLogger(c).Errorf("comment with ID %s not found: %v", req.ID, err)
+		return nil, fmt.Errorf("comment not found: %w", err)
+	}
+
+	if err := cs.commentMapper.DeleteComment(c, commentID); err != nil {
+		logger.BizLogger(c).Errorf("failed to delete comment with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("comment deleted successfully with ID: %s", req.ID)
+
+	return &vo.DeleteCommentResponse{
+		Message: "Comment deleted successfully",
+	}, nil
+}
+
+// checkPermission 检查当前用户是否具备指定权限
+func (cs *CommentServiceImpl) checkPermission(c *app.RequestContext, resource, action string) error {
+	userID := currentUserID(c)
+	if userID == nil {
+		return fmt.Errorf("permission denied: %s", resource)
+	}
+
+	allowed, err := cs.rbacMapper.CheckPermission(c, strconv.FormatInt(*userID, 10), resource, action)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check permission %s for user %d: %v", resource, *userID, err)
+		return fmt.Errorf("failed to check permission: %w", err)
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user %d is not allowed to access %s", *userID, resource)
+		return fmt.Errorf("permission denied: %s", resource)
+	}
+	return nil
+}
+
+// toCommentItem 将评论模型转换为公开的评论列表项
+func (cs *CommentServiceImpl) toCommentItem(c *app.RequestContext, cm *comment.Comment, avatars map[int64]string) *vo.CommentItem {
+	var userIDStr, avatar string
+	if cm.UserID != nil {
+		userIDStr = strconv.FormatInt(*cm.UserID, 10)
+		cached, ok := avatars[*cm.UserID]
+		if !ok {
+			if u, err := cs.userMapper.GetUserByID(c, *cm.UserID); err == nil {
+				cached = u.Avatar
+			}
+			avatars[*cm.UserID] = cached
+		}
+		avatar = cached
+	}
+
+	return &vo.CommentItem{
+		ID:        strconv.FormatInt(cm.ID, 10),
+		PostID:    strconv.FormatInt(cm.PostID, 10),
+		ParentID:  strconv.FormatInt(cm.ParentID, 10),
+		UserID:    userIDStr,
+		Nickname:  cm.Nickname,
+		Avatar:    avatar,
+		Website:   cm.Website,
+		Content:   cm.Content,
+		Status:    cm.Status,
+		CreatedAt: time.Unix(cm.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+	}
+}
+
+// truncateString 按字符截断字符串
+func truncateString(s string, maxLen int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+	return string(runes[:maxLen])
+}
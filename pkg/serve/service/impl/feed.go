@@ -0,0 +1,197 @@
+// Package impl 订阅源服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/feed"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/internal/utils/site"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// FeedServiceImpl 订阅源服务实现
+type FeedServiceImpl struct {
+	postMapper     mapper.PostMapper
+	categoryMapper mapper.CategoryMapper
+	tagMapper      mapper.TagMapper
+	userMapper     mapper.UserMapper
+}
+
+// NewFeedService 创建订阅源服务实例
+func NewFeedService(postMapperImpl mapper.PostMapper, categoryMapperImpl mapper.CategoryMapper, tagMapperImpl mapper.TagMapper, userMapperImpl mapper.UserMapper) service.FeedService {
+	return &FeedServiceImpl{
+		postMapper:     postMapperImpl,
+		categoryMapper: categoryMapperImpl,
+		tagMapper:      tagMapperImpl,
+		userMapper:     userMapperImpl,
+	}
+}
+
+// GetFeed 生成指定格式的订阅源，支持按分类或标签筛选
+func (fs *FeedServiceImpl) GetFeed(c *app.RequestContext, req *dto.GetFeedRequest, format string) (*vo.FeedResponse, error) {
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	siteConfig := cfgs.SiteConfig
+
+	f := &feed.Feed{
+		Title:       siteConfig.Title,
+		Link:        site.AbsoluteURL(siteConfig, "/"),
+		FeedURL:     site.AbsoluteURL(siteConfig, string(c.URI().RequestURI())),
+		Description: siteConfig.Description,
+		Language:    siteConfig.Language,
+	}
+
+	if req.CategoryID != nil {
+		category, err := fs.categoryMapper.GetCategoryByID(c, *req.CategoryID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("category with ID %d not found: %v", *req.CategoryID, err)
+			return nil, fmt.Errorf("category not found: %w", err)
+		}
+		if !category.IsActive {
+			return nil, fmt.Errorf("category not found: %w", gorm.ErrRecordNotFound)
+		}
+		f.Title = fmt.Sprintf("%s - %s", siteConfig.Title, category.Name)
+		f.Link = site.CategoryURL(siteConfig, category.ID)
+		if category.Description != "" {
+			f.Description = category.Description
+		}
+	}
+
+	if req.TagID != nil {
+		t, err := fs.tagMapper.GetTagByID(c, *req.TagID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("tag with ID %d not found: %v", *req.TagID, err)
+			return nil, fmt.Errorf("tag not found: %w", err)
+		}
+		f.Title = fmt.Sprintf("%s - %s", f.Title, t.Name)
+		f.Link = site.TagURL(siteConfig, t.ID)
+		if t.Description != "" {
+			f.Description = t.Description
+		}
+	}
+
+	size := int64(cfgs.FeedConfig.Size)
+	if size <= 0 {
+		size = consts.DefaultFeedSize
+	}
+	posts, _, err := fs.postMapper.ListPublishedPosts(c, 1, size, &mapper.PostFilter{CategoryID: req.CategoryID, TagID: req.TagID, Sort: consts.PostSortNewest})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list posts for feed: %v", err)
+		return nil, fmt.Errorf("failed to list posts: %w", err)
+	}
+
+	postIDs := make([]int64, 0, len(posts))
+	var authorIDs, categoryIDs []int64
+	for _, p := range posts {
+		postIDs = append(postIDs, p.ID)
+		if p.AuthorID != nil {
+			authorIDs = append(authorIDs, *p.AuthorID)
+		}
+		if p.CategoryID != nil {
+			categoryIDs = append(categoryIDs, *p.CategoryID)
+		}
+	}
+
+	postTags, err := fs.tagMapper.ListTagsByPostIDs(c, postIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list tags for feed: %v", err)
+		return nil, fmt.Errorf("failed to list post tags: %w", err)
+	}
+
+	users, err := fs.userMapper.ListUsersByIDs(c, authorIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list authors for feed: %v", err)
+		return nil, fmt.Errorf("failed to list post authors: %w", err)
+	}
+	authorNames := make(map[int64]string, len(users))
+	for _, u := range users {
+		authorNames[u.ID] = u.Nickname
+	}
+
+	categoryNames := make(map[int64]string)
+	for _, categoryID := range categoryIDs {
+		if _, ok := categoryNames[categoryID]; ok {
+			continue
+		}
+		if category, err := fs.categoryMapper.GetCategoryByID(c, categoryID); err == nil && category.IsActive {
+			categoryNames[categoryID] = category.Name
+		} else {
+			categoryNames[categoryID] = ""
+		}
+	}
+
+	for _, p := range posts {
+		link := site.PostURL(siteConfig, p.ID, p.Slug)
+		published := time.Unix(p.GmtCreated, 0)
+		if p.PublishAt != nil {
+			published = time.Unix(*p.PublishAt, 0)
+		}
+		updated := time.Unix(p.GmtModified, 0)
+		if updated.After(f.Updated) {
+			f.Updated = updated
+		}
+
+		item := &feed.Item{
+			ID:        link,
+			Title:     p.Title,
+			Link:      link,
+			Summary:   p.Description,
+			Content:   p.HTML,
+			Published: published,
+			Updated:   updated,
+		}
+		if p.AuthorID != nil {
+			item.Author = authorNames[*p.AuthorID]
+		}
+		if p.CategoryID != nil && categoryNames[*p.CategoryID] != "" {
+			item.Categories = append(item.Categories, categoryNames[*p.CategoryID])
+		}
+		for _, t := range postTags[p.ID] {
+			item.Categories = append(item.Categories, t.Name)
+		}
+		f.Items = append(f.Items, item)
+	}
+
+	var content []byte
+	var contentType string
+	switch format {
+	case consts.FeedFormatAtom:
+		content, err = feed.RenderAtom(f)
+		contentType = feed.ContentTypeAtom
+	case consts.FeedFormatJSON:
+		content, err = feed.RenderJSON(f)
+		contentType = feed.ContentTypeJSON
+	default:
+		content, err = feed.RenderRSS(f)
+		contentType = feed.ContentTypeRSS
+	}
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to render %s feed: %v", format, err)
+		return nil, fmt.Errorf("failed to render feed: %w", err)
+	}
+
+	sum := sha256.Sum256(content)
+	return &vo.FeedResponse{
+		Content:      content,
+		ContentType:  contentType,
+		ETag:         `"` + hex.EncodeToString(sum[:16]) + `"`,
+		LastModified: f.Updated,
+	}, nil
+}
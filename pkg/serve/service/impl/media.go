@@ -0,0 +1,517 @@
+// Package impl 媒体文件服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/model/media"
+	"github.com/Done-0/jank/internal/storage"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	mediautils "github.com/Done-0/jank/internal/utils/media"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// variantGroup 合并同一变体的并发按需生成请求，避免重复读取与解码原图
+var variantGroup singleflight.Group
+
+// MediaServiceImpl 媒体文件服务实现
+type MediaServiceImpl struct {
+	mediaMapper mapper.MediaMapper
+	rbacMapper  mapper.RBACMapper
+}
+
+// NewMediaService 创建媒体文件服务实例
+func NewMediaService(mediaMapperImpl mapper.MediaMapper, rbacMapperImpl mapper.RBACMapper) service.MediaService {
+	return &MediaServiceImpl{
+		mediaMapper: mediaMapperImpl,
+		rbacMapper:  rbacMapperImpl,
+	}
+}
+
+// Upload 上传媒体文件，按内容识别类型并计算哈希；同一用户重复上传相同内容时直接返回已有记录，不同用户上传相同内容时复用存储中的文件
+func (ms *MediaServiceImpl) Upload(c *app.RequestContext, req *dto.UploadMediaRequest) (*vo.UploadMediaResponse, error) {
+	userID := currentUserID(c)
+	if userID == nil {
+		return nil, fmt.Errorf("permission denied: upload media requires login")
+	}
+	if storage.GlobalStorage == nil {
+		return nil, errors.New("storage not initialized")
+	}
+
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	maxSize := int64(cfgs.StorageConfig.MaxSizeMB) << 20
+	if maxSize <= 0 {
+		maxSize = consts.DefaultMediaMaxSizeMB << 20
+	}
+	if req.File.Size > maxSize {
+		return nil, fmt.Errorf("file too large: %d bytes exceeds limit of %d bytes", req.File.Size, maxSize)
+	}
+
+	file, err := req.File.Open()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to open uploaded file '%s': %v", req.File.Filename, err)
+		return nil, fmt.Errorf("failed to open uploaded file: %w", err)
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxSize+1))
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to read uploaded file '%s': %v", req.File.Filename, err)
+		return nil, fmt.Errorf("failed to read uploaded file: %w", err)
+	}
+	if int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("file too large: exceeds limit of %d bytes", maxSize)
+	}
+
+	mimeType := mediautils.DetectContentType(content)
+	if !slices.Contains(cfgs.StorageConfig.AllowedTypes, mimeType) {
+		logger.BizLogger(c).Warnf("user %d uploaded file '%s' with disallowed type %s", *userID, req.File.Filename, mimeType)
+		return nil, fmt.Errorf("media type not allowed: %s", mimeType)
+	}
+
+	if mediautils.IsProcessableImage(mimeType) {
+		if err := mediautils.CheckPixels(content, imageMaxPixels(cfgs.ImageConfig)); errors.Is(err, mediautils.ErrTooManyPixels) {
+			logger.BizLogger(c).Warnf("user %d uploaded image '%s' over pixel limit: %v", *userID, req.File.Filename, err)
+			return nil, fmt.Errorf("file too large: %w", err)
+		}
+	}
+
+	if cfgs.ImageConfig.StripMetadata && mediautils.IsProcessableImage(mimeType) {
+		normalized, err := mediautils.Normalize(content, mimeType, imageQuality(cfgs.ImageConfig), imageMaxPixels(cfgs.ImageConfig))
+		if err != nil {
+			logger.BizLogger(c).Warnf("failed to strip metadata from '%s', storing original: %v", req.File.Filename, err)
+		} else {
+			content = normalized
+		}
+	}
+
+	hash := mediautils.Hash(content)
+	existing, err := ms.mediaMapper.GetMediaByOwnerAndHash(c, *userID, hash)
+	if err == nil {
+		return &vo.UploadMediaResponse{
+			MediaItem: toMediaItem(existing),
+			Duplicate: true,
+			Message:   "Media already uploaded",
+		}, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.BizLogger(c).Errorf("failed to check duplicate media for user %d: %v", *userID, err)
+		return nil, fmt.Errorf("failed to check duplicate media: %w", err)
+	}
+
+	store := storage.GlobalStorage
+	key := mediautils.StorageKey(hash, mimeType)
+	exists, err := store.Exists(context.Background(), key)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check storage object '%s': %v", key, err)
+		return nil, fmt.Errorf("failed to check storage: %w", err)
+	}
+	if !exists {
+		if err := store.Put(context.Background(), key, bytes.NewReader(content), int64(len(content)), mimeType); err != nil {
+			logger.BizLogger(c).Errorf("failed to store media '%s': %v", key, err)
+			return nil, fmt.Errorf("failed to store media: %w", err)
+		}
+	}
+
+	width, height := mediautils.ImageSize(content)
+	variants := ""
+	if exists {
+		// 存储中已有相同文件时复用其变体
+		if other, err := ms.mediaMapper.GetMediaByHash(c, hash); err == nil && other.StorageKey == key {
+			variants = other.Variants
+		}
+	} else if width > 0 && mediautils.IsProcessableImage(mimeType) && !mediautils.IsAnimated(content, mimeType) {
+		variants = ms.prepareVariants(c, key, mimeType, content, cfgs.ImageConfig)
+	}
+
+	item := &media.Media{
+		OwnerID:    *userID,
+		FileName:   truncateString(req.File.Filename, 255),
+		Storage:    store.Driver(),
+		StorageKey: key,
+		URL:        store.URL(key),
+		MimeType:   mimeType,
+		Size:       int64(len(content)),
+		Hash:       hash,
+		Width:      width,
+		Height:     height,
+		Variants:   variants,
+	}
+	if err := ms.mediaMapper.CreateMedia(c, item); err != nil {
+		logger.BizLogger(c).Errorf("failed to create media record for '%s': %v", key, err)
+		return nil, fmt.Errorf("failed to create media: %w", err)
+	}
+
+	return &vo.UploadMediaResponse{
+		MediaItem: toMediaItem(item),
+		Message:   "Media uploaded successfully",
+	}, nil
+}
+
+// List 获取媒体文件列表，具备管理权限时返回所有用户的文件，否则仅返回自己上传的文件
+func (ms *MediaServiceImpl) List(c *app.RequestContext, req *dto.ListMediaRequest) (*vo.ListMediaResponse, error) {
+	userID := currentUserID(c)
+	if userID == nil {
+		return nil, fmt.Errorf("permission denied: list media requires login")
+	}
+
+	canManage, err := ms.canManage(c, *userID)
+	if err != nil {
+		return nil, err
+	}
+	ownerID := userID
+	if canManage {
+		ownerID = nil
+	}
+
+	items, total, err := ms.mediaMapper.ListMedia(c, ownerID, req.MimeType, req.PageNo, req.PageSize)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list media: %v", err)
+		return nil, fmt.Errorf("failed to list media: %w", err)
+	}
+
+	list := make([]*vo.MediaItem, 0, len(items))
+	for _, item := range items {
+		list = append(list, toMediaItem(item))
+	}
+
+	return &vo.ListMediaResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     list,
+	}, nil
+}
+
+// Delete 删除媒体文件，存储中的文件及其图片变体在不再被任何记录引用时一并删除
+func (ms *MediaServiceImpl) Delete(c *app.RequestContext, req *dto.DeleteMediaRequest) (*vo.DeleteMediaResponse, error) {
+	userID := currentUserID(c)
+	if userID == nil {
+		return nil, fmt.Errorf("permission denied: delete media requires login")
+	}
+
+	mediaID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid media ID format: %s", req.ID)
+		return nil, fmt.Errorf("invalid media ID format: %w", err)
+	}
+
+	item, err := ms.mediaMapper.GetMediaByID(c, mediaID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get media with ID %d: %v", mediaID, err)
+		return nil, fmt.Errorf("failed to get media: %w", err)
+	}
+
+	if item.OwnerID != *userID {
+		canManage, err := ms.canManage(c, *userID)
+		if err != nil {
+			return nil, err
+		}
+		if !canManage {
+			logger.BizLogger(c).Warnf("user %d is not allowed to delete media %d", *userID, mediaID)
+			return nil, fmt.Errorf("permission denied: %s", consts.MediaPermissionManage)
+		}
+	}
+
+	if err := ms.mediaMapper.DeleteMedia(c, mediaID); err != nil {
+		logger.BizLogger(c).Errorf("failed to delete media %d: %v", mediaID, err)
+		return nil, fmt.Errorf("failed to delete media: %w", err)
+	}
+
+	// 存储文件清理失败不影响删除结果，仅记录日志
+	refs, err := ms.mediaMapper.CountMediaByStorageKey(c, item.Storage, item.StorageKey)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count references of '%s': %v", item.StorageKey, err)
+	} else if refs == 0 && storage.GlobalStorage != nil && storage.GlobalStorage.Driver() == item.Storage {
+		if err := storage.GlobalStorage.Delete(context.Background(), item.StorageKey); err != nil {
+			logger.BizLogger(c).Errorf("failed to delete storage object '%s': %v", item.StorageKey, err)
+		}
+		for _, v := range decodeVariants(item.Variants) {
+			if err := storage.GlobalStorage.Delete(context.Background(), v.Key); err != nil {
+				logger.BizLogger(c).Errorf("failed to delete storage object '%s': %v", v.Key, err)
+			}
+		}
+	}
+
+	return &vo.DeleteMediaResponse{
+		Message: "Media deleted successfully",
+	}, nil
+}
+
+// ServeLocalFile 获取本地存储文件路径
+func (ms *MediaServiceImpl) ServeLocalFile(c *app.RequestContext, key string) (string, error) {
+	return storage.LocalPath(key)
+}
+
+// ServeVariant 获取图片变体访问地址，变体尚未生成时由原图生成并写入存储
+func (ms *MediaServiceImpl) ServeVariant(c *app.RequestContext, key string) (string, error) {
+	store := storage.GlobalStorage
+	if store == nil {
+		return "", errors.New("storage not initialized")
+	}
+
+	key = strings.TrimPrefix(path.Clean("/"+key), "/")
+	item, err := ms.mediaMapper.GetMediaByHash(c, variantHash(key))
+	if err != nil {
+		return "", fmt.Errorf("failed to get media of variant '%s': %w", key, err)
+	}
+
+	variants := decodeVariants(item.Variants)
+	idx := slices.IndexFunc(variants, func(v mediautils.Variant) bool { return v.Key == key })
+	if idx < 0 {
+		return "", fmt.Errorf("variant '%s' not found: %w", key, gorm.ErrRecordNotFound)
+	}
+	if variants[idx].Size > 0 {
+		return store.URL(variants[idx].Key), nil
+	}
+	if item.Storage != store.Driver() {
+		return "", fmt.Errorf("media stored with driver '%s' but current driver is '%s'", item.Storage, store.Driver())
+	}
+
+	url, err, _ := variantGroup.Do(key, func() (any, error) {
+		return ms.generateLazyVariant(c, store, item, variants, idx)
+	})
+	if err != nil {
+		return "", err
+	}
+	return url.(string), nil
+}
+
+// generateLazyVariant 由原图生成变体并写入存储，同时更新媒体记录中的变体信息
+func (ms *MediaServiceImpl) generateLazyVariant(c *app.RequestContext, store storage.Storage, item *media.Media, variants []mediautils.Variant, idx int) (string, error) {
+	variant := &variants[idx]
+
+	// 读取媒体记录之后，其他请求可能已生成该变体
+	if exists, err := store.Exists(context.Background(), variant.Key); err == nil && exists {
+		return store.URL(variant.Key), nil
+	}
+
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return "", fmt.Errorf("failed to get config: %w", err)
+	}
+
+	reader, err := store.Get(context.Background(), item.StorageKey)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to read storage object '%s': %v", item.StorageKey, err)
+		return "", fmt.Errorf("failed to read media: %w", err)
+	}
+	content, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to read storage object '%s': %v", item.StorageKey, err)
+		return "", fmt.Errorf("failed to read media: %w", err)
+	}
+
+	img, err := mediautils.Decode(content, imageMaxPixels(cfgs.ImageConfig))
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to decode media '%s': %v", item.StorageKey, err)
+		return "", err
+	}
+	if err := generateVariant(context.Background(), store, img, variant, cfgs.ImageConfig); err != nil {
+		logger.BizLogger(c).Errorf("failed to generate variant '%s': %v", variant.Key, err)
+		return "", fmt.Errorf("failed to generate variant: %w", err)
+	}
+
+	// 变体已写入存储，记录更新失败时下次访问会重新生成，仅记录日志
+	if encoded, err := json.Marshal(variants); err == nil {
+		if err := ms.mediaMapper.UpdateVariantsByStorageKey(c, item.Storage, item.StorageKey, string(encoded)); err != nil {
+			logger.BizLogger(c).Errorf("failed to update variants of '%s': %v", item.StorageKey, err)
+		}
+	}
+
+	return store.URL(variant.Key), nil
+}
+
+// prepareVariants 规划图片变体，上传时生成模式下同时生成变体，返回变体 JSON；生成失败的变体在首次访问时重新生成
+func (ms *MediaServiceImpl) prepareVariants(c *app.RequestContext, key, mimeType string, content []byte, cfg configs.ImageConfig) string {
+	img, err := mediautils.Decode(content, imageMaxPixels(cfg))
+	if err != nil {
+		logger.BizLogger(c).Warnf("failed to decode image '%s', skipping variants: %v", key, err)
+		return ""
+	}
+
+	opts := mediautils.VariantOptions{
+		Widths: cfg.Widths,
+		WebP:   cfg.WebP && mediautils.WebPEncoderAvailable(cfg.WebPEncoder),
+	}
+	if cfg.WebP && !opts.WebP {
+		logger.BizLogger(c).Warnf("webp encoder '%s' not available, skipping webp variants", cfg.WebPEncoder)
+	}
+
+	bounds := img.Bounds()
+	variants := mediautils.PlanVariants(key, mimeType, mediautils.IsOpaque(img), bounds.Dx(), bounds.Dy(), opts)
+	if len(variants) == 0 {
+		return ""
+	}
+
+	if cfg.Generate != consts.ImageGenerateLazy {
+		for i := range variants {
+			if err := generateVariant(context.Background(), storage.GlobalStorage, img, &variants[i], cfg); err != nil {
+				logger.BizLogger(c).Warnf("failed to generate variant '%s', deferring to first request: %v", variants[i].Key, err)
+			}
+		}
+	}
+
+	encoded, err := json.Marshal(variants)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to encode variants of '%s': %v", key, err)
+		return ""
+	}
+	return string(encoded)
+}
+
+// generateVariant 生成单个图片变体并写入存储，成功后记录变体文件大小
+func generateVariant(ctx context.Context, store storage.Storage, img image.Image, variant *mediautils.Variant, cfg configs.ImageConfig) error {
+	if img.Bounds().Dx() != variant.Width {
+		img = mediautils.Resize(img, variant.Width)
+	}
+
+	var content []byte
+	var err error
+	if variant.MimeType == "image/webp" {
+		encodeCtx, cancel := context.WithTimeout(ctx, consts.ImageEncodeTimeout*time.Second)
+		defer cancel()
+		content, err = mediautils.EncodeWebP(encodeCtx, cfg.WebPEncoder, img, imageQuality(cfg))
+	} else {
+		content, err = mediautils.Encode(img, variant.MimeType, imageQuality(cfg))
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := store.Put(ctx, variant.Key, bytes.NewReader(content), int64(len(content)), variant.MimeType); err != nil {
+		return fmt.Errorf("failed to store variant: %w", err)
+	}
+	variant.Size = int64(len(content))
+	return nil
+}
+
+// imageQuality 获取图片编码质量，未配置或超出范围时使用默认值
+func imageQuality(cfg configs.ImageConfig) int {
+	if cfg.Quality < 1 || cfg.Quality > 100 {
+		return consts.DefaultImageQuality
+	}
+	return cfg.Quality
+}
+
+// imageMaxPixels 获取允许解码的图片像素数上限，未配置时使用默认值
+func imageMaxPixels(cfg configs.ImageConfig) int64 {
+	if cfg.MaxPixels <= 0 {
+		return consts.DefaultImageMaxPixels
+	}
+	return cfg.MaxPixels
+}
+
+// variantHash 从变体存储键中解析原图内容哈希，如 ab/cd/abcd...1234_w640.webp 解析为 abcd...1234
+func variantHash(key string) string {
+	name := strings.TrimSuffix(path.Base(key), path.Ext(key))
+	if idx := strings.LastIndex(name, "_w"); idx >= 0 {
+		name = name[:idx]
+	}
+	return name
+}
+
+// decodeVariants 解析媒体文件存储的变体 JSON，解析失败或为空时返回空列表
+func decodeVariants(raw string) []mediautils.Variant {
+	var variants []mediautils.Variant
+	if raw == "" {
+		return variants
+	}
+	if err := json.Unmarshal([]byte(raw), &variants); err != nil {
+		return nil
+	}
+	return variants
+}
+
+// toImageSet 组装图片响应式尺寸集合，非图片时返回 nil
+// 已生成的变体指向存储地址，尚未生成的变体指向按需生成地址；srcset 按 MIME 类型分组，缺少原尺寸的分组补充原图
+func toImageSet(item *media.Media) *vo.ImageSet {
+	if item.Width == 0 || !mediautils.IsProcessableImage(item.MimeType) {
+		return nil
+	}
+
+	original := &vo.ImageVariant{URL: item.URL, Width: item.Width, Height: item.Height, MimeType: item.MimeType, Size: item.Size}
+	list := []*vo.ImageVariant{original}
+	for _, v := range decodeVariants(item.Variants) {
+		url := consts.MediaVariantPathPrefix + "/" + v.Key
+		if v.Size > 0 && storage.GlobalStorage != nil && storage.GlobalStorage.Driver() == item.Storage {
+			url = storage.GlobalStorage.URL(v.Key)
+		}
+		list = append(list, &vo.ImageVariant{URL: url, Width: v.Width, Height: v.Height, MimeType: v.MimeType, Size: v.Size})
+	}
+	slices.SortStableFunc(list, func(a, b *vo.ImageVariant) int { return a.Width - b.Width })
+
+	groups := make(map[string][]*vo.ImageVariant)
+	for _, v := range list {
+		groups[v.MimeType] = append(groups[v.MimeType], v)
+	}
+	srcset := make(map[string]string, len(groups))
+	for mimeType, group := range groups {
+		if group[len(group)-1].Width < item.Width {
+			group = append(group, original)
+		}
+		candidates := make([]string, 0, len(group))
+		for _, v := range group {
+			candidates = append(candidates, fmt.Sprintf("%s %dw", v.URL, v.Width))
+		}
+		srcset[mimeType] = strings.Join(candidates, ", ")
+	}
+
+	return &vo.ImageSet{Variants: list, Srcset: srcset}
+}
+
+// canManage 判断用户是否具备管理所有媒体文件的权限
+func (ms *MediaServiceImpl) canManage(c *app.RequestContext, userID int64) (bool, error) {
+	allowed, err := ms.rbacMapper.CheckPermission(c, strconv.FormatInt(userID, 10), consts.MediaPermissionManage, consts.MediaPermissionManageAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check media permission for user %d: %v", userID, err)
+		return false, fmt.Errorf("failed to check permission: %w", err)
+	}
+	return allowed, nil
+}
+
+// toMediaItem 转换媒体文件列表项
+func toMediaItem(item *media.Media) *vo.MediaItem {
+	return &vo.MediaItem{
+		ID:        strconv.FormatInt(item.ID, 10),
+		OwnerID:   strconv.FormatInt(item.OwnerID, 10),
+		FileName:  item.FileName,
+		URL:       item.URL,
+		MimeType:  item.MimeType,
+		Size:      item.Size,
+		Hash:      item.Hash,
+		Width:     item.Width,
+		Height:    item.Height,
+		CreatedAt: time.Unix(item.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+		ImageSet:  toImageSet(item),
+	}
+}
@@ -4,33 +4,71 @@
 package impl
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
 
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/category"
 	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/tag"
+	"github.com/Done-0/jank/internal/model/user"
 	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/internal/utils/importer"
 	"github.com/Done-0/jank/internal/utils/logger"
 	"github.com/Done-0/jank/internal/utils/markdown"
+	"github.com/Done-0/jank/internal/utils/pageview"
+	"github.com/Done-0/jank/internal/utils/sanitize"
+	"github.com/Done-0/jank/internal/utils/search"
+	"github.com/Done-0/jank/internal/utils/slug"
 	"github.com/Done-0/jank/pkg/serve/controller/dto"
 	"github.com/Done-0/jank/pkg/serve/mapper"
 	"github.com/Done-0/jank/pkg/serve/service"
 	"github.com/Done-0/jank/pkg/vo"
 )
 
+const (
+	maxSlugAttempts     = 100 // 自动生成别名时的最大尝试次数
+	searchSnippetLength = 160 // 检索结果摘要片段长度（字符）
+	rerenderBatchSize   = 100 // 重新渲染文章时每批处理的数量
+)
+
 // PostServiceImpl 文章服务实现
 type PostServiceImpl struct {
 	postMapper     mapper.PostMapper
 	categoryMapper mapper.CategoryMapper
+	tagMapper      mapper.TagMapper
+	userMapper     mapper.UserMapper
+	rbacMapper     mapper.RBACMapper
+	mediaMapper    mapper.MediaMapper
+	seriesMapper   mapper.SeriesMapper
+	reactionMapper mapper.ReactionMapper
 }
 
 // NewPostService 创建文章服务实例
-func NewPostService(postMapperImpl mapper.PostMapper, categoryMapperImpl mapper.CategoryMapper) service.PostService {
+func NewPostService(postMapperImpl mapper.PostMapper, categoryMapperImpl mapper.CategoryMapper, tagMapperImpl mapper.TagMapper, userMapperImpl mapper.UserMapper, rbacMapperImpl mapper.RBACMapper, mediaMapperImpl mapper.MediaMapper, seriesMapperImpl mapper.SeriesMapper, reactionMapperImpl mapper.ReactionMapper) service.PostService {
 	return &PostServiceImpl{
 		postMapper:     postMapperImpl,
 		categoryMapper: categoryMapperImpl,
+		tagMapper:      tagMapperImpl,
+		userMapper:     userMapperImpl,
+		rbacMapper:     rbacMapperImpl,
+		mediaMapper:    mediaMapperImpl,
+		seriesMapper:   seriesMapperImpl,
+		reactionMapper: reactionMapperImpl,
 	}
 }
 
@@ -48,58 +86,250 @@ func (ps *PostServiceImpl) GetPost(c *app.RequestContext, req *dto.GetPostReques
 		return nil, fmt.Errorf("failed to get post: %w", err)
 	}
 
+	if err := ps.checkPostVisible(c, post); err != nil {
+		return nil, err
+	}
+
+	return ps.toGetPostResponse(c, post)
+}
+
+// GetPostBySlug 根据别名获取文章，别名为历史别名时返回当前文章（响应中的别名为最新别名）
+func (ps *PostServiceImpl) GetPostBySlug(c *app.RequestContext, req *dto.GetPostBySlugRequest) (*vo.GetPostResponse, error) {
+	post, err := ps.postMapper.GetPostBySlug(c, req.Slug)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.BizLogger(c).Errorf("failed to get post with slug '%s': %v", req.Slug, err)
+			return nil, fmt.Errorf("failed to get post: %w", err)
+		}
+
+		history, err := ps.postMapper.GetSlugHistoryBySlug(c, req.Slug)
+		if err != nil {
+			logger.BizLogger(c).Errorf("post with slug '%s' not found: %v", req.Slug, err)
+			return nil, fmt.Errorf("post not found: %w", err)
+		}
+
+		post, err = ps.postMapper.GetPostByID(c, history.PostID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to get post with ID %d for slug '%s': %v", history.PostID, req.Slug, err)
+			return nil, fmt.Errorf("failed to get post: %w", err)
+		}
+	}
+
+	if err := ps.checkPostVisible(c, post); err != nil {
+		return nil, err
+	}
+
+	return ps.toGetPostResponse(c, post)
+}
+
+// isPublicPost 判断文章是否对所有人可见（已发布或已归档）
+func isPublicPost(p *post.Post) bool {
+	return p.Status == consts.PostStatusPublished || p.Status == consts.PostStatusArchived
+}
+
+// checkPostVisible 检查当前用户是否可查看文章
+// 已发布与已归档文章对所有人可见；草稿、私有与定时发布文章仅作者本人或拥有查看隐藏文章权限的用户可见，
+// 不可见时返回记录不存在错误，避免暴露文章是否存在
+func (ps *PostServiceImpl) checkPostVisible(c *app.RequestContext, p *post.Post) error {
+	if isPublicPost(p) {
+		return nil
+	}
+
+	userID := currentUserID(c)
+	if userID == nil {
+		logger.BizLogger(c).Warnf("anonymous request for hidden post %d with status %s", p.ID, p.Status)
+		return fmt.Errorf("post not found: %w", gorm.ErrRecordNotFound)
+	}
+
+	if p.AuthorID != nil && *p.AuthorID == *userID {
+		return nil
+	}
+
+	allowed, err := ps.rbacMapper.CheckPermission(c, strconv.FormatInt(*userID, 10), consts.PostPermissionViewHidden, consts.PostPermissionViewHiddenAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check view permission for user %d: %v", *userID, err)
+		return fmt.Errorf("failed to check permission: %w", err)
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user %d attempted to view hidden post %d without permission", *userID, p.ID)
+		return fmt.Errorf("post not found: %w", gorm.ErrRecordNotFound)
+	}
+
+	return nil
+}
+
+// toGetPostResponse 组装文章详情响应
+func (ps *PostServiceImpl) toGetPostResponse(c *app.RequestContext, p *post.Post) (*vo.GetPostResponse, error) {
 	var categoryIDStr, categoryName string
-	if post.CategoryID != nil {
-		if category, err := ps.categoryMapper.GetCategoryByID(c, *post.CategoryID); err == nil && category.IsActive {
-			categoryIDStr = strconv.FormatInt(*post.CategoryID, 10)
+	if p.CategoryID != nil {
+		if category, err := ps.categoryMapper.GetCategoryByID(c, *p.CategoryID); err == nil && category.IsActive {
+			categoryIDStr = strconv.FormatInt(*p.CategoryID, 10)
 			categoryName = category.Name
 		}
 	}
 
+	postTags, err := ps.tagMapper.ListTagsByPostIDs(c, []int64{p.ID})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get tags for post %d: %v", p.ID, err)
+		return nil, fmt.Errorf("failed to get post tags: %w", err)
+	}
+
+	authors, err := ps.listAuthorsByPosts(c, []*post.Post{p})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get author for post %d: %v", p.ID, err)
+		return nil, fmt.Errorf("failed to get post author: %w", err)
+	}
+	authorID, authorNickname, authorAvatar := authorInfo(p.AuthorID, authors)
+
+	imageSets, err := ps.listImageSetsByPosts(c, []*post.Post{p})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get image variants for post %d: %v", p.ID, err)
+		return nil, fmt.Errorf("failed to get post image variants: %w", err)
+	}
+
+	seriesInfo, err := ps.postSeriesInfo(c, p)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get series for post %d: %v", p.ID, err)
+		return nil, err
+	}
+
+	reactionCounts, err := ps.reactionMapper.CountReactionsByPostIDs(c, []int64{p.ID})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count reactions for post %d: %v", p.ID, err)
+		return nil, fmt.Errorf("failed to count post reactions: %w", err)
+	}
+
 	return &vo.GetPostResponse{
-		ID:           strconv.FormatInt(post.ID, 10),
-		Title:        post.Title,
-		Description:  post.Description,
-		Image:        post.Image,
-		Status:       post.Status,
-		CategoryID:   categoryIDStr,
-		CategoryName: categoryName,
-		Markdown:     post.Markdown,
-		HTML:         post.HTML,
-		CreatedAt:    time.Unix(post.GmtCreated, 0).Format("2006-01-02 15:04:05"),
-		UpdatedAt:    time.Unix(post.GmtModified, 0).Format("2006-01-02 15:04:05"),
+		ID:             strconv.FormatInt(p.ID, 10),
+		Title:          p.Title,
+		Slug:           p.Slug,
+		Description:    p.Description,
+		Image:          p.Image,
+		ImageSet:       imageSets[p.Image],
+		Status:         p.Status,
+		CategoryID:     categoryIDStr,
+		CategoryName:   categoryName,
+		AuthorID:       authorID,
+		AuthorNickname: authorNickname,
+		AuthorAvatar:   authorAvatar,
+		PublishAt:      formatPublishAt(p.PublishAt),
+		Tags:           toPostTagItems(postTags[p.ID]),
+		Markdown:       p.Markdown,
+		HTML:           p.HTML,
+		TOC:            decodeTOC(p.TOC),
+		WordCount:      p.WordCount,
+		ReadingTime:    p.ReadingTime,
+		CreatedAt:      time.Unix(p.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+		UpdatedAt:      time.Unix(p.GmtModified, 0).Format("2006-01-02 15:04:05"),
+
+		MetaTitle:       p.MetaTitle,
+		MetaDescription: p.MetaDescription,
+		CanonicalURL:    p.CanonicalURL,
+		NoIndex:         p.NoIndex,
+		OGImage:         p.OGImage,
+
+		Series: seriesInfo,
+
+		PinScope:    activePinScope(p),
+		PinExpireAt: formatPinExpireAt(p),
+		Featured:    p.Featured,
+		Views:       p.ViewCount,
+
+		ReactionTypes: postSelectedReactionTypes(p),
+		Reactions:     toReactionCounts(postReactionTypes(p), reactionCounts[p.ID]),
 	}, nil
 }
 
+// postSeriesInfo 获取文章所属系列信息，位置与上下篇仅按公开文章（及当前文章）计算，文章不属于任何系列时返回 nil
+func (ps *PostServiceImpl) postSeriesInfo(c *app.RequestContext, p *post.Post) (*vo.PostSeriesInfo, error) {
+	sp, err := ps.seriesMapper.GetSeriesPostByPostID(c, p.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get post series: %w", err)
+	}
+
+	s, err := ps.seriesMapper.GetSeriesByID(c, sp.SeriesID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+
+	parts, err := listSeriesParts(c, ps.seriesMapper, ps.postMapper, s.ID, func(part *post.Post) bool {
+		return part.ID == p.ID || isPublicPost(part)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	index := slices.IndexFunc(parts, func(part *post.Post) bool { return part.ID == p.ID })
+	if index < 0 {
+		return nil, nil
+	}
+
+	info := &vo.PostSeriesInfo{
+		ID:       strconv.FormatInt(s.ID, 10),
+		Name:     s.Name,
+		Position: index + 1,
+		Total:    len(parts),
+	}
+	if index > 0 {
+		info.Prev = toSeriesPostItem(parts[index-1], index-1)
+	}
+	if index < len(parts)-1 {
+		info.Next = toSeriesPostItem(parts[index+1], index+1)
+	}
+	return info, nil
+}
+
 // ListPublishedPosts 获取已发布文章列表
 func (ps *PostServiceImpl) ListPublishedPosts(c *app.RequestContext, req *dto.ListPublishedPostsRequest) (*vo.ListPostsResponse, error) {
-	posts, total, err := ps.postMapper.ListPublishedPosts(c, req.PageNo, req.PageSize, req.CategoryID)
+	posts, total, err := ps.postMapper.ListPublishedPosts(c, req.PageNo, req.PageSize, &mapper.PostFilter{
+		CategoryID: req.CategoryID,
+		TagID:      req.TagID,
+		SeriesID:   req.SeriesID,
+		Featured:   req.Featured,
+		Sort:       req.Sort,
+	})
 	if err != nil {
 		logger.BizLogger(c).Errorf("failed to list posts: %v", err)
 		return nil, fmt.Errorf("failed to list posts: %w", err)
 	}
 
-	postItems := make([]*vo.PostItem, 0, len(posts))
-	for _, post := range posts {
-		var categoryIDStr, categoryName string
-		if post.CategoryID != nil {
-			if category, err := ps.categoryMapper.GetCategoryByID(c, *post.CategoryID); err == nil && category.IsActive {
-				categoryIDStr = strconv.FormatInt(*post.CategoryID, 10)
-				categoryName = category.Name
-			}
-		}
+	postItems, err := ps.toPostItems(c, posts)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, err
+	}
 
-		postItems = append(postItems, &vo.PostItem{
-			ID:           strconv.FormatInt(post.ID, 10),
-			Title:        post.Title,
-			Description:  post.Description,
-			Image:        post.Image,
-			Status:       post.Status,
-			CategoryID:   categoryIDStr,
-			CategoryName: categoryName,
-			CreatedAt:    time.Unix(post.GmtCreated, 0).Format("2006-01-02 15:04:05"),
-			UpdatedAt:    time.Unix(post.GmtModified, 0).Format("2006-01-02 15:04:05"),
-		})
+	return &vo.ListPostsResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     postItems,
+	}, nil
+}
+
+// ListPostsByAuthor 获取指定作者的已发布文章列表
+func (ps *PostServiceImpl) ListPostsByAuthor(c *app.RequestContext, req *dto.ListPostsByAuthorRequest) (*vo.ListPostsResponse, error) {
+	if _, err := ps.userMapper.GetUserByID(c, req.AuthorID); err != nil {
+		logger.BizLogger(c).Errorf("author with ID %d not found: %v", req.AuthorID, err)
+		return nil, fmt.Errorf("author not found: %w", err)
+	}
+
+	posts, total, err := ps.postMapper.ListPublishedPostsByAuthor(c, req.AuthorID, req.PageNo, req.PageSize, req.Sort)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list posts by author %d: %v", req.AuthorID, err)
+		return nil, fmt.Errorf("failed to list posts by author: %w", err)
+	}
+
+	postItems, err := ps.toPostItems(c, posts)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, err
 	}
 
 	return &vo.ListPostsResponse{
@@ -112,33 +342,21 @@ func (ps *PostServiceImpl) ListPublishedPosts(c *app.RequestContext, req *dto.Li
 
 // ListPostsByStatus 根据状态获取文章列表
 func (ps *PostServiceImpl) ListPostsByStatus(c *app.RequestContext, req *dto.ListPostsByStatusRequest) (*vo.ListPostsResponse, error) {
-	posts, total, err := ps.postMapper.ListPostsByStatus(c, req.PageNo, req.PageSize, req.Status, req.CategoryID)
+	posts, total, err := ps.postMapper.ListPostsByStatus(c, req.PageNo, req.PageSize, req.Status, &mapper.PostFilter{
+		CategoryID: req.CategoryID,
+		SeriesID:   req.SeriesID,
+		Featured:   req.Featured,
+		Sort:       req.Sort,
+	})
 	if err != nil {
 		logger.BizLogger(c).Errorf("failed to list posts by status: %v", err)
 		return nil, fmt.Errorf("failed to list posts by status: %w", err)
 	}
 
-	postItems := make([]*vo.PostItem, 0, len(posts))
-	for _, post := range posts {
-		var categoryIDStr, categoryName string
-		if post.CategoryID != nil {
-			if category, err := ps.categoryMapper.GetCategoryByID(c, *post.CategoryID); err == nil && category.IsActive {
-				categoryIDStr = strconv.FormatInt(*post.CategoryID, 10)
-				categoryName = category.Name
-			}
-		}
-
-		postItems = append(postItems, &vo.PostItem{
-			ID:           strconv.FormatInt(post.ID, 10),
-			Title:        post.Title,
-			Description:  post.Description,
-			Image:        post.Image,
-			Status:       post.Status,
-			CategoryID:   categoryIDStr,
-			CategoryName: categoryName,
-			CreatedAt:    time.Unix(post.GmtCreated, 0).Format("2006-01-02 15:04:05"),
-			UpdatedAt:    time.Unix(post.GmtModified, 0).Format("2006-01-02 15:04:05"),
-		})
+	postItems, err := ps.toPostItems(c, posts)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, err
 	}
 
 	return &vo.ListPostsResponse{
@@ -149,6 +367,75 @@ func (ps *PostServiceImpl) ListPostsByStatus(c *app.RequestContext, req *dto.Lis
 	}, nil
 }
 
+// Search 全文检索已发布文章，按相关度排序并返回高亮摘要
+func (ps *PostServiceImpl) Search(c *app.RequestContext, req *dto.SearchPostsRequest) (*vo.SearchPostsResponse, error) {
+	response := &vo.SearchPostsResponse{
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     []*vo.SearchPostItem{},
+	}
+
+	terms := search.QueryTerms(req.Keyword)
+	if len(terms) == 0 {
+		return response, nil
+	}
+
+	hits, total, err := ps.postMapper.SearchPublishedPosts(c, terms, req.PageNo, req.PageSize)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to search posts with keyword '%s': %v", req.Keyword, err)
+		return nil, fmt.Errorf("failed to search posts: %w", err)
+	}
+	response.Total = total
+	if len(hits) == 0 {
+		return response, nil
+	}
+
+	postIDs := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		postIDs = append(postIDs, hit.PostID)
+	}
+	posts, err := ps.postMapper.ListPostsByIDs(c, postIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get posts for search hits: %v", err)
+		return nil, fmt.Errorf("failed to get posts: %w", err)
+	}
+	postMap := make(map[int64]*post.Post, len(posts))
+	for _, p := range posts {
+		postMap[p.ID] = p
+	}
+
+	// 按相关度顺序组装结果
+	ordered := make([]*post.Post, 0, len(hits))
+	scores := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		if p, ok := postMap[hit.PostID]; ok {
+			ordered = append(ordered, p)
+			scores = append(scores, hit.Score)
+		}
+	}
+
+	postItems, err := ps.toPostItems(c, ordered)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, err
+	}
+
+	for i, p := range ordered {
+		text := markdown.ExtractText([]byte(p.Markdown))
+		if text == "" {
+			text = p.Description
+		}
+		response.List = append(response.List, &vo.SearchPostItem{
+			PostItem:         postItems[i],
+			HighlightedTitle: search.Highlight(p.Title, req.Keyword, 0),
+			Snippet:          search.Highlight(text, req.Keyword, searchSnippetLength),
+			Score:            scores[i],
+		})
+	}
+
+	return response, nil
+}
+
 // Create 创建文章
 func (ps *PostServiceImpl) Create(c *app.RequestContext, req *dto.CreatePostRequest) (*vo.CreatePostResponse, error) {
 	status := req.Status
@@ -156,14 +443,18 @@ func (ps *PostServiceImpl) Create(c *app.RequestContext, req *dto.CreatePostRequ
 		status = consts.PostStatusDraft
 	}
 
-	var htmlContent string
+	var rendered *markdown.Result
 	if req.Markdown != "" {
-		html, err := markdown.RenderMarkdown([]byte(req.Markdown))
+		keepRaw, err := ps.keepRawHTML(c, currentUserID(c))
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to check author trust for post '%s': %v", req.Title, err)
+			return nil, err
+		}
+		rendered, err = renderPost(req.Markdown, keepRaw)
 		if err != nil {
 			logger.BizLogger(c).Errorf("failed to render markdown for post '%s': %v", req.Title, err)
 			return nil, fmt.Errorf("failed to render markdown: %w", err)
 		}
-		htmlContent = html
 	}
 
 	var categoryID *int64
@@ -183,19 +474,59 @@ func (ps *PostServiceImpl) Create(c *app.RequestContext, req *dto.CreatePostRequ
 		categoryID = &parsedCategoryID
 	}
 
+	publishAt, err := resolvePublishAt(status, req.PublishAt, nil)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid publish time for post '%s': %v", req.Title, err)
+		return nil, err
+	}
+
+	postSlug, err := ps.resolveSlug(c, req.Slug, req.Title, 0)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to resolve slug for post '%s': %v", req.Title, err)
+		return nil, err
+	}
+
 	post := &post.Post{
 		Title:       req.Title,
+		Slug:        postSlug,
 		Description: req.Description,
 		Image:       req.Image,
 		Status:      status,
 		CategoryID:  categoryID,
+		AuthorID:    currentUserID(c),
+		PublishAt:   publishAt,
 		Markdown:    req.Markdown,
-		HTML:        htmlContent,
+
+		MetaTitle:       req.MetaTitle,
+		MetaDescription: req.MetaDescription,
+		CanonicalURL:    req.CanonicalURL,
+		NoIndex:         req.NoIndex,
+		OGImage:         req.OGImage,
+	}
+	if rendered != nil {
+		applyRendered(post, rendered)
+	}
+	applyAutoSummary(post)
+	if err := applyReactionTypes(post, req.ReactionTypes); err != nil {
+		logger.BizLogger(c).Errorf("invalid reaction types for post '%s': %v", req.Title, err)
+		return nil, err
 	}
 
-	if err := ps.postMapper.CreatePost(c, post); err != nil {
+	postTags, err := db.RunDBTransaction(c, func() ([]*tag.Tag, error) {
+		if err := ps.postMapper.CreatePost(c, post); err != nil {
+			return nil, slugConflict(err, post.Slug, "failed to create post")
+		}
+		if _, err := ps.saveRevision(c, post.ID, post.Title, post.Markdown, currentUserID(c)); err != nil {
+			return nil, err
+		}
+		if err := ps.indexPost(c, post); err != nil {
+			return nil, err
+		}
+		return ps.bindPostTags(c, post.ID, req.Tags)
+	})
+	if err != nil {
 		logger.BizLogger(c).Errorf("failed to create post '%s': %v", req.Title, err)
-		return nil, fmt.Errorf("failed to create post: %w", err)
+		return nil, err
 	}
 
 	logger.BizLogger(c).Infof("post created successfully with ID: %d", post.ID)
@@ -211,13 +542,22 @@ func (ps *PostServiceImpl) Create(c *app.RequestContext, req *dto.CreatePostRequ
 	return &vo.CreatePostResponse{
 		ID:           strconv.FormatInt(post.ID, 10),
 		Title:        post.Title,
+		Slug:         post.Slug,
 		Description:  post.Description,
 		Image:        post.Image,
 		Status:       post.Status,
 		CategoryID:   categoryIDStr,
 		CategoryName: categoryName,
+		PublishAt:    formatPublishAt(post.PublishAt),
+		Tags:         toPostTagItems(postTags),
 		Markdown:     post.Markdown,
 		Message:      "Post created successfully",
+
+		MetaTitle:       post.MetaTitle,
+		MetaDescription: post.MetaDescription,
+		CanonicalURL:    post.CanonicalURL,
+		NoIndex:         post.NoIndex,
+		OGImage:         post.OGImage,
 	}, nil
 }
 
@@ -235,27 +575,50 @@ func (ps *PostServiceImpl) Update(c *app.RequestContext, req *dto.UpdatePostRequ
 		return nil, fmt.Errorf("failed to get existing post: %w", err)
 	}
 
+	// 保留修改前内容，用于在文章尚无修订记录时补充基线版本
+	oldTitle, oldMarkdown := existingPost.Title, existingPost.Markdown
+
 	// 更新字段（只更新非空字段）
 	if req.Title != "" {
 		existingPost.Title = req.Title
 	}
 	if req.Description != "" {
 		existingPost.Description = req.Description
+		delete(existingPost.Ext, consts.PostExtAutoDescription)
 	}
 	if req.Image != "" {
 		existingPost.Image = req.Image
+		delete(existingPost.Ext, consts.PostExtAutoImage)
 	}
 	if req.Status != "" {
 		existingPost.Status = req.Status
 	}
 	if req.Markdown != "" {
 		existingPost.Markdown = req.Markdown
-		html, err := markdown.RenderMarkdown([]byte(req.Markdown))
+		// 编辑者与作者均受信任时才保留原始 HTML
+		writers := []*int64{currentUserID(c)}
+		if existingPost.AuthorID != nil {
+			writers = append(writers, existingPost.AuthorID)
+		}
+		keepRaw, err := ps.keepRawHTML(c, writers...)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to check writer trust for post ID %s: %v", req.ID, err)
+			return nil, err
+		}
+		rendered, err := renderPost(req.Markdown, keepRaw)
 		if err != nil {
 			logger.BizLogger(c).Errorf("failed to render markdown for post ID %s: %v", req.ID, err)
 			return nil, fmt.Errorf("failed to render markdown: %w", err)
 		}
-		existingPost.HTML = html
+		applyRendered(existingPost, rendered)
+	}
+	applyAutoSummary(existingPost)
+	seoChanged := applySEO(existingPost, req)
+	if req.ReactionTypes != nil {
+		if err := applyReactionTypes(existingPost, req.ReactionTypes); err != nil {
+			logger.BizLogger(c).Errorf("invalid reaction types for post ID %s: %v", req.ID, err)
+			return nil, err
+		}
 	}
 	if req.CategoryID != "" {
 		parsedCategoryID, err := strconv.ParseInt(req.CategoryID, 10, 64)
@@ -273,9 +636,73 @@ func (ps *PostServiceImpl) Update(c *app.RequestContext, req *dto.UpdatePostRequ
 		existingPost.CategoryID = &parsedCategoryID
 	}
 
-	if err := ps.postMapper.UpdatePost(c, existingPost); err != nil {
+	if req.Status != "" || req.PublishAt != "" {
+		existingPost.PublishAt, err = resolvePublishAt(existingPost.Status, req.PublishAt, existingPost.PublishAt)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid publish time for post ID %s: %v", req.ID, err)
+			return nil, err
+		}
+	}
+
+	oldSlug := existingPost.Slug
+	if req.Slug != "" || oldSlug == "" {
+		existingPost.Slug, err = ps.resolveSlug(c, req.Slug, existingPost.Title, existingPost.ID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to resolve slug for post ID %s: %v", req.ID, err)
+			return nil, err
+		}
+	}
+
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		if err := ps.postMapper.UpdatePost(c, existingPost); err != nil {
+			return nil, slugConflict(err, existingPost.Slug, "failed to update post")
+		}
+		// Updates 忽略零值，目录或字数被清空时需单独写入
+		if req.Markdown != "" {
+			if err := ps.postMapper.UpdatePostRendered(c, existingPost); err != nil {
+				return nil, fmt.Errorf("failed to update rendered content: %w", err)
+			}
+		}
+		// SEO 字段可被清空或取消禁止收录，同样需单独写入
+		if seoChanged {
+			if err := ps.postMapper.UpdatePostSEO(c, existingPost); err != nil {
+				return nil, fmt.Errorf("failed to update SEO fields: %w", err)
+			}
+		}
+		latest, err := ps.postMapper.GetLatestRevisionVersion(c, existingPost.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest revision: %w", err)
+		}
+		if latest == 0 {
+			if _, err := ps.saveRevision(c, existingPost.ID, oldTitle, oldMarkdown, nil); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := ps.saveRevision(c, existingPost.ID, existingPost.Title, existingPost.Markdown, currentUserID(c)); err != nil {
+			return nil, err
+		}
+		if err := ps.indexPost(c, existingPost); err != nil {
+			return nil, err
+		}
+		if oldSlug != "" && oldSlug != existingPost.Slug {
+			if err := ps.postMapper.CreateSlugHistory(c, &post.SlugHistory{PostID: existingPost.ID, Slug: oldSlug}); err != nil {
+				return nil, fmt.Errorf("failed to save slug history: %w", err)
+			}
+		}
+		if req.Tags == nil {
+			return nil, nil
+		}
+		return ps.bindPostTags(c, existingPost.ID, req.Tags)
+	})
+	if err != nil {
 		logger.BizLogger(c).Errorf("failed to update post with ID %s: %v", req.ID, err)
-		return nil, fmt.Errorf("failed to update post: %w", err)
+		return nil, err
+	}
+
+	postTags, err := ps.tagMapper.ListTagsByPostIDs(c, []int64{existingPost.ID})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get tags for post %d: %v", existingPost.ID, err)
+		return nil, fmt.Errorf("failed to get post tags: %w", err)
 	}
 
 	logger.BizLogger(c).Infof("post updated successfully with ID: %s", req.ID)
@@ -291,13 +718,22 @@ func (ps *PostServiceImpl) Update(c *app.RequestContext, req *dto.UpdatePostRequ
 	return &vo.UpdatePostResponse{
 		ID:           strconv.FormatInt(existingPost.ID, 10),
 		Title:        existingPost.Title,
+		Slug:         existingPost.Slug,
 		Description:  existingPost.Description,
 		Image:        existingPost.Image,
 		Status:       existingPost.Status,
 		CategoryID:   categoryIDStr,
 		CategoryName: categoryName,
+		PublishAt:    formatPublishAt(existingPost.PublishAt),
+		Tags:         toPostTagItems(postTags[existingPost.ID]),
 		Markdown:     existingPost.Markdown,
 		Message:      "Post updated successfully",
+
+		MetaTitle:       existingPost.MetaTitle,
+		MetaDescription: existingPost.MetaDescription,
+		CanonicalURL:    existingPost.CanonicalURL,
+		NoIndex:         existingPost.NoIndex,
+		OGImage:         existingPost.OGImage,
 	}, nil
 }
 
@@ -315,9 +751,18 @@ func (ps *PostServiceImpl) Delete(c *app.RequestContext, req *dto.DeletePostRequ
 		return nil, fmt.Errorf("post not found: %w", err)
 	}
 
-	if err := ps.postMapper.DeletePost(c, postID); err != nil {
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		if err := ps.postMapper.DeletePost(c, postID); err != nil {
+			return nil, fmt.Errorf("failed to delete post: %w", err)
+		}
+		if err := ps.postMapper.DeleteSearchTerms(c, postID); err != nil {
+			return nil, fmt.Errorf("failed to delete search terms: %w", err)
+		}
+		return nil, nil
+	})
+	if err != nil {
 		logger.BizLogger(c).Errorf("failed to delete post with ID %s: %v", req.ID, err)
-		return nil, fmt.Errorf("failed to delete post: %w", err)
+		return nil, err
 	}
 
 	logger.BizLogger(c).Infof("post deleted successfully with ID: %s", req.ID)
@@ -326,3 +771,1311 @@ func (ps *PostServiceImpl) Delete(c *app.RequestContext, req *dto.DeletePostRequ
 		Message: "Post deleted successfully",
 	}, nil
 }
+
+// ListRevisions 获取文章修订列表，文章对当前用户不可见时视为不存在
+func (ps *PostServiceImpl) ListRevisions(c *app.RequestContext, req *dto.ListPostRevisionsRequest) (*vo.ListPostRevisionsResponse, error) {
+	postID, err := strconv.ParseInt(req.PostID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.PostID)
+		return nil, fmt.Errorf("invalid post ID format: %w", err)
+	}
+
+	p, err := ps.postMapper.GetPostByID(c, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("post with ID %s not found: %v", req.PostID, err)
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+	if err := ps.checkPostVisible(c, p); err != nil {
+		return nil, err
+	}
+
+	revisions, total, err := ps.postMapper.ListRevisions(c, postID, req.PageNo, req.PageSize)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list revisions for post %s: %v", req.PostID, err)
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	items := make([]*vo.PostRevisionItem, 0, len(revisions))
+	for _, r := range revisions {
+		var editorID string
+		if r.EditorID != nil {
+			editorID = strconv.FormatInt(*r.EditorID, 10)
+		}
+		items = append(items, &vo.PostRevisionItem{
+			ID:        strconv.FormatInt(r.ID, 10),
+			PostID:    strconv.FormatInt(r.PostID, 10),
+			Version:   r.Version,
+			Title:     r.Title,
+			EditorID:  editorID,
+			CreatedAt: time.Unix(r.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &vo.ListPostRevisionsResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     items,
+	}, nil
+}
+
+// DiffRevisions 对比同一文章的两个修订，返回统一格式差异，文章对当前用户不可见时视为不存在
+func (ps *PostServiceImpl) DiffRevisions(c *app.RequestContext, req *dto.DiffPostRevisionsRequest) (*vo.DiffPostRevisionsResponse, error) {
+	fromID, err := strconv.ParseInt(req.FromID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid revision ID format: %s", req.FromID)
+		return nil, fmt.Errorf("invalid revision ID format: %w", err)
+	}
+	toID, err := strconv.ParseInt(req.ToID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid revision ID format: %s", req.ToID)
+		return nil, fmt.Errorf("invalid revision ID format: %w", err)
+	}
+
+	from, err := ps.postMapper.GetRevisionByID(c, fromID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("revision with ID %s not found: %v", req.FromID, err)
+		return nil, fmt.Errorf("revision not found: %w", err)
+	}
+	to, err := ps.postMapper.GetRevisionByID(c, toID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("revision with ID %s not found: %v", req.ToID, err)
+		return nil, fmt.Errorf("revision not found: %w", err)
+	}
+
+	if from.PostID != to.PostID {
+		logger.BizLogger(c).Errorf("revisions %s and %s belong to different posts", req.FromID, req.ToID)
+		return nil, fmt.Errorf("revisions belong to different posts")
+	}
+
+	p, err := ps.postMapper.GetPostByID(c, from.PostID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("post with ID %d not found: %v", from.PostID, err)
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+	if err := ps.checkPostVisible(c, p); err != nil {
+		return nil, err
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.Markdown),
+		B:        difflib.SplitLines(to.Markdown),
+		FromFile: fmt.Sprintf("v%d", from.Version),
+		ToFile:   fmt.Sprintf("v%d", to.Version),
+		FromDate: time.Unix(from.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+		ToDate:   time.Unix(to.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+		Context:  3,
+	})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to diff revisions %s and %s: %v", req.FromID, req.ToID, err)
+		return nil, fmt.Errorf("failed to diff revisions: %w", err)
+	}
+
+	return &vo.DiffPostRevisionsResponse{
+		FromID:      strconv.FormatInt(from.ID, 10),
+		ToID:        strconv.FormatInt(to.ID, 10),
+		FromVersion: from.Version,
+		ToVersion:   to.Version,
+		Diff:        diff,
+	}, nil
+}
+
+// RestoreRevision 将文章内容恢复为指定修订，并记录为新的修订，仅文章作者或具备恢复修订权限的用户可操作
+func (ps *PostServiceImpl) RestoreRevision(c *app.RequestContext, req *dto.RestorePostRevisionRequest) (*vo.RestorePostRevisionResponse, error) {
+	revisionID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid revision ID format: %s", req.ID)
+		return nil, fmt.Errorf("invalid revision ID format: %w", err)
+	}
+
+	revision, err := ps.postMapper.GetRevisionByID(c, revisionID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("revision with ID %s not found: %v", req.ID, err)
+		return nil, fmt.Errorf("revision not found: %w", err)
+	}
+
+	existingPost, err := ps.postMapper.GetPostByID(c, revision.PostID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("post with ID %d not found: %v", revision.PostID, err)
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+	if err := ps.checkRevisionPermission(c, existingPost); err != nil {
+		return nil, err
+	}
+
+	// 恢复者、作者与修订的编辑者均受信任时才保留原始 HTML
+	writers := []*int64{currentUserID(c)}
+	if existingPost.AuthorID != nil {
+		writers = append(writers, existingPost.AuthorID)
+	}
+	if revision.EditorID != nil {
+		writers = append(writers, revision.EditorID)
+	}
+	keepRaw, err := ps.keepRawHTML(c, writers...)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check writer trust for post %d: %v", existingPost.ID, err)
+		return nil, err
+	}
+	rendered, err := renderPost(revision.Markdown, keepRaw)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to render markdown for revision %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to render markdown: %w", err)
+	}
+
+	existingPost.Title = revision.Title
+	existingPost.Markdown = revision.Markdown
+	applyRendered(existingPost, rendered)
+	applyAutoSummary(existingPost)
+
+	restored, err := db.RunDBTransaction(c, func() (*post.Revision, error) {
+		if err := ps.postMapper.UpdatePost(c, existingPost); err != nil {
+			return nil, fmt.Errorf("failed to update post: %w", err)
+		}
+		if err := ps.postMapper.UpdatePostRendered(c, existingPost); err != nil {
+			return nil, fmt.Errorf("failed to update rendered content: %w", err)
+		}
+		if err := ps.indexPost(c, existingPost); err != nil {
+			return nil, err
+		}
+		return ps.saveRevision(c, existingPost.ID, existingPost.Title, existingPost.Markdown, currentUserID(c))
+	})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to restore revision %s for post %d: %v", req.ID, existingPost.ID, err)
+		return nil, err
+	}
+
+	logger.BizLogger(c).Infof("post %d restored to revision version %d", existingPost.ID, revision.Version)
+
+	return &vo.RestorePostRevisionResponse{
+		PostID:  strconv.FormatInt(existingPost.ID, 10),
+		Version: restored.Version,
+		Message: "Post revision restored successfully",
+	}, nil
+}
+
+// RerenderPosts 按当前渲染与清洗配置重新生成全部文章的 HTML，需具备重新渲染权限
+func (ps *PostServiceImpl) RerenderPosts(c *app.RequestContext) (*vo.RerenderPostsResponse, error) {
+	userID := currentUserID(c)
+	if userID == nil {
+		return nil, fmt.Errorf("permission denied: %s", consts.PostPermissionRerender)
+	}
+	allowed, err := ps.rbacMapper.CheckPermission(c, strconv.FormatInt(*userID, 10), consts.PostPermissionRerender, consts.PostPermissionRerenderAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check rerender permission for user %d: %v", *userID, err)
+		return nil, fmt.Errorf("failed to check permission: %w", err)
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user %d is not allowed to rerender posts", *userID)
+		return nil, fmt.Errorf("permission denied: %s", consts.PostPermissionRerender)
+	}
+
+	response := &vo.RerenderPostsResponse{}
+	trustByUser := make(map[int64]bool)
+	var afterID int64
+	for {
+		posts, err := ps.postMapper.ListPostsAfterID(c, afterID, rerenderBatchSize)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to list posts after ID %d: %v", afterID, err)
+			return nil, fmt.Errorf("failed to list posts: %w", err)
+		}
+		if len(posts) == 0 {
+			break
+		}
+
+		for _, p := range posts {
+			afterID = p.ID
+			response.Total++
+
+			keepRaw, err := ps.rerenderKeepRaw(c, p, trustByUser)
+			if err != nil {
+				logger.BizLogger(c).Errorf("failed to check writer trust for post %d: %v", p.ID, err)
+				response.Failed++
+				continue
+			}
+
+			rendered, err := renderPost(p.Markdown, keepRaw)
+			if err != nil {
+				logger.BizLogger(c).Errorf("failed to render markdown for post %d: %v", p.ID, err)
+				response.Failed++
+				continue
+			}
+			updated := *p
+			applyRendered(&updated, rendered)
+			if updated.HTML == p.HTML && updated.TOC == p.TOC && updated.WordCount == p.WordCount && updated.ReadingTime == p.ReadingTime {
+				continue
+			}
+			if err := ps.postMapper.UpdatePostRendered(c, &updated); err != nil {
+				logger.BizLogger(c).Errorf("failed to update rendered content for post %d: %v", p.ID, err)
+				response.Failed++
+				continue
+			}
+			response.Updated++
+		}
+	}
+
+	logger.BizLogger(c).Infof("posts rerendered: total %d, updated %d, failed %d", response.Total, response.Updated, response.Failed)
+	response.Message = "Posts rerendered successfully"
+
+	return response, nil
+}
+
+// Import 从上传的文件导入文章，支持单个 Markdown 文件、Markdown 文件的 zip 压缩包与 WordPress 导出的 WXR 文件
+func (ps *PostServiceImpl) Import(c *app.RequestContext, req *dto.ImportPostsRequest) (*vo.ImportPostsResponse, error) {
+	userID := currentUserID(c)
+	if userID == nil {
+		return nil, fmt.Errorf("permission denied: %s", consts.PostPermissionImport)
+	}
+	allowed, err := ps.rbacMapper.CheckPermission(c, strconv.FormatInt(*userID, 10), consts.PostPermissionImport, consts.PostPermissionImportAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check import permission for user %d: %v", *userID, err)
+		return nil, fmt.Errorf("failed to check permission: %w", err)
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user %d is not allowed to import posts", *userID)
+		return nil, fmt.Errorf("permission denied: %s", consts.PostPermissionImport)
+	}
+
+	file, err := req.File.Open()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to open import file '%s': %v", req.File.Filename, err)
+		return nil, fmt.Errorf("failed to open import file: %w", err)
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, importer.MaxFileSize+1))
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to read import file '%s': %v", req.File.Filename, err)
+		return nil, fmt.Errorf("failed to read import file: %w", err)
+	}
+	if len(content) > importer.MaxFileSize {
+		return nil, fmt.Errorf("invalid import file: exceeds limit of %d bytes", importer.MaxFileSize)
+	}
+
+	parsed, err := importer.Parse(req.File.Filename, content)
+	if err != nil {
+		logger.BizLogger(c).Warnf("failed to parse import file '%s': %v", req.File.Filename, err)
+		return nil, fmt.Errorf("invalid import file: %w", err)
+	}
+
+	return ps.ImportParsed(c, parsed)
+}
+
+// ImportParsed 导入已解析的文章，逐篇在独立事务中创建并保留原始发布与更新时间，单篇失败不影响其他文章
+// 第一个分类作为文章分类（不存在时自动创建），其余分类作为标签保留；指定别名已被占用时该篇导入失败，避免重复导入
+func (ps *PostServiceImpl) ImportParsed(c *app.RequestContext, parsed *importer.Result) (*vo.ImportPostsResponse, error) {
+	authorID := currentUserID(c)
+	keepRaw, err := ps.keepRawHTML(c, authorID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check author trust for import: %v", err)
+		return nil, err
+	}
+
+	response := &vo.ImportPostsResponse{
+		Items: make([]*vo.ImportPostItem, 0, len(parsed.Failures)+len(parsed.Items)),
+	}
+	for _, failure := range parsed.Failures {
+		response.Items = append(response.Items, &vo.ImportPostItem{Source: failure.Source, Error: failure.Error})
+		response.Failed++
+	}
+
+	categoryIDs := make(map[string]int64)
+	for _, item := range parsed.Items {
+		result := &vo.ImportPostItem{Source: item.Source, Title: item.Title}
+		p, err := ps.importItem(c, item, authorID, keepRaw, categoryIDs)
+		if err != nil {
+			logger.BizLogger(c).Warnf("failed to import post from '%s': %v", item.Source, err)
+			result.Error = err.Error()
+			response.Failed++
+		} else {
+			result.ID = strconv.FormatInt(p.ID, 10)
+			result.Slug = p.Slug
+			result.Status = p.Status
+			response.Imported++
+		}
+		response.Items = append(response.Items, result)
+	}
+
+	response.Total = int64(len(response.Items))
+	response.Message = fmt.Sprintf("Imported %d of %d posts", response.Imported, response.Total)
+	logger.BizLogger(c).Infof("post import finished: total=%d, imported=%d, failed=%d", response.Total, response.Imported, response.Failed)
+
+	return response, nil
+}
+
+// Pin 设置或取消文章置顶，需具备置顶权限；新置顶或变更置顶范围的文章排在该范围的最前面
+func (ps *PostServiceImpl) Pin(c *app.RequestContext, req *dto.PinPostRequest) (*vo.PinPostResponse, error) {
+	if err := ps.checkPinPermission(c); err != nil {
+		return nil, err
+	}
+
+	postID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.ID)
+		return nil, fmt.Errorf("invalid post ID format: %w", err)
+	}
+
+	p, err := ps.postMapper.GetPostByID(c, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get post with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to get post: %w", err)
+	}
+
+	if req.Scope == consts.PostPinScopeNone {
+		p.PinScope = ""
+		p.PinOrder = 0
+		p.PinExpireAt = nil
+	} else {
+		if req.Scope == consts.PostPinScopeCategory && p.CategoryID == nil {
+			return nil, fmt.Errorf("invalid pin scope: post %d has no category", postID)
+		}
+
+		var expireAt *int64
+		if req.ExpireAt != "" {
+			t, err := time.ParseInLocation("2006-01-02 15:04:05", req.ExpireAt, time.Local)
+			if err != nil {
+				return nil, fmt.Errorf("invalid expire_at: %w", err)
+			}
+			if !t.After(time.Now()) {
+				return nil, fmt.Errorf("invalid expire_at: must be in the future")
+			}
+			ts := t.Unix()
+			expireAt = &ts
+		}
+
+		if activePinScope(p) != req.Scope {
+			maxOrder, err := ps.postMapper.GetMaxPinOrder(c, req.Scope, p.CategoryID)
+			if err != nil {
+				logger.BizLogger(c).Errorf("failed to get max pin order of scope '%s': %v", req.Scope, err)
+				return nil, fmt.Errorf("failed to get max pin order: %w", err)
+			}
+			p.PinOrder = maxOrder + 1
+		}
+		p.PinScope = req.Scope
+		p.PinExpireAt = expireAt
+	}
+
+	if err := ps.postMapper.UpdatePostPin(c, p); err != nil {
+		logger.BizLogger(c).Errorf("failed to update pin of post %d: %v", postID, err)
+		return nil, fmt.Errorf("failed to update post pin: %w", err)
+	}
+
+	return &vo.PinPostResponse{
+		ID:          req.ID,
+		PinScope:    p.PinScope,
+		PinExpireAt: formatPinExpireAt(p),
+		Message:     "Post pin updated successfully",
+	}, nil
+}
+
+// Feature 设置或取消文章推荐，需具备置顶权限
+func (ps *PostServiceImpl) Feature(c *app.RequestContext, req *dto.FeaturePostRequest) (*vo.FeaturePostResponse, error) {
+	if err := ps.checkPinPermission(c); err != nil {
+		return nil, err
+	}
+
+	postID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.ID)
+		return nil, fmt.Errorf("invalid post ID format: %w", err)
+	}
+
+	if _, err := ps.postMapper.GetPostByID(c, postID); err != nil {
+		logger.BizLogger(c).Errorf("failed to get post with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to get post: %w", err)
+	}
+
+	if err := ps.postMapper.UpdatePostFeatured(c, postID, *req.Featured); err != nil {
+		logger.BizLogger(c).Errorf("failed to update featured flag of post %d: %v", postID, err)
+		return nil, fmt.Errorf("failed to update post featured flag: %w", err)
+	}
+
+	return &vo.FeaturePostResponse{
+		ID:       req.ID,
+		Featured: *req.Featured,
+		Message:  "Post featured flag updated successfully",
+	}, nil
+}
+
+// ListPinnedPosts 获取指定范围内未到期的已发布置顶文章，按置顶顺序排列
+func (ps *PostServiceImpl) ListPinnedPosts(c *app.RequestContext, req *dto.ListPinnedPostsRequest) (*vo.ListPinnedPostsResponse, error) {
+	categoryID, err := parsePinCategoryID(req.Scope, req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := ps.postMapper.ListPinnedPosts(c, req.Scope, categoryID, consts.PostStatusPublished)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list pinned posts of scope '%s': %v", req.Scope, err)
+		return nil, fmt.Errorf("failed to list pinned posts: %w", err)
+	}
+
+	postItems, err := ps.toPostItems(c, posts)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, err
+	}
+
+	return &vo.ListPinnedPostsResponse{List: postItems}, nil
+}
+
+// ReorderPins 调整指定范围内置顶文章的顺序，需具备置顶权限；post_ids 须恰好包含该范围内全部未到期的置顶文章
+func (ps *PostServiceImpl) ReorderPins(c *app.RequestContext, req *dto.ReorderPinsRequest) (*vo.ReorderPinsResponse, error) {
+	if err := ps.checkPinPermission(c); err != nil {
+		return nil, err
+	}
+
+	categoryID, err := parsePinCategoryID(req.Scope, req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned, err := ps.postMapper.ListPinnedPosts(c, req.Scope, categoryID, "")
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list pinned posts of scope '%s': %v", req.Scope, err)
+		return nil, fmt.Errorf("failed to list pinned posts: %w", err)
+	}
+	if len(req.PostIDs) != len(pinned) {
+		return nil, fmt.Errorf("invalid post_ids: expected %d pinned posts, got %d", len(pinned), len(req.PostIDs))
+	}
+
+	pinnedIDs := make(map[int64]bool, len(pinned))
+	for _, p := range pinned {
+		pinnedIDs[p.ID] = true
+	}
+
+	orders := make(map[int64]int64, len(req.PostIDs))
+	for i, rawID := range req.PostIDs {
+		postID, err := strconv.ParseInt(rawID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid post ID format: %s", rawID)
+		}
+		if !pinnedIDs[postID] {
+			return nil, fmt.Errorf("invalid post_ids: post %d is not pinned in scope '%s'", postID, req.Scope)
+		}
+		if _, ok := orders[postID]; ok {
+			return nil, fmt.Errorf("invalid post_ids: duplicate post %d", postID)
+		}
+		orders[postID] = int64(len(req.PostIDs) - i)
+	}
+
+	if err := ps.postMapper.UpdatePinOrders(c, orders); err != nil {
+		logger.BizLogger(c).Errorf("failed to update pin orders of scope '%s': %v", req.Scope, err)
+		return nil, fmt.Errorf("failed to update pin orders: %w", err)
+	}
+
+	return &vo.ReorderPinsResponse{
+		Message: "Pinned posts reordered successfully",
+	}, nil
+}
+
+// RecordView 记录一次文章浏览，仅统计已发布文章
+// 访客以客户端 IP 与 User-Agent 加每日轮换的盐值哈希标识（不保存原始 IP），同一访客在去重时间窗口内重复浏览只计一次；
+// 浏览量先缓冲在 Redis 中，由后台任务定期写入数据库，Redis 不可用时不统计
+func (ps *PostServiceImpl) RecordView(c *app.RequestContext, p *vo.GetPostResponse) error {
+	if p.Status != consts.PostStatusPublished || global.RedisClient == nil {
+		return nil
+	}
+
+	postID, err := strconv.ParseInt(p.ID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid post ID format: %w", err)
+	}
+
+	ctx := context.Background()
+	day := pageview.DayStart(time.Now())
+	salt, err := viewSalt(ctx, day)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get view salt: %v", err)
+		return err
+	}
+
+	visitor := pageview.VisitorHash(salt, c.ClientIP(), string(c.UserAgent()))
+	seenKey := fmt.Sprintf("%s:%d:%s", consts.PostViewSeenKeyPrefix, postID, visitor)
+	first, err := global.RedisClient.SetNX(ctx, seenKey, 1, viewDedupWindow()).Result()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check view of post %d: %v", postID, err)
+		return fmt.Errorf("failed to check view: %w", err)
+	}
+	if !first {
+		return nil
+	}
+
+	if err := global.RedisClient.HIncrBy(ctx, consts.PostViewPendingKey, pageview.Field(postID, day), 1).Err(); err != nil {
+		logger.BizLogger(c).Errorf("failed to buffer view of post %d: %v", postID, err)
+		return fmt.Errorf("failed to buffer view: %w", err)
+	}
+	return nil
+}
+
+// ListPopularWeek 获取近 7 天（含当日）浏览量最多的已发布文章
+func (ps *PostServiceImpl) ListPopularWeek(c *app.RequestContext, req *dto.ListPopularPostsRequest) (*vo.ListPopularPostsResponse, error) {
+	since := pageview.DayStart(time.Now().AddDate(0, 0, 1-consts.PostPopularWeekDays))
+	stats, err := ps.postMapper.ListPopularPostViews(c, since, popularLimit(req.Limit))
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list popular post views since %d: %v", since, err)
+		return nil, fmt.Errorf("failed to list popular post views: %w", err)
+	}
+
+	postIDs := make([]int64, 0, len(stats))
+	for _, stat := range stats {
+		postIDs = append(postIDs, stat.PostID)
+	}
+	posts, err := ps.postMapper.ListPostsByIDs(c, postIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get popular posts: %v", err)
+		return nil, fmt.Errorf("failed to get posts: %w", err)
+	}
+	postMap := make(map[int64]*post.Post, len(posts))
+	for _, p := range posts {
+		postMap[p.ID] = p
+	}
+
+	// 按浏览量顺序组装结果
+	ordered := make([]*post.Post, 0, len(stats))
+	views := make([]int64, 0, len(stats))
+	for _, stat := range stats {
+		if p, ok := postMap[stat.PostID]; ok {
+			ordered = append(ordered, p)
+			views = append(views, stat.Views)
+		}
+	}
+
+	return ps.toPopularPostsResponse(c, ordered, views)
+}
+
+// ListPopularAllTime 获取总浏览量最多的已发布文章
+func (ps *PostServiceImpl) ListPopularAllTime(c *app.RequestContext, req *dto.ListPopularPostsRequest) (*vo.ListPopularPostsResponse, error) {
+	posts, _, err := ps.postMapper.ListPublishedPosts(c, 1, popularLimit(req.Limit), &mapper.PostFilter{Sort: consts.PostSortMostViewed})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list most viewed posts: %v", err)
+		return nil, fmt.Errorf("failed to list most viewed posts: %w", err)
+	}
+
+	// 与近 7 天热门一致，不返回尚无浏览量的文章
+	ordered := make([]*post.Post, 0, len(posts))
+	views := make([]int64, 0, len(posts))
+	for _, p := range posts {
+		if p.ViewCount > 0 {
+			ordered = append(ordered, p)
+			views = append(views, p.ViewCount)
+		}
+	}
+
+	return ps.toPopularPostsResponse(c, ordered, views)
+}
+
+// toPopularPostsResponse 组装热门文章列表响应
+func (ps *PostServiceImpl) toPopularPostsResponse(c *app.RequestContext, posts []*post.Post, views []int64) (*vo.ListPopularPostsResponse, error) {
+	postItems, err := ps.toPostItems(c, posts)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, err
+	}
+
+	response := &vo.ListPopularPostsResponse{List: make([]*vo.PopularPostItem, 0, len(postItems))}
+	for i, item := range postItems {
+		response.List = append(response.List, &vo.PopularPostItem{
+			PostItem:    item,
+			PeriodViews: views[i],
+		})
+	}
+	return response, nil
+}
+
+// viewSalt 获取指定日期的访客标识盐值，不存在时生成；多实例部署时各实例共享同一盐值
+func viewSalt(ctx context.Context, day int64) (string, error) {
+	key := fmt.Sprintf("%s:%d", consts.PostViewSaltKeyPrefix, day)
+	salt, err := global.RedisClient.Get(ctx, key).Result()
+	if err == nil {
+		return salt, nil
+	}
+	if !errors.Is(err, redis.Nil) {
+		return "", fmt.Errorf("failed to get view salt: %w", err)
+	}
+
+	// 当日首次浏览时生成，并发生成时以先写入的盐值为准
+	if salt, err = pageview.NewSalt(); err != nil {
+		return "", err
+	}
+	if err := global.RedisClient.SetNX(ctx, key, salt, consts.PostViewSaltTTL).Err(); err != nil {
+		return "", fmt.Errorf("failed to set view salt: %w", err)
+	}
+	salt, err = global.RedisClient.Get(ctx, key).Result()
+	if err != nil {
+		return "", fmt.Errorf("failed to get view salt: %w", err)
+	}
+	return salt, nil
+}
+
+// viewDedupWindow 获取访客浏览去重时间窗口，未配置时使用默认值
+func viewDedupWindow() time.Duration {
+	cfgs, err := configs.GetConfig()
+	if err != nil || cfgs.ViewConfig.DedupWindowMinutes <= 0 {
+		return consts.DefaultViewDedupWindow
+	}
+	return time.Duration(cfgs.ViewConfig.DedupWindowMinutes) * time.Minute
+}
+
+// popularLimit 获取热门文章列表返回数量，未指定时使用默认值
+func popularLimit(limit int64) int64 {
+	if limit <= 0 {
+		return consts.DefaultPopularPostsLimit
+	}
+	return limit
+}
+
+// checkPinPermission 检查当前用户是否具备置顶权限
+func (ps *PostServiceImpl) checkPinPermission(c *app.RequestContext) error {
+	userID := currentUserID(c)
+	if userID == nil {
+		return fmt.Errorf("permission denied: %s", consts.PostPermissionPin)
+	}
+	allowed, err := ps.rbacMapper.CheckPermission(c, strconv.FormatInt(*userID, 10), consts.PostPermissionPin, consts.PostPermissionPinAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check pin permission for user %d: %v", *userID, err)
+		return fmt.Errorf("failed to check permission: %w", err)
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user %d is not allowed to pin posts", *userID)
+		return fmt.Errorf("permission denied: %s", consts.PostPermissionPin)
+	}
+	return nil
+}
+
+// checkRevisionPermission 检查当前用户能否恢复文章修订：文章作者本人或具备恢复修订权限
+func (ps *PostServiceImpl) checkRevisionPermission(c *app.RequestContext, p *post.Post) error {
+	userID := currentUserID(c)
+	if userID == nil {
+		return fmt.Errorf("permission denied: %s", consts.PostPermissionRevision)
+	}
+	if p.AuthorID != nil && *p.AuthorID == *userID {
+		return nil
+	}
+	allowed, err := ps.rbacMapper.CheckPermission(c, strconv.FormatInt(*userID, 10), consts.PostPermissionRevision, consts.PostPermissionRevisionAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check revision permission for user %d: %v", *userID, err)
+		return fmt.Errorf("failed to check permission: %w", err)
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user %d is not allowed to restore revisions of post %d", *userID, p.ID)
+		return fmt.Errorf("permission denied: %s", consts.PostPermissionRevision)
+	}
+	return nil
+}
+
+// parsePinCategoryID 解析置顶范围对应的分类 ID，全局置顶时返回 nil
+func parsePinCategoryID(scope, rawID string) (*int64, error) {
+	if scope != consts.PostPinScopeCategory {
+		return nil, nil
+	}
+	categoryID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid category ID format: %w", err)
+	}
+	return &categoryID, nil
+}
+
+// activePinScope 获取文章当前生效的置顶范围，未置顶或置顶已到期时返回空字符串
+func activePinScope(p *post.Post) string {
+	if p.PinExpireAt != nil && *p.PinExpireAt <= time.Now().Unix() {
+		return ""
+	}
+	return p.PinScope
+}
+
+// formatPinExpireAt 格式化置顶到期时间，未置顶或永久置顶时返回空字符串
+func formatPinExpireAt(p *post.Post) string {
+	if activePinScope(p) == "" {
+		return ""
+	}
+	return formatPublishAt(p.PinExpireAt)
+}
+
+// importItem 创建单篇导入的文章
+func (ps *PostServiceImpl) importItem(c *app.RequestContext, item *importer.Item, authorID *int64, keepRaw bool, categoryIDs map[string]int64) (*post.Post, error) {
+	if len([]rune(item.Title)) > 255 {
+		return nil, errors.New("title exceeds 255 characters")
+	}
+
+	status := item.Status
+	var publishAt *int64
+	if status != consts.PostStatusDraft {
+		ts := time.Now().Unix()
+		if !item.Created.IsZero() {
+			ts = item.Created.Unix()
+		}
+		publishAt = &ts
+		if status == consts.PostStatusScheduled && ts <= time.Now().Unix() {
+			status = consts.PostStatusPublished
+		}
+	}
+
+	var rendered *markdown.Result
+	if item.Markdown != "" {
+		var err error
+		rendered, err = renderPost(item.Markdown, keepRaw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render markdown: %w", err)
+		}
+	}
+
+	var categoryID *int64
+	tagNames := item.Tags
+	if len(item.Categories) > 0 {
+		id, err := ps.resolveImportCategory(c, item.Categories[0], categoryIDs)
+		if err != nil {
+			return nil, err
+		}
+		categoryID = &id
+		tagNames = append(slices.Clone(item.Tags), item.Categories[1:]...)
+	}
+	tagNames = slices.DeleteFunc(tagNames, func(name string) bool { return len([]rune(name)) > 64 })
+
+	postSlug, err := ps.resolveSlug(c, item.Slug, item.Title, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &post.Post{
+		Title:       item.Title,
+		Slug:        postSlug,
+		Description: truncateString(item.Description, consts.PostDescriptionMaxLength),
+		Status:      status,
+		CategoryID:  categoryID,
+		AuthorID:    authorID,
+		PublishAt:   publishAt,
+		Markdown:    item.Markdown,
+	}
+	if len(item.Image) <= consts.PostImageMaxLength {
+		p.Image = item.Image
+	}
+	if rendered != nil {
+		applyRendered(p, rendered)
+	}
+	applyAutoSummary(p)
+
+	_, err = db.RunDBTransaction(c, func() ([]*tag.Tag, error) {
+		if err := ps.postMapper.CreatePost(c, p); err != nil {
+			return nil, slugConflict(err, p.Slug, "failed to create post")
+		}
+		if !item.Created.IsZero() {
+			modified := item.Created
+			if item.Updated.After(modified) {
+				modified = item.Updated
+			}
+			if err := ps.postMapper.UpdatePostTimestamps(c, p.ID, item.Created.Unix(), modified.Unix()); err != nil {
+				return nil, fmt.Errorf("failed to set post timestamps: %w", err)
+			}
+			p.GmtCreated, p.GmtModified = item.Created.Unix(), modified.Unix()
+		}
+		if _, err := ps.saveRevision(c, p.ID, p.Title, p.Markdown, authorID); err != nil {
+			return nil, err
+		}
+		if err := ps.indexPost(c, p); err != nil {
+			return nil, err
+		}
+		return ps.bindPostTags(c, p.ID, tagNames)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// resolveImportCategory 根据名称获取导入文章的分类 ID，不存在时创建顶级分类
+func (ps *PostServiceImpl) resolveImportCategory(c *app.RequestContext, name string, categoryIDs map[string]int64) (int64, error) {
+	if id, ok := categoryIDs[name]; ok {
+		return id, nil
+	}
+
+	cat, err := ps.categoryMapper.GetCategoryByName(c, name)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, fmt.Errorf("failed to get category '%s': %w", name, err)
+		}
+		cat = &category.Category{Name: truncateString(name, 100), IsActive: true}
+		if err := ps.categoryMapper.CreateCategory(c, cat); err != nil {
+			return 0, fmt.Errorf("failed to create category '%s': %w", name, err)
+		}
+	}
+
+	categoryIDs[name] = cat.ID
+	return cat.ID, nil
+}
+
+// keepRawHTML 判断内容是否保留原始 HTML：未启用清洗时保留；否则参与撰写的用户（作者、编辑者）需全部具备受信任角色，
+// 任一用户未知或不受信任时清洗
+func (ps *PostServiceImpl) keepRawHTML(c *app.RequestContext, writerIDs ...*int64) (bool, error) {
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		return false, fmt.Errorf("failed to get config: %w", err)
+	}
+	if !cfgs.SanitizeConfig.Enabled {
+		return true, nil
+	}
+
+	writerRoles := make([][]string, 0, len(writerIDs))
+	for _, writerID := range writerIDs {
+		if writerID == nil {
+			return false, nil
+		}
+		roles, err := ps.rbacMapper.GetUserRoles(c, strconv.FormatInt(*writerID, 10))
+		if err != nil {
+			return false, fmt.Errorf("failed to get user roles: %w", err)
+		}
+		names := make([]string, 0, len(roles))
+		for _, role := range roles {
+			names = append(names, role.V1)
+		}
+		writerRoles = append(writerRoles, names)
+	}
+
+	return sanitize.Trusted(cfgs.SanitizeConfig.TrustedRoles, writerRoles...), nil
+}
+
+// rerenderKeepRaw 判断重新渲染时文章是否保留原始 HTML：作者及所有修订的编辑者均需受信任，作者未知的文章按不受信任处理；
+// trustByUser 缓存单个用户的判断结果，避免批量渲染时重复查询角色
+func (ps *PostServiceImpl) rerenderKeepRaw(c *app.RequestContext, p *post.Post, trustByUser map[int64]bool) (bool, error) {
+	if p.AuthorID == nil {
+		return ps.keepRawHTML(c, nil)
+	}
+
+	editorIDs, err := ps.postMapper.ListRevisionEditorIDs(c, p.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list revision editors: %w", err)
+	}
+
+	for _, writerID := range append([]int64{*p.AuthorID}, editorIDs...) {
+		trusted, ok := trustByUser[writerID]
+		if !ok {
+			if trusted, err = ps.keepRawHTML(c, &writerID); err != nil {
+				return false, err
+			}
+			trustByUser[writerID] = trusted
+		}
+		if !trusted {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// renderPost 按配置的扩展渲染 Markdown 并提取目录与字数，不保留原始 HTML 时按配置的白名单清洗
+func renderPost(content string, keepRaw bool) (*markdown.Result, error) {
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	rendered, err := markdown.Render([]byte(content), markdown.Options{
+		Extensions:           cfgs.MarkdownConfig.Extensions,
+		HighlightStyle:       cfgs.MarkdownConfig.HighlightStyle,
+		HighlightLineNumbers: cfgs.MarkdownConfig.HighlightLineNumbers,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if keepRaw {
+		return rendered, nil
+	}
+
+	rendered.HTML = sanitize.HTML(rendered.HTML, sanitize.Policy{
+		AllowedElements:   cfgs.SanitizeConfig.AllowedElements,
+		AllowedAttributes: cfgs.SanitizeConfig.AllowedAttributes,
+		AllowedURLSchemes: cfgs.SanitizeConfig.AllowedURLSchemes,
+	})
+	return rendered, nil
+}
+
+// applyRendered 将渲染结果写入文章，目录以 JSON 存储，无标题时置空
+func applyRendered(p *post.Post, rendered *markdown.Result) {
+	p.HTML = rendered.HTML
+	p.WordCount = rendered.WordCount
+	p.ReadingTime = rendered.ReadingTime
+	p.TOC = ""
+	if len(rendered.TOC) > 0 {
+		if toc, err := json.Marshal(rendered.TOC); err == nil {
+			p.TOC = string(toc)
+		}
+	}
+}
+
+// applyAutoSummary 描述或封面为空、或此前由正文自动生成时，根据正文重新生成并在扩展字段中标记
+// 生成结果为空时保留原值，避免内容变更后误清空
+func applyAutoSummary(p *post.Post) {
+	if p.Ext == nil {
+		p.Ext = make(map[string]any)
+	}
+
+	if autoDescription, _ := p.Ext[consts.PostExtAutoDescription].(bool); p.Description == "" || autoDescription {
+		if excerpt := markdown.Excerpt([]byte(p.Markdown), excerptLength()); excerpt != "" {
+			p.Description = excerpt
+			p.Ext[consts.PostExtAutoDescription] = true
+		}
+	}
+
+	if autoImage, _ := p.Ext[consts.PostExtAutoImage].(bool); p.Image == "" || autoImage {
+		if image := markdown.FirstImage([]byte(p.Markdown)); image != "" && len(image) <= consts.PostImageMaxLength {
+			p.Image = image
+			p.Ext[consts.PostExtAutoImage] = true
+		}
+	}
+}
+
+// applySEO 将更新请求中非 null 的 SEO 字段写入文章
+// 参数：
+//   - p: 文章
+//   - req: 更新文章请求
+//
+// 返回值：
+//   - bool: 是否有 SEO 字段被修改
+func applySEO(p *post.Post, req *dto.UpdatePostRequest) bool {
+	changed := false
+	for _, field := range []struct {
+		value  *string
+		target *string
+	}{
+		{req.MetaTitle, &p.MetaTitle},
+		{req.MetaDescription, &p.MetaDescription},
+		{req.CanonicalURL, &p.CanonicalURL},
+		{req.OGImage, &p.OGImage},
+	} {
+		if field.value != nil {
+			*field.target = *field.value
+			changed = true
+		}
+	}
+	if req.NoIndex != nil {
+		p.NoIndex = *req.NoIndex
+		changed = true
+	}
+	return changed
+}
+
+// excerptLength 获取自动摘要长度，未配置时使用默认值，且不超过描述字段长度
+func excerptLength() int {
+	cfgs, err := configs.GetConfig()
+	if err != nil || cfgs.ExcerptConfig.Length <= 0 {
+		return consts.DefaultExcerptLength
+	}
+	return min(cfgs.ExcerptConfig.Length, consts.PostDescriptionMaxLength)
+}
+
+// decodeTOC 解析文章存储的目录 JSON，解析失败或为空时返回空列表
+func decodeTOC(raw string) []*vo.PostTOCItem {
+	toc := make([]*vo.PostTOCItem, 0)
+	if raw == "" {
+		return toc
+	}
+	if err := json.Unmarshal([]byte(raw), &toc); err != nil {
+		return make([]*vo.PostTOCItem, 0)
+	}
+	return toc
+}
+
+// saveRevision 记录文章内容快照，版本号在当前最新版本基础上递增
+func (ps *PostServiceImpl) saveRevision(c *app.RequestContext, postID int64, title, content string, editorID *int64) (*post.Revision, error) {
+	latest, err := ps.postMapper.GetLatestRevisionVersion(c, postID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest revision: %w", err)
+	}
+
+	revision := &post.Revision{
+		PostID:   postID,
+		Version:  latest + 1,
+		Title:    title,
+		Markdown: content,
+		EditorID: editorID,
+	}
+	if err := ps.postMapper.CreateRevision(c, revision); err != nil {
+		return nil, fmt.Errorf("failed to create revision: %w", err)
+	}
+
+	return revision, nil
+}
+
+// indexPost 重建文章检索词项，标题、描述与正文按不同权重计入
+func (ps *PostServiceImpl) indexPost(c *app.RequestContext, p *post.Post) error {
+	weights := search.BuildTermWeights(p.Title, p.Description, markdown.ExtractText([]byte(p.Markdown)))
+	if err := ps.postMapper.ReplaceSearchTerms(c, p.ID, weights); err != nil {
+		return fmt.Errorf("failed to index post: %w", err)
+	}
+	return nil
+}
+
+// resolvePublishAt 解析并校验发布时间：定时发布必须指定未来时间，直接发布且未指定时间时记录当前时间
+func resolvePublishAt(status, publishAt string, current *int64) (*int64, error) {
+	ts := current
+	if publishAt != "" {
+		t, err := time.ParseInLocation("2006-01-02 15:04:05", publishAt, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid publish time format: %w", err)
+		}
+		unix := t.Unix()
+		ts = &unix
+	}
+
+	now := time.Now().Unix()
+	switch status {
+	case consts.PostStatusScheduled:
+		if ts == nil {
+			return nil, fmt.Errorf("publish time is required for scheduled posts")
+		}
+		if *ts <= now {
+			return nil, fmt.Errorf("publish time must be in the future for scheduled posts")
+		}
+	case consts.PostStatusPublished:
+		if ts == nil {
+			ts = &now
+		}
+	}
+
+	return ts, nil
+}
+
+// formatPublishAt 格式化发布时间，未设置时返回空字符串
+func formatPublishAt(publishAt *int64) string {
+	if publishAt == nil {
+		return ""
+	}
+	return time.Unix(*publishAt, 0).Format("2006-01-02 15:04:05")
+}
+
+// currentUserID 从请求上下文中获取当前登录用户 ID，未登录时返回 nil
+func currentUserID(c *app.RequestContext) *int64 {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		return nil
+	}
+	id, ok := userID.(int64)
+	if !ok {
+		return nil
+	}
+	return &id
+}
+
+// bindPostTags 根据标签名称设置文章标签，不存在的标签自动创建
+func (ps *PostServiceImpl) bindPostTags(c *app.RequestContext, postID int64, names []string) ([]*tag.Tag, error) {
+	seen := make(map[string]bool, len(names))
+	tags := make([]*tag.Tag, 0, len(names))
+	tagIDs := make([]int64, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		t, err := ps.tagMapper.FirstOrCreateTag(c, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get or create tag '%s': %w", name, err)
+		}
+
+		tags = append(tags, t)
+		tagIDs = append(tagIDs, t.ID)
+	}
+
+	if err := ps.tagMapper.SetPostTags(c, postID, tagIDs); err != nil {
+		return nil, fmt.Errorf("failed to set post tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// resolveSlug 确定文章别名：指定别名时校验格式与唯一性，未指定时根据标题生成并在冲突时追加序号
+func (ps *PostServiceImpl) resolveSlug(c *app.RequestContext, requested, title string, postID int64) (string, error) {
+	if requested != "" {
+		if !slug.IsValid(requested) {
+			return "", fmt.Errorf("invalid slug '%s': only lowercase letters, digits and hyphens are allowed", requested)
+		}
+		taken, err := ps.postMapper.IsSlugTaken(c, requested, postID)
+		if err != nil {
+			return "", fmt.Errorf("failed to check slug: %w", err)
+		}
+		if taken {
+			return "", fmt.Errorf("slug '%s' already exists", requested)
+		}
+		return requested, nil
+	}
+
+	base := slug.Generate(title)
+	if base == "" {
+		base = "post"
+	}
+
+	for i := 1; i <= maxSlugAttempts; i++ {
+		candidate := base
+		if i > 1 {
+			suffix := "-" + strconv.Itoa(i)
+			candidate = strings.TrimRight(base[:min(len(base), slug.MaxLength-len(suffix))], "-") + suffix
+		}
+
+		taken, err := ps.postMapper.IsSlugTaken(c, candidate, postID)
+		if err != nil {
+			return "", fmt.Errorf("failed to check slug: %w", err)
+		}
+		if !taken {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("failed to generate unique slug for '%s'", title)
+}
+
+// slugConflict 包装文章写入错误，别名唯一索引冲突（并发写入同一别名）时返回与 resolveSlug 一致的别名已存在错误
+func slugConflict(err error, postSlug, msg string) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return fmt.Errorf("slug '%s' already exists", postSlug)
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// toPostItems 将文章列表转换为列表项，批量加载标签、作者与表态数量
+func (ps *PostServiceImpl) toPostItems(c *app.RequestContext, posts []*post.Post) ([]*vo.PostItem, error) {
+	postTags, err := ps.listTagsByPosts(c, posts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list post tags: %w", err)
+	}
+
+	authors, err := ps.listAuthorsByPosts(c, posts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list post authors: %w", err)
+	}
+
+	imageSets, err := ps.listImageSetsByPosts(c, posts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list post image variants: %w", err)
+	}
+
+	postIDs := make([]int64, 0, len(posts))
+	for _, p := range posts {
+		postIDs = append(postIDs, p.ID)
+	}
+	reactionCounts, err := ps.reactionMapper.CountReactionsByPostIDs(c, postIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count post reactions: %w", err)
+	}
+
+	postItems := make([]*vo.PostItem, 0, len(posts))
+	for _, post := range posts {
+		var categoryIDStr, categoryName string
+		if post.CategoryID != nil {
+			if category, err := ps.categoryMapper.GetCategoryByID(c, *post.CategoryID); err == nil && category.IsActive {
+				categoryIDStr = strconv.FormatInt(*post.CategoryID, 10)
+				categoryName = category.Name
+			}
+		}
+
+		authorID, authorNickname, authorAvatar := authorInfo(post.AuthorID, authors)
+
+		postItems = append(postItems, &vo.PostItem{
+			ID:             strconv.FormatInt(post.ID, 10),
+			Title:          post.Title,
+			Slug:           post.Slug,
+			Description:    post.Description,
+			Image:          post.Image,
+			ImageSet:       imageSets[post.Image],
+			Status:         post.Status,
+			CategoryID:     categoryIDStr,
+			CategoryName:   categoryName,
+			AuthorID:       authorID,
+			AuthorNickname: authorNickname,
+			AuthorAvatar:   authorAvatar,
+			PublishAt:      formatPublishAt(post.PublishAt),
+			Tags:           toPostTagItems(postTags[post.ID]),
+			CreatedAt:      time.Unix(post.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+			UpdatedAt:      time.Unix(post.GmtModified, 0).Format("2006-01-02 15:04:05"),
+
+			PinScope:    activePinScope(post),
+			PinExpireAt: formatPinExpireAt(post),
+			Featured:    post.Featured,
+			Views:       post.ViewCount,
+
+			Reactions: toReactionCounts(postReactionTypes(post), reactionCounts[post.ID]),
+		})
+	}
+
+	return postItems, nil
+}
+
+// listAuthorsByPosts 批量获取文章作者，返回以用户 ID 为键的映射
+func (ps *PostServiceImpl) listAuthorsByPosts(c *app.RequestContext, posts []*post.Post) (map[int64]*user.User, error) {
+	seen := make(map[int64]bool, len(posts))
+	authorIDs := make([]int64, 0, len(posts))
+	for _, p := range posts {
+		if p.AuthorID == nil || seen[*p.AuthorID] {
+			continue
+		}
+		seen[*p.AuthorID] = true
+		authorIDs = append(authorIDs, *p.AuthorID)
+	}
+
+	users, err := ps.userMapper.ListUsersByIDs(c, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	authors := make(map[int64]*user.User, len(users))
+	for _, u := range users {
+		authors[u.ID] = u
+	}
+	return authors, nil
+}
+
+// listImageSetsByPosts 批量获取文章封面图片的响应式尺寸集合，返回以图片地址为键的映射，封面不是媒体库图片时不包含
+func (ps *PostServiceImpl) listImageSetsByPosts(c *app.RequestContext, posts []*post.Post) (map[string]*vo.ImageSet, error) {
+	urls := make([]string, 0, len(posts))
+	for _, p := range posts {
+		if p.Image != "" && !slices.Contains(urls, p.Image) {
+			urls = append(urls, p.Image)
+		}
+	}
+
+	items, err := ps.mediaMapper.ListMediaByURLs(c, urls)
+	if err != nil {
+		return nil, err
+	}
+
+	imageSets := make(map[string]*vo.ImageSet, len(items))
+	for _, item := range items {
+		if set := toImageSet(item); set != nil {
+			imageSets[item.URL] = set
+		}
+	}
+	return imageSets, nil
+}
+
+// authorInfo 获取作者 ID、昵称与头像，作者未知或已删除时昵称与头像为空
+func authorInfo(authorID *int64, authors map[int64]*user.User) (string, string, string) {
+	if authorID == nil {
+		return "", "", ""
+	}
+	id := strconv.FormatInt(*authorID, 10)
+	if u, ok := authors[*authorID]; ok {
+		return id, u.Nickname, u.Avatar
+	}
+	return id, "", ""
+}
+
+// listTagsByPosts 批量获取文章列表关联的标签
+func (ps *PostServiceImpl) listTagsByPosts(c *app.RequestContext, posts []*post.Post) (map[int64][]*tag.Tag, error) {
+	postIDs := make([]int64, 0, len(posts))
+	for _, p := range posts {
+		postIDs = append(postIDs, p.ID)
+	}
+	return ps.tagMapper.ListTagsByPostIDs(c, postIDs)
+}
+
+// toPostTagItems 将标签模型转换为文章标签值对象
+func toPostTagItems(tags []*tag.Tag) []*vo.PostTagItem {
+	items := make([]*vo.PostTagItem, 0, len(tags))
+	for _, t := range tags {
+		items = append(items, &vo.PostTagItem{
+			ID:   strconv.FormatInt(t.ID, 10),
+			Name: t.Name,
+		})
+	}
+	return items
+}
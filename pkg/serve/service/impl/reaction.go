@@ -0,0 +1,385 @@
+// Package impl 文章表态服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/reaction"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/internal/utils/pageview"
+	reactionUtils "github.com/Done-0/jank/internal/utils/reaction"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// reactionRateScript 计数加一并在计数键未设置过期时间时设置统计窗口，两步原子执行，避免设置过期前中断导致计数键永不过期
+var reactionRateScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if redis.call("PTTL", KEYS[1]) == -1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// ReactionServiceImpl 文章表态服务实现
+type ReactionServiceImpl struct {
+	reactionMapper mapper.ReactionMapper
+	postMapper     mapper.PostMapper
+	rbacMapper     mapper.RBACMapper
+}
+
+// NewReactionService 创建文章表态服务实例
+func NewReactionService(reactionMapperImpl mapper.ReactionMapper, postMapperImpl mapper.PostMapper, rbacMapperImpl mapper.RBACMapper) service.ReactionService {
+	return &ReactionServiceImpl{
+		reactionMapper: reactionMapperImpl,
+		postMapper:     postMapperImpl,
+		rbacMapper:     rbacMapperImpl,
+	}
+}
+
+// Toggle 切换文章表态，未表态时添加，已表态时取消；仅已发布与已归档文章可表态
+// 登录用户按账号去重，匿名访客按客户端指纹去重（未提供时按 IP 与 User-Agent），同一用户或 IP 的表态次数受限流控制
+func (rs *ReactionServiceImpl) Toggle(c *app.RequestContext, req *dto.ToggleReactionRequest) (*vo.ToggleReactionResponse, error) {
+	p, err := rs.getReactablePost(c, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	types := postReactionTypes(p)
+	if !slices.ContainsFunc(types, func(t configs.ReactionTypeConfig) bool { return t.Key == req.Type }) {
+		logger.BizLogger(c).Warnf("reaction type %s is not enabled for post %d", req.Type, p.ID)
+		return nil, fmt.Errorf("invalid reaction type: %s", req.Type)
+	}
+
+	if err := checkReactionRate(c); err != nil {
+		return nil, err
+	}
+
+	reactor, err := currentReactor(c, req.Fingerprint)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to resolve reactor: %v", err)
+		return nil, err
+	}
+
+	reacted := false
+	existing, err := rs.reactionMapper.GetReaction(c, p.ID, req.Type, reactor)
+	switch {
+	case err == nil:
+		if err := rs.reactionMapper.DeleteReaction(c, existing.ID); err != nil {
+			logger.BizLogger(c).Errorf("failed to remove %s reaction on post %d: %v", req.Type, p.ID, err)
+			return nil, fmt.Errorf("failed to remove reaction: %w", err)
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if err := rs.reactionMapper.CreateReaction(c, &reaction.Reaction{PostID: p.ID, Type: req.Type, Reactor: reactor}); err != nil {
+			logger.BizLogger(c).Errorf("failed to add %s reaction on post %d: %v", req.Type, p.ID, err)
+			return nil, fmt.Errorf("failed to add reaction: %w", err)
+		}
+		reacted = true
+	default:
+		logger.BizLogger(c).Errorf("failed to get %s reaction on post %d: %v", req.Type, p.ID, err)
+		return nil, fmt.Errorf("failed to get reaction: %w", err)
+	}
+
+	counts, err := rs.reactionMapper.CountReactionsByPostIDs(c, []int64{p.ID})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count reactions of post %d: %v", p.ID, err)
+		return nil, fmt.Errorf("failed to count reactions: %w", err)
+	}
+
+	return &vo.ToggleReactionResponse{
+		PostID:    strconv.FormatInt(p.ID, 10),
+		Type:      req.Type,
+		Reacted:   reacted,
+		Reactions: toReactionCounts(types, counts[p.ID]),
+	}, nil
+}
+
+// GetReactions 获取文章表态数量及当前用户或访客已表态的类型
+func (rs *ReactionServiceImpl) GetReactions(c *app.RequestContext, req *dto.GetReactionsRequest) (*vo.GetReactionsResponse, error) {
+	p, err := rs.getReactablePost(c, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := rs.reactionMapper.CountReactionsByPostIDs(c, []int64{p.ID})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count reactions of post %d: %v", p.ID, err)
+		return nil, fmt.Errorf("failed to count reactions: %w", err)
+	}
+
+	reactor, err := currentReactor(c, req.Fingerprint)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to resolve reactor: %v", err)
+		return nil, err
+	}
+	reactedTypes, err := rs.reactionMapper.ListReactorTypes(c, p.ID, reactor)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list reactions of post %d by current reactor: %v", p.ID, err)
+		return nil, fmt.Errorf("failed to list reacted types: %w", err)
+	}
+
+	// 仅返回文章当前启用的类型，按配置顺序排列
+	types := postReactionTypes(p)
+	reacted := make([]string, 0, len(reactedTypes))
+	for _, t := range types {
+		if slices.Contains(reactedTypes, t.Key) {
+			reacted = append(reacted, t.Key)
+		}
+	}
+
+	return &vo.GetReactionsResponse{
+		PostID:    strconv.FormatInt(p.ID, 10),
+		Reactions: toReactionCounts(types, counts[p.ID]),
+		Reacted:   reacted,
+	}, nil
+}
+
+// ListTypes 获取已配置的表态类型
+func (rs *ReactionServiceImpl) ListTypes(c *app.RequestContext) (*vo.ListReactionTypesResponse, error) {
+	types := reactionTypes()
+	response := &vo.ListReactionTypesResponse{List: make([]*vo.ReactionTypeItem, 0, len(types))}
+	for _, t := range types {
+		response.List = append(response.List, &vo.ReactionTypeItem{Type: t.Key, Emoji: t.Emoji})
+	}
+	return response, nil
+}
+
+// Report 获取表态最多的已发布文章报表，需具备查看表态报表权限
+func (rs *ReactionServiceImpl) Report(c *app.RequestContext, req *dto.ReactionReportRequest) (*vo.ReactionReportResponse, error) {
+	if err := rs.checkReportPermission(c); err != nil {
+		return nil, err
+	}
+
+	var since int64
+	if req.Days > 0 {
+		since = pageview.DayStart(time.Now().AddDate(0, 0, 1-int(req.Days)))
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = consts.DefaultReactionReportLimit
+	}
+
+	stats, err := rs.reactionMapper.ListMostReactedPosts(c, since, req.Type, limit)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list most reacted posts since %d: %v", since, err)
+		return nil, fmt.Errorf("failed to list most reacted posts: %w", err)
+	}
+
+	postIDs := make([]int64, 0, len(stats))
+	for _, stat := range stats {
+		postIDs = append(postIDs, stat.PostID)
+	}
+	posts, err := rs.postMapper.ListPostsByIDs(c, postIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get most reacted posts: %v", err)
+		return nil, fmt.Errorf("failed to get posts: %w", err)
+	}
+	postMap := make(map[int64]*post.Post, len(posts))
+	for _, p := range posts {
+		postMap[p.ID] = p
+	}
+
+	counts, err := rs.reactionMapper.CountReactionsByPostIDs(c, postIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count reactions of most reacted posts: %v", err)
+		return nil, fmt.Errorf("failed to count reactions: %w", err)
+	}
+
+	// 按统计周期内的表态数量顺序组装结果，明细包含全部已配置类型，文章已停用的类型同样计入
+	types := reactionTypes()
+	response := &vo.ReactionReportResponse{List: make([]*vo.ReactedPostItem, 0, len(stats))}
+	for _, stat := range stats {
+		p, ok := postMap[stat.PostID]
+		if !ok {
+			continue
+		}
+		response.List = append(response.List, &vo.ReactedPostItem{
+			ID:          strconv.FormatInt(p.ID, 10),
+			Title:       p.Title,
+			Slug:        p.Slug,
+			PublishAt:   formatPublishAt(p.PublishAt),
+			PeriodCount: stat.Count,
+			Reactions:   toReactionCounts(types, counts[p.ID]),
+		})
+	}
+
+	return response, nil
+}
+
+// getReactablePost 获取可表态的文章，文章不存在或不公开时返回记录不存在错误
+func (rs *ReactionServiceImpl) getReactablePost(c *app.RequestContext, rawID string) (*post.Post, error) {
+	postID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", rawID)
+		return nil, fmt.Errorf("invalid post ID format: %w", err)
+	}
+
+	p, err := rs.postMapper.GetPostByID(c, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get post with ID %d: %v", postID, err)
+		return nil, fmt.Errorf("failed to get post: %w", err)
+	}
+	if !isPublicPost(p) {
+		logger.BizLogger(c).Warnf("reaction request for non-public post %d with status %s", p.ID, p.Status)
+		return nil, fmt.Errorf("post not found: %w", gorm.ErrRecordNotFound)
+	}
+	return p, nil
+}
+
+// checkReportPermission 检查当前用户是否具备查看表态报表权限
+func (rs *ReactionServiceImpl) checkReportPermission(c *app.RequestContext) error {
+	userID := currentUserID(c)
+	if userID == nil {
+		return fmt.Errorf("permission denied: %s", consts.ReactionPermissionReport)
+	}
+	allowed, err := rs.rbacMapper.CheckPermission(c, strconv.FormatInt(*userID, 10), consts.ReactionPermissionReport, consts.ReactionPermissionReportAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check reaction report permission for user %d: %v", *userID, err)
+		return fmt.Errorf("failed to check permission: %w", err)
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user %d is not allowed to view reaction report", *userID)
+		return fmt.Errorf("permission denied: %s", consts.ReactionPermissionReport)
+	}
+	return nil
+}
+
+// currentReactor 获取当前请求的表态者标识，登录用户按账号标识，匿名访客以 JWT 密钥对客户端指纹（或 IP 与 User-Agent）做 HMAC
+func currentReactor(c *app.RequestContext, fingerprint string) (string, error) {
+	if userID := currentUserID(c); userID != nil {
+		return reactionUtils.UserReactor(*userID), nil
+	}
+
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		return "", fmt.Errorf("failed to get config: %w", err)
+	}
+	return reactionUtils.AnonymousReactor(cfgs.AppConfig.JWT.Secret, fingerprint, c.ClientIP(), string(c.UserAgent())), nil
+}
+
+// checkReactionRate 检查当前用户（匿名访客按 IP）在限流窗口内的表态次数，超出时返回错误；
+// 客户端指纹可由访客随意更换，因此不作为限流依据；Redis 不可用时不限流
+func checkReactionRate(c *app.RequestContext) error {
+	if global.RedisClient == nil {
+		return nil
+	}
+
+	limit, window := consts.DefaultReactionRateLimit, consts.DefaultReactionRateWindow
+	subject := ""
+	if cfgs, err := configs.GetConfig(); err == nil {
+		if cfgs.ReactionConfig.RateLimit > 0 {
+			limit = cfgs.ReactionConfig.RateLimit
+		}
+		if cfgs.ReactionConfig.RateWindowSeconds > 0 {
+			window = time.Duration(cfgs.ReactionConfig.RateWindowSeconds) * time.Second
+		}
+		subject = reactionUtils.AnonymousReactor(cfgs.AppConfig.JWT.Secret, "", c.ClientIP(), "")
+	}
+	if userID := currentUserID(c); userID != nil {
+		subject = reactionUtils.UserReactor(*userID)
+	}
+
+	ctx := context.Background()
+	key := fmt.Sprintf("%s:%s", consts.ReactionRateKeyPrefix, subject)
+	count, err := reactionRateScript.Run(ctx, global.RedisClient, []string{key}, window.Milliseconds()).Int64()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count reactions of %s: %v", subject, err)
+		return fmt.Errorf("failed to check reaction rate: %w", err)
+	}
+	if count > int64(limit) {
+		logger.BizLogger(c).Warnf("reaction rate limit exceeded by %s", subject)
+		return fmt.Errorf("too many reactions: at most %d per %d seconds", limit, int(window.Seconds()))
+	}
+	return nil
+}
+
+// reactionTypes 获取已配置的表态类型，忽略标识为空、过长或重复的类型
+func reactionTypes() []configs.ReactionTypeConfig {
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		return nil
+	}
+
+	types := make([]configs.ReactionTypeConfig, 0, len(cfgs.ReactionConfig.Types))
+	for _, t := range cfgs.ReactionConfig.Types {
+		if t.Key == "" || len(t.Key) > consts.ReactionTypeKeyMaxLength {
+			continue
+		}
+		if slices.ContainsFunc(types, func(existing configs.ReactionTypeConfig) bool { return existing.Key == t.Key }) {
+			continue
+		}
+		types = append(types, t)
+	}
+	return types
+}
+
+// postReactionTypes 获取文章启用的表态类型，按配置顺序排列
+func postReactionTypes(p *post.Post) []configs.ReactionTypeConfig {
+	types := reactionTypes()
+	selected, _ := reactionUtils.DecodeTypes(p.Ext[consts.PostExtReactionTypes])
+	if len(selected) == 0 {
+		return types
+	}
+
+	keys := make([]string, 0, len(types))
+	for _, t := range types {
+		keys = append(keys, t.Key)
+	}
+	enabled := reactionUtils.EnabledTypes(keys, selected)
+	return slices.DeleteFunc(types, func(t configs.ReactionTypeConfig) bool { return !slices.Contains(enabled, t.Key) })
+}
+
+// postSelectedReactionTypes 获取文章选择的表态类型，未选择时返回空列表
+func postSelectedReactionTypes(p *post.Post) []string {
+	selected, _ := reactionUtils.DecodeTypes(p.Ext[consts.PostExtReactionTypes])
+	if selected == nil {
+		return []string{}
+	}
+	return selected
+}
+
+// applyReactionTypes 校验并保存文章选择的表态类型，为空时移除选择（启用全部已配置类型）
+func applyReactionTypes(p *post.Post, selected []string) error {
+	types := reactionTypes()
+	for _, s := range selected {
+		if !slices.ContainsFunc(types, func(t configs.ReactionTypeConfig) bool { return t.Key == s }) {
+			return fmt.Errorf("invalid reaction type: %s", s)
+		}
+	}
+
+	if p.Ext == nil {
+		p.Ext = make(map[string]any)
+	}
+	if len(selected) == 0 {
+		delete(p.Ext, consts.PostExtReactionTypes)
+		return nil
+	}
+	p.Ext[consts.PostExtReactionTypes] = selected
+	return nil
+}
+
+// toReactionCounts 将文章启用的表态类型与统计数量组装为表态值对象，未被表态的类型数量为 0
+func toReactionCounts(types []configs.ReactionTypeConfig, counts map[string]int64) []*vo.ReactionCount {
+	items := make([]*vo.ReactionCount, 0, len(types))
+	for _, t := range types {
+		items = append(items, &vo.ReactionCount{Type: t.Key, Emoji: t.Emoji, Count: counts[t.Key]})
+	}
+	return items
+}
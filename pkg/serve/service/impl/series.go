@@ -0,0 +1,323 @@
+// Package impl 文章系列服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/series"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// SeriesServiceImpl 文章系列服务实现
+type SeriesServiceImpl struct {
+	seriesMapper mapper.SeriesMapper
+	postMapper   mapper.PostMapper
+}
+
+// NewSeriesService 创建文章系列服务实例
+func NewSeriesService(seriesMapperImpl mapper.SeriesMapper, postMapperImpl mapper.PostMapper) service.SeriesService {
+	return &SeriesServiceImpl{
+		seriesMapper: seriesMapperImpl,
+		postMapper:   postMapperImpl,
+	}
+}
+
+// GetSeries 获取单个系列及其公开文章
+func (ss *SeriesServiceImpl) GetSeries(c *app.RequestContext, req *dto.GetSeriesRequest) (*vo.GetSeriesResponse, error) {
+	seriesID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid series ID format: %s", req.ID)
+		return nil, fmt.Errorf("invalid series ID format: %w", err)
+	}
+
+	s, err := ss.seriesMapper.GetSeriesByID(c, seriesID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get series with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+
+	parts, err := listSeriesParts(c, ss.seriesMapper, ss.postMapper, s.ID, isPublicPost)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list posts of series %s: %v", req.ID, err)
+		return nil, err
+	}
+
+	posts := make([]*vo.SeriesPostItem, 0, len(parts))
+	for i, p := range parts {
+		posts = append(posts, toSeriesPostItem(p, i))
+	}
+
+	return &vo.GetSeriesResponse{
+		ID:          strconv.FormatInt(s.ID, 10),
+		Name:        s.Name,
+		Description: s.Description,
+		Posts:       posts,
+		CreatedAt:   time.Unix(s.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+		UpdatedAt:   time.Unix(s.GmtModified, 0).Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+// ListSeries 获取系列列表
+func (ss *SeriesServiceImpl) ListSeries(c *app.RequestContext, req *dto.ListSeriesRequest) (*vo.ListSeriesResponse, error) {
+	list, total, err := ss.seriesMapper.ListSeries(c, req.PageNo, req.PageSize, req.Keyword)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list series: %v", err)
+		return nil, fmt.Errorf("failed to list series: %w", err)
+	}
+
+	seriesIDs := make([]int64, 0, len(list))
+	for _, s := range list {
+		seriesIDs = append(seriesIDs, s.ID)
+	}
+	counts, err := ss.seriesMapper.CountSeriesPosts(c, seriesIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count series posts: %v", err)
+		return nil, fmt.Errorf("failed to count series posts: %w", err)
+	}
+
+	items := make([]*vo.SeriesItem, 0, len(list))
+	for _, s := range list {
+		items = append(items, &vo.SeriesItem{
+			ID:          strconv.FormatInt(s.ID, 10),
+			Name:        s.Name,
+			Description: s.Description,
+			PostCount:   counts[s.ID],
+			CreatedAt:   time.Unix(s.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+			UpdatedAt:   time.Unix(s.GmtModified, 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &vo.ListSeriesResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     items,
+	}, nil
+}
+
+// Create 创建系列
+func (ss *SeriesServiceImpl) Create(c *app.RequestContext, req *dto.CreateSeriesRequest) (*vo.CreateSeriesResponse, error) {
+	if _, err := ss.seriesMapper.GetSeriesByName(c, req.Name); err == nil {
+		logger.BizLogger(c).Errorf("series '%s' already exists", req.Name)
+		return nil, fmt.Errorf("series '%s' already exists", req.Name)
+	}
+
+	postIDs, err := ss.parsePostIDs(c, req.PostIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid posts for series '%s': %v", req.Name, err)
+		return nil, err
+	}
+
+	s := &series.Series{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		if err := ss.seriesMapper.CreateSeries(c, s); err != nil {
+			return nil, fmt.Errorf("failed to create series: %w", err)
+		}
+		if err := ss.seriesMapper.SetSeriesPosts(c, s.ID, postIDs); err != nil {
+			return nil, fmt.Errorf("failed to set series posts: %w", err)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to create series '%s': %v", req.Name, err)
+		return nil, err
+	}
+
+	logger.BizLogger(c).Infof("series created successfully with ID: %d", s.ID)
+
+	return &vo.CreateSeriesResponse{
+		ID:          strconv.FormatInt(s.ID, 10),
+		Name:        s.Name,
+		Description: s.Description,
+		PostCount:   int64(len(postIDs)),
+		Message:     "Series created successfully",
+	}, nil
+}
+
+// Update 更新系列
+func (ss *SeriesServiceImpl) Update(c *app.RequestContext, req *dto.UpdateSeriesRequest) (*vo.UpdateSeriesResponse, error) {
+	seriesID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid series ID format: %s", req.ID)
+		return nil, fmt.Errorf("invalid series ID format: %w", err)
+	}
+
+	s, err := ss.seriesMapper.GetSeriesByID(c, seriesID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get series with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+
+	if req.Name != "" && req.Name != s.Name {
+		if existing, err := ss.seriesMapper.GetSeriesByName(c, req.Name); err == nil && existing.ID != s.ID {
+			logger.BizLogger(c).Errorf("series '%s' already exists", req.Name)
+			return nil, fmt.Errorf("series '%s' already exists", req.Name)
+		}
+		s.Name = req.Name
+	}
+	if req.Description != "" {
+		s.Description = req.Description
+	}
+
+	var postIDs []int64
+	if req.PostIDs != nil {
+		if postIDs, err = ss.parsePostIDs(c, req.PostIDs); err != nil {
+			logger.BizLogger(c).Errorf("invalid posts for series %s: %v", req.ID, err)
+			return nil, err
+		}
+	}
+
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		if err := ss.seriesMapper.UpdateSeries(c, s); err != nil {
+			return nil, fmt.Errorf("failed to update series: %w", err)
+		}
+		if req.PostIDs != nil {
+			if err := ss.seriesMapper.SetSeriesPosts(c, s.ID, postIDs); err != nil {
+				return nil, fmt.Errorf("failed to set series posts: %w", err)
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to update series with ID %s: %v", req.ID, err)
+		return nil, err
+	}
+
+	counts, err := ss.seriesMapper.CountSeriesPosts(c, []int64{s.ID})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count posts of series %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to count series posts: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("series updated successfully with ID: %s", req.ID)
+
+	return &vo.UpdateSeriesResponse{
+		ID:          strconv.FormatInt(s.ID, 10),
+		Name:        s.Name,
+		Description: s.Description,
+		PostCount:   counts[s.ID],
+		Message:     "Series updated successfully",
+	}, nil
+}
+
+// Delete 删除系列，系列中的文章保留
+func (ss *SeriesServiceImpl) Delete(c *app.RequestContext, req *dto.DeleteSeriesRequest) (*vo.DeleteSeriesResponse, error) {
+	seriesID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid series ID format: %s", req.ID)
+		return nil, fmt.Errorf("invalid series ID format: %w", err)
+	}
+
+	if _, err := ss.seriesMapper.GetSeriesByID(c, seriesID); err != nil {
+		logger.BizLogger(c).Errorf("series with ID %s not found: %v", req.ID, err)
+		return nil, fmt.Errorf("series not found: %w", err)
+	}
+
+	if err := ss.seriesMapper.DeleteSeries(c, seriesID); err != nil {
+		logger.BizLogger(c).Errorf("failed to delete series with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to delete series: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("series deleted successfully with ID: %s", req.ID)
+
+	return &vo.DeleteSeriesResponse{
+		Message: "Series deleted successfully",
+	}, nil
+}
+
+// parsePostIDs 解析并校验系列的文章 ID 列表，拒绝重复或不存在的文章
+func (ss *SeriesServiceImpl) parsePostIDs(c *app.RequestContext, ids []string) ([]int64, error) {
+	postIDs := make([]int64, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		postID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid post ID format: %s", id)
+		}
+		if seen[postID] {
+			return nil, fmt.Errorf("duplicate post ID: %s", id)
+		}
+		seen[postID] = true
+		postIDs = append(postIDs, postID)
+	}
+
+	posts, err := ss.postMapper.ListPostsByIDs(c, postIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get posts: %w", err)
+	}
+	found := make(map[int64]bool, len(posts))
+	for _, p := range posts {
+		found[p.ID] = true
+	}
+	for _, postID := range postIDs {
+		if !found[postID] {
+			return nil, fmt.Errorf("post %d not found", postID)
+		}
+	}
+	return postIDs, nil
+}
+
+// listSeriesParts 按系列顺序获取系列中的文章，跳过已删除及 include 返回 false 的文章
+// 参数：
+//   - c: 请求上下文
+//   - seriesMapper: 系列数据访问
+//   - postMapper: 文章数据访问
+//   - seriesID: 系列 ID
+//   - include: 文章筛选条件
+//
+// 返回值：
+//   - []*post.Post: 按系列顺序排列的文章
+//   - error: 查询失败时返回错误
+func listSeriesParts(c *app.RequestContext, seriesMapper mapper.SeriesMapper, postMapper mapper.PostMapper, seriesID int64, include func(*post.Post) bool) ([]*post.Post, error) {
+	seriesPosts, err := seriesMapper.ListSeriesPosts(c, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get series posts: %w", err)
+	}
+
+	postIDs := make([]int64, 0, len(seriesPosts))
+	for _, sp := range seriesPosts {
+		postIDs = append(postIDs, sp.PostID)
+	}
+	posts, err := postMapper.ListPostsByIDs(c, postIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get posts: %w", err)
+	}
+	postMap := make(map[int64]*post.Post, len(posts))
+	for _, p := range posts {
+		postMap[p.ID] = p
+	}
+
+	parts := make([]*post.Post, 0, len(seriesPosts))
+	for _, sp := range seriesPosts {
+		if p, ok := postMap[sp.PostID]; ok && include(p) {
+			parts = append(parts, p)
+		}
+	}
+	return parts, nil
+}
+
+// toSeriesPostItem 组装系列文章项
+func toSeriesPostItem(p *post.Post, index int) *vo.SeriesPostItem {
+	return &vo.SeriesPostItem{
+		ID:       strconv.FormatInt(p.ID, 10),
+		Title:    p.Title,
+		Slug:     p.Slug,
+		Position: index + 1,
+	}
+}
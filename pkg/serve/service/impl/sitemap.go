@@ -0,0 +1,161 @@
+// Package impl 站点地图服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/model/category"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/internal/utils/site"
+	"github.com/Done-0/jank/internal/utils/sitemap"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// SitemapServiceImpl 站点地图服务实现
+type SitemapServiceImpl struct {
+	postMapper     mapper.PostMapper
+	categoryMapper mapper.CategoryMapper
+}
+
+// NewSitemapService 创建站点地图服务实例
+func NewSitemapService(postMapperImpl mapper.PostMapper, categoryMapperImpl mapper.CategoryMapper) service.SitemapService {
+	return &SitemapServiceImpl{
+		postMapper:     postMapperImpl,
+		categoryMapper: categoryMapperImpl,
+	}
+}
+
+// GetSitemap 生成站点地图，链接依次为首页、启用的分类与已发布文章，超过单文件上限时生成站点地图索引
+func (ss *SitemapServiceImpl) GetSitemap(c *app.RequestContext, req *dto.GetSitemapRequest) (*vo.SitemapResponse, error) {
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	siteConfig := cfgs.SiteConfig
+
+	isActive := true
+	categories, _, err := ss.categoryMapper.ListCategories(c, 1, sitemap.MaxURLs, nil, &isActive)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list categories for sitemap: %v", err)
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	postCount, err := ss.postMapper.CountPublishedPostsForSitemap(c)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count posts for sitemap: %v", err)
+		return nil, fmt.Errorf("failed to count posts: %w", err)
+	}
+
+	latest, err := ss.postMapper.GetLatestModifiedTime(c)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get latest post modified time: %v", err)
+		return nil, fmt.Errorf("failed to get latest modified time: %w", err)
+	}
+	for _, cat := range categories {
+		latest = max(latest, cat.GmtModified)
+	}
+	var lastModified time.Time
+	if latest > 0 {
+		lastModified = time.Unix(latest, 0)
+	}
+
+	fixed := int64(1 + len(categories)) // 首页与分类页
+	total := fixed + postCount
+	pages := (total + sitemap.MaxURLs - 1) / sitemap.MaxURLs
+
+	var content []byte
+	switch {
+	case req.Page == 0 && pages > 1:
+		locs := make([]string, 0, pages)
+		for page := int64(1); page <= pages; page++ {
+			locs = append(locs, site.AbsoluteURL(siteConfig, fmt.Sprintf("/sitemap.xml?page=%d", page)))
+		}
+		content, err = sitemap.RenderIndex(locs, lastModified)
+	case req.Page > pages:
+		return nil, fmt.Errorf("sitemap page %d not found: %w", req.Page, gorm.ErrRecordNotFound)
+	default:
+		content, err = ss.renderPage(c, siteConfig, max(req.Page, 1), categories, fixed, total, lastModified)
+	}
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to render sitemap page %d: %v", req.Page, err)
+		return nil, fmt.Errorf("failed to render sitemap: %w", err)
+	}
+
+	sum := sha256.Sum256(content)
+	return &vo.SitemapResponse{
+		Content:      content,
+		ContentType:  sitemap.ContentType,
+		ETag:         `"` + hex.EncodeToString(sum[:16]) + `"`,
+		LastModified: lastModified,
+	}, nil
+}
+
+// GetRobots 生成 robots.txt，未配置自定义内容时根据允许、禁止路径生成并附加站点地图地址
+func (ss *SitemapServiceImpl) GetRobots(c *app.RequestContext) (*vo.RobotsResponse, error) {
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	robotsConfig := cfgs.RobotsConfig
+	if strings.TrimSpace(robotsConfig.Content) != "" {
+		return &vo.RobotsResponse{Content: robotsConfig.Content}, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("User-agent: *\n")
+	for _, path := range robotsConfig.Allow {
+		sb.WriteString("Allow: " + path + "\n")
+	}
+	for _, path := range robotsConfig.Disallow {
+		sb.WriteString("Disallow: " + path + "\n")
+	}
+	sb.WriteString("\nSitemap: " + site.AbsoluteURL(cfgs.SiteConfig, "/sitemap.xml") + "\n")
+
+	return &vo.RobotsResponse{Content: sb.String()}, nil
+}
+
+// renderPage 生成指定页码的站点地图，链接序号依次为首页（0）、分类（1 ~ fixed-1）与文章
+func (ss *SitemapServiceImpl) renderPage(c *app.RequestContext, siteConfig configs.SiteConfig, page int64, categories []*category.Category, fixed, total int64, lastModified time.Time) ([]byte, error) {
+	start := (page - 1) * sitemap.MaxURLs
+	end := min(start+sitemap.MaxURLs, total)
+
+	urls := make([]*sitemap.URL, 0, end-start)
+	for i := start; i < min(end, fixed); i++ {
+		if i == 0 {
+			urls = append(urls, &sitemap.URL{Loc: site.AbsoluteURL(siteConfig, "/"), LastMod: lastModified, ChangeFreq: "daily", Priority: 1.0})
+			continue
+		}
+		cat := categories[i-1]
+		urls = append(urls, &sitemap.URL{Loc: site.CategoryURL(siteConfig, cat.ID), LastMod: time.Unix(cat.GmtModified, 0), ChangeFreq: "weekly", Priority: 0.6})
+	}
+
+	postStart := max(start-fixed, 0)
+	postEnd := end - fixed
+	if postEnd > postStart {
+		posts, err := ss.postMapper.ListPublishedPostsForSitemap(c, postStart, postEnd-postStart)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list posts: %w", err)
+		}
+		for _, p := range posts {
+			urls = append(urls, &sitemap.URL{Loc: site.PostURL(siteConfig, p.ID, p.Slug), LastMod: time.Unix(p.GmtModified, 0), ChangeFreq: "weekly", Priority: 0.8})
+		}
+	}
+
+	return sitemap.RenderURLSet(urls)
+}
@@ -0,0 +1,461 @@
+// Package impl 静态站点服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/storage"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/internal/utils/site"
+	"github.com/Done-0/jank/internal/utils/sitemap"
+	"github.com/Done-0/jank/internal/utils/staticsite"
+	resultvo "github.com/Done-0/jank/internal/utils/vo"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+
+	themeUtils "github.com/Done-0/jank/internal/utils/theme"
+)
+
+// staticFeeds 订阅源格式与站内路径，与订阅源路由一致
+var staticFeeds = []struct{ format, path string }{
+	{consts.FeedFormatRSS, "/feed.xml"},
+	{consts.FeedFormatAtom, "/atom.xml"},
+	{consts.FeedFormatJSON, "/feed.json"},
+}
+
+// StaticSiteServiceImpl 静态站点服务实现
+type StaticSiteServiceImpl struct {
+	postService     service.PostService
+	categoryService service.CategoryService
+	feedService     service.FeedService
+	sitemapService  service.SitemapService
+	mediaService    service.MediaService
+	themeService    *ThemeServiceImpl // 复用主题服务的模板加载与页面数据生成，使静态页面与服务端渲染结果一致
+}
+
+// staticBuild 单次生成过程的状态
+type staticBuild struct {
+	c         *app.RequestContext
+	cfgs      *configs.Config
+	output    string
+	index     []byte                // 主题首页，主题未提供首页文件时为 nil
+	templates *themeUtils.Templates // 主题声明的服务端渲染模板，未声明时为 nil
+	pageSize  int64                 // 首页与分类页每页文章数
+	variants  map[string]string     // 按需生成的图片变体地址 -> 存储地址
+	response  *vo.BuildStaticSiteResponse
+}
+
+// NewStaticSiteService 创建静态站点服务实例
+func NewStaticSiteService(postService service.PostService, categoryService service.CategoryService, feedService service.FeedService, sitemapService service.SitemapService, mediaService service.MediaService) service.StaticSiteService {
+	return &StaticSiteServiceImpl{
+		postService:     postService,
+		categoryService: categoryService,
+		feedService:     feedService,
+		sitemapService:  sitemapService,
+		mediaService:    mediaService,
+		themeService:    &ThemeServiceImpl{postService: postService, categoryService: categoryService},
+	}
+}
+
+// Build 使用当前前端主题将所有公开页面生成为静态站点
+// 主题声明了服务端渲染模板的页面按模板渲染，其余页面以主题首页为模板输出，主题读取的公开接口数据导出为 JSON 文件并由适配脚本提供，输出目录可直接部署到对象存储
+func (ss *StaticSiteServiceImpl) Build(c *app.RequestContext, req *dto.BuildStaticSiteRequest) (*vo.BuildStaticSiteResponse, error) {
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	activeTheme, templates, err := ss.themeService.frontendTemplates(c, "/")
+	if err != nil {
+		return nil, err
+	}
+
+	// 声明了模板的主题可不提供首页文件，此时未提供模板的页面无法生成
+	var index []byte
+	indexFile := strings.TrimPrefix(activeTheme.IndexFilePath, "/")
+	if indexFile != "" || templates == nil {
+		index, err = os.ReadFile(filepath.Join(activeTheme.Path, indexFile))
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to read index file of theme '%s': %v", activeTheme.ID, err)
+			return nil, fmt.Errorf("failed to read theme index file: %w", err)
+		}
+	}
+
+	if req.Clean {
+		if err := os.RemoveAll(req.OutputDir); err != nil {
+			return nil, fmt.Errorf("failed to clean output directory: %w", err)
+		}
+	}
+	if err := os.MkdirAll(req.OutputDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	b := &staticBuild{
+		c:         c,
+		cfgs:      cfgs,
+		output:    req.OutputDir,
+		index:     index,
+		templates: templates,
+		variants:  make(map[string]string),
+		response:  &vo.BuildStaticSiteResponse{OutputDir: req.OutputDir, Theme: activeTheme.ID},
+	}
+
+	// 主题静态资源按服务端的映射方式输出：首页所在目录对应站点根路径
+	if activeTheme.StaticDirPath != "" {
+		staticDir := strings.TrimPrefix(activeTheme.StaticDirPath, "/")
+		rel, err := filepath.Rel(filepath.Dir(indexFile), staticDir)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			rel = filepath.Base(staticDir)
+		}
+		count, err := staticsite.CopyDir(filepath.Join(activeTheme.Path, staticDir), filepath.Join(b.output, rel))
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to copy static assets of theme '%s': %v", activeTheme.ID, err)
+			return nil, fmt.Errorf("failed to copy theme assets: %w", err)
+		}
+		b.response.Files += int64(count)
+	}
+
+	// 本地存储的媒体文件由服务端提供访问，需一并输出
+	storageConfig := cfgs.StorageConfig
+	if (storageConfig.Driver == storage.DriverLocal || storageConfig.Driver == "") && strings.HasPrefix(storageConfig.Local.URLPrefix, "/") {
+		if _, err := os.Stat(storageConfig.Local.Root); err == nil {
+			count, err := staticsite.CopyDir(storageConfig.Local.Root, filepath.Join(b.output, filepath.FromSlash(storageConfig.Local.URLPrefix)))
+			if err != nil {
+				logger.BizLogger(c).Errorf("failed to copy local media: %v", err)
+				return nil, fmt.Errorf("failed to copy local media: %w", err)
+			}
+			b.response.Files += int64(count)
+		}
+	}
+
+	posts, err := ss.buildPosts(b)
+	if err != nil {
+		return nil, err
+	}
+
+	// 静态站点无法按 page 查询参数分页，首页与分类页在一页内列出全部文章
+	b.pageSize = max(int64(len(posts)), 1)
+
+	if err := ss.buildCategories(b, posts); err != nil {
+		return nil, err
+	}
+
+	if err := b.writePage("/", consts.ThemeTemplateIndex, func(string) (*vo.ThemePageData, error) {
+		return ss.themeService.indexPageData(c, cfgs.SiteConfig, b.pageSize)
+	}, &staticsite.PageMeta{
+		Title:       cfgs.SiteConfig.Title,
+		Description: cfgs.SiteConfig.Description,
+		Canonical:   site.AbsoluteURL(cfgs.SiteConfig, "/"),
+		Fallback:    postLinks(cfgs.SiteConfig, cfgs.SiteConfig.Title, posts),
+	}); err != nil {
+		return nil, err
+	}
+	if err := b.writePage("/404.html", consts.ThemeTemplateNotFound, func(requestPath string) (*vo.ThemePageData, error) {
+		return ss.themeService.notFoundPageData(c, cfgs.SiteConfig, requestPath)
+	}, &staticsite.PageMeta{Title: cfgs.SiteConfig.Title}); err != nil {
+		return nil, err
+	}
+
+	if err := ss.buildFeeds(b); err != nil {
+		return nil, err
+	}
+
+	if err := b.writeFile(staticsite.APIScriptPath, staticsite.APIScript); err != nil {
+		return nil, err
+	}
+
+	b.response.Message = fmt.Sprintf("generated %d pages (%d posts, %d categories) and %d files in total", b.response.Pages, b.response.Posts, b.response.Categories, b.response.Files)
+	logger.BizLogger(c).Infof("static site built to '%s' with theme '%s': %s", req.OutputDir, activeTheme.ID, b.response.Message)
+	return b.response, nil
+}
+
+// buildPosts 导出已发布文章列表与文章详情，并生成文章页面
+func (ss *StaticSiteServiceImpl) buildPosts(b *staticBuild) ([]*vo.PostItem, error) {
+	var posts []*vo.PostItem
+	for pageNo := int64(1); ; pageNo++ {
+		list, err := ss.postService.ListPublishedPosts(b.c, &dto.ListPublishedPostsRequest{PageNo: pageNo, PageSize: 100})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list published posts: %w", err)
+		}
+		posts = append(posts, list.List...)
+		if len(list.List) == 0 || int64(len(posts)) >= list.Total {
+			break
+		}
+	}
+
+	for _, item := range posts {
+		if err := ss.resolveImageSet(b, item.ImageSet); err != nil {
+			return nil, err
+		}
+	}
+	if err := b.writeAPI("/post/list-published", &vo.ListPostsResponse{Total: int64(len(posts)), PageNo: 1, PageSize: int64(len(posts)), List: posts}); err != nil {
+		return nil, err
+	}
+
+	for _, item := range posts {
+		detail, err := ss.postService.GetPost(b.c, &dto.GetPostRequest{ID: item.ID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get post %s: %w", item.ID, err)
+		}
+		if err := ss.resolveImageSet(b, detail.ImageSet); err != nil {
+			return nil, err
+		}
+
+		if err := b.writeAPI("/post/get/"+detail.ID, detail); err != nil {
+			return nil, err
+		}
+		if detail.Slug != "" {
+			if err := b.writeAPI("/post/get-by-slug/"+detail.Slug, detail); err != nil {
+				return nil, err
+			}
+		}
+
+		id, _ := strconv.ParseInt(detail.ID, 10, 64)
+		article, title := postSEO(b.cfgs.SiteConfig, detail)
+		if err := b.writePage(site.PostURL(b.cfgs.SiteConfig, id, detail.Slug), consts.ThemeTemplatePost, func(requestPath string) (*vo.ThemePageData, error) {
+			return ss.themeService.postPageData(b.c, b.cfgs.SiteConfig, requestPath, detail)
+		}, &staticsite.PageMeta{
+			Title:    title,
+			Head:     article.HeadHTML(),
+			Fallback: "<article><h1>" + html.EscapeString(detail.Title) + "</h1>" + detail.HTML + "</article>",
+		}); err != nil {
+			return nil, err
+		}
+		b.response.Posts++
+	}
+
+	return posts, nil
+}
+
+// buildCategories 导出分类列表与分类详情，并为启用的分类生成分类页面
+func (ss *StaticSiteServiceImpl) buildCategories(b *staticBuild, posts []*vo.PostItem) error {
+	var categories []*vo.CategoryItem
+	for pageNo := int64(1); ; pageNo++ {
+		list, err := ss.categoryService.ListCategories(b.c, &dto.ListCategoriesRequest{PageNo: pageNo, PageSize: 100})
+		if err != nil {
+			return fmt.Errorf("failed to list categories: %w", err)
+		}
+		categories = append(categories, list.List...)
+		if len(list.List) == 0 || int64(len(categories)) >= list.Total {
+			break
+		}
+	}
+
+	if err := b.writeAPI("/category/list", &vo.ListCategoriesResponse{Total: int64(len(categories)), PageNo: 1, PageSize: int64(len(categories)), List: categories}); err != nil {
+		return err
+	}
+
+	for _, item := range categories {
+		if !item.IsActive {
+			continue
+		}
+
+		detail, err := ss.categoryService.GetCategory(b.c, &dto.GetCategoryRequest{ID: item.ID})
+		if err != nil {
+			return fmt.Errorf("failed to get category %s: %w", item.ID, err)
+		}
+		if err := b.writeAPI("/category/get/"+detail.ID, detail); err != nil {
+			return err
+		}
+
+		var categoryPosts []*vo.PostItem
+		for _, p := range posts {
+			if p.CategoryID == item.ID {
+				categoryPosts = append(categoryPosts, p)
+			}
+		}
+
+		id, _ := strconv.ParseInt(item.ID, 10, 64)
+		link := site.CategoryURL(b.cfgs.SiteConfig, id)
+		if err := b.writePage(link, consts.ThemeTemplateCategory, func(requestPath string) (*vo.ThemePageData, error) {
+			return ss.themeService.categoryPageData(b.c, b.cfgs.SiteConfig, requestPath, item.ID, b.pageSize)
+		}, &staticsite.PageMeta{
+			Title:       item.Name + " - " + b.cfgs.SiteConfig.Title,
+			Description: item.Description,
+			Canonical:   link,
+			Fallback:    postLinks(b.cfgs.SiteConfig, item.Name, categoryPosts),
+		}); err != nil {
+			return err
+		}
+		b.response.Categories++
+	}
+
+	return nil
+}
+
+// buildFeeds 生成订阅源、站点地图与 robots.txt，分页站点地图输出为 sitemap-<页码>.xml 并生成索引
+func (ss *StaticSiteServiceImpl) buildFeeds(b *staticBuild) error {
+	for _, f := range staticFeeds {
+		b.c.Request.SetRequestURI(f.path)
+		feed, err := ss.feedService.GetFeed(b.c, &dto.GetFeedRequest{}, f.format)
+		if err != nil {
+			return fmt.Errorf("failed to generate %s feed: %w", f.format, err)
+		}
+		if err := b.writeFile(f.path, feed.Content); err != nil {
+			return err
+		}
+	}
+
+	var pages [][]byte
+	for page := int64(1); ; page++ {
+		s, err := ss.sitemapService.GetSitemap(b.c, &dto.GetSitemapRequest{Page: page})
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to generate sitemap page %d: %w", page, err)
+		}
+		pages = append(pages, s.Content)
+	}
+
+	switch len(pages) {
+	case 1:
+		if err := b.writeFile("/sitemap.xml", pages[0]); err != nil {
+			return err
+		}
+	default:
+		locs := make([]string, 0, len(pages))
+		for i, content := range pages {
+			name := fmt.Sprintf("/sitemap-%d.xml", i+1)
+			if err := b.writeFile(name, content); err != nil {
+				return err
+			}
+			locs = append(locs, site.AbsoluteURL(b.cfgs.SiteConfig, name))
+		}
+		content, err := sitemap.RenderIndex(locs, time.Time{})
+		if err != nil {
+			return fmt.Errorf("failed to render sitemap index: %w", err)
+		}
+		if err := b.writeFile("/sitemap.xml", content); err != nil {
+			return err
+		}
+	}
+
+	robots, err := ss.sitemapService.GetRobots(b.c)
+	if err != nil {
+		return fmt.Errorf("failed to generate robots.txt: %w", err)
+	}
+	return b.writeFile("/robots.txt", []byte(robots.Content))
+}
+
+// resolveImageSet 将尚未生成的图片变体（指向服务端按需生成路由）生成后替换为存储地址
+func (ss *StaticSiteServiceImpl) resolveImageSet(b *staticBuild, set *vo.ImageSet) error {
+	if set == nil {
+		return nil
+	}
+
+	prefix := consts.MediaVariantPathPrefix + "/"
+	for _, v := range set.Variants {
+		if !strings.HasPrefix(v.URL, prefix) {
+			continue
+		}
+
+		resolved, ok := b.variants[v.URL]
+		if !ok {
+			storageURL, err := ss.mediaService.ServeVariant(b.c, strings.TrimPrefix(v.URL, prefix))
+			if err != nil {
+				return fmt.Errorf("failed to generate image variant '%s': %w", v.URL, err)
+			}
+			resolved = storageURL
+			b.variants[v.URL] = resolved
+		}
+
+		for mimeType, srcset := range set.Srcset {
+			set.Srcset[mimeType] = strings.ReplaceAll(srcset, v.URL+" ", resolved+" ")
+		}
+		v.URL = resolved
+	}
+	return nil
+}
+
+// writeAPI 将接口响应数据按服务端响应格式写入 /api/v1<endpoint>.json
+func (b *staticBuild) writeAPI(endpoint string, data any) error {
+	content, err := json.Marshal(resultvo.Success(b.c, data))
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", endpoint, err)
+	}
+	return b.writeFile("/api/v1"+endpoint+".json", content)
+}
+
+// writePage 生成页面，link 为站内路径或站点绝对链接
+// 主题提供 page 页面模板时以 data 生成的数据渲染模板，否则以主题首页为模板并注入 meta
+func (b *staticBuild) writePage(link, page string, data func(requestPath string) (*vo.ThemePageData, error), meta *staticsite.PageMeta) error {
+	p := link
+	if u, err := url.Parse(link); err == nil && u.IsAbs() {
+		p = u.Path
+		if base, err := url.Parse(b.cfgs.SiteConfig.URL); err == nil {
+			p = strings.TrimPrefix(p, strings.TrimRight(base.Path, "/"))
+		}
+	}
+
+	file, err := staticsite.PagePath(p)
+	if err != nil {
+		return err
+	}
+
+	var content []byte
+	switch {
+	case b.templates.Has(page):
+		pageData, err := data(p)
+		if err != nil {
+			return err
+		}
+		if content, err = b.templates.Render(page, pageData); err != nil {
+			return fmt.Errorf("failed to render page '%s': %w", p, err)
+		}
+	case b.index != nil:
+		content = staticsite.RenderPage(b.index, meta)
+	default:
+		return fmt.Errorf("theme provides neither a %s template nor an index file", page)
+	}
+
+	if err := b.writeFile(file, content); err != nil {
+		return err
+	}
+	b.response.Pages++
+	return nil
+}
+
+// writeFile 写入输出目录中的文件，name 为以 / 分隔的站内路径
+func (b *staticBuild) writeFile(name string, content []byte) error {
+	target := filepath.Join(b.output, filepath.FromSlash(path.Clean("/"+name)))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for '%s': %w", name, err)
+	}
+	if err := os.WriteFile(target, content, 0o644); err != nil {
+		return fmt.Errorf("failed to write '%s': %w", name, err)
+	}
+	b.response.Files++
+	return nil
+}
+
+// postLinks 生成文章链接列表，作为未启用脚本时的页面内容
+func postLinks(siteConfig configs.SiteConfig, heading string, posts []*vo.PostItem) string {
+	var sb strings.Builder
+	sb.WriteString("<h1>" + html.EscapeString(heading) + "</h1><ul>")
+	for _, p := range posts {
+		id, _ := strconv.ParseInt(p.ID, 10, 64)
+		sb.WriteString(`<li><a href="` + html.EscapeString(site.PostURL(siteConfig, id, p.Slug)) + `">` + html.EscapeString(p.Title) + "</a></li>")
+	}
+	sb.WriteString("</ul>")
+	return sb.String()
+}
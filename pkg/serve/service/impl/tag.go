@@ -0,0 +1,219 @@
+// Package impl 标签服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package impl
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/model/tag"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// TagServiceImpl 标签服务实现
+type TagServiceImpl struct {
+	tagMapper mapper.TagMapper
+}
+
+// NewTagService 创建标签服务实例
+func NewTagService(tagMapperImpl mapper.TagMapper) service.TagService {
+	return &TagServiceImpl{
+		tagMapper: tagMapperImpl,
+	}
+}
+
+// GetTag 获取单个标签
+func (ts *TagServiceImpl) GetTag(c *app.RequestContext, req *dto.GetTagRequest) (*vo.GetTagResponse, error) {
+	tagID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid tag ID format: %s", req.ID)
+		return nil, fmt.Errorf("invalid tag ID format: %w", err)
+	}
+
+	t, err := ts.tagMapper.GetTagByID(c, tagID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get tag with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	return &vo.GetTagResponse{
+		ID:          strconv.FormatInt(t.ID, 10),
+		Name:        t.Name,
+		Description: t.Description,
+		CreatedAt:   time.Unix(t.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+		UpdatedAt:   time.Unix(t.GmtModified, 0).Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+// ListTags 获取标签列表
+func (ts *TagServiceImpl) ListTags(c *app.RequestContext, req *dto.ListTagsRequest) (*vo.ListTagsResponse, error) {
+	tags, total, err := ts.tagMapper.ListTags(c, req.PageNo, req.PageSize, req.Keyword)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list tags: %v", err)
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	tagItems := make([]*vo.TagItem, 0, len(tags))
+	for _, t := range tags {
+		tagItems = append(tagItems, &vo.TagItem{
+			ID:          strconv.FormatInt(t.ID, 10),
+			Name:        t.Name,
+			Description: t.Description,
+			CreatedAt:   time.Unix(t.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+			UpdatedAt:   time.Unix(t.GmtModified, 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &vo.ListTagsResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     tagItems,
+	}, nil
+}
+
+// GetTagCloud 获取标签云
+func (ts *TagServiceImpl) GetTagCloud(c *app.RequestContext, req *dto.GetTagCloudRequest) (*vo.GetTagCloudResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = 100 // 默认返回数量
+	}
+
+	counts, err := ts.tagMapper.ListTagCloud(c, limit)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get tag cloud: %v", err)
+		return nil, fmt.Errorf("failed to get tag cloud: %w", err)
+	}
+
+	cloudItems := make([]*vo.TagCloudItem, 0, len(counts))
+	for _, count := range counts {
+		cloudItems = append(cloudItems, &vo.TagCloudItem{
+			ID:        strconv.FormatInt(count.ID, 10),
+			Name:      count.Name,
+			PostCount: count.PostCount,
+		})
+	}
+
+	return &vo.GetTagCloudResponse{
+		List: cloudItems,
+	}, nil
+}
+
+// Create 创建标签
+func (ts *TagServiceImpl) Create(c *app.RequestContext, req *dto.CreateTagRequest) (*vo.CreateTagResponse, error) {
+	if _, err := ts.tagMapper.GetTagByName(c, req.Name); err == nil {
+		logger.BizLogger(c).Errorf("tag '%s' already exists", req.Name)
+		return nil, fmt.Errorf("tag '%s' already exists", req.Name)
+	}
+
+	// 同名标签已被删除时恢复原标签，避免与名称唯一索引冲突
+	t, err := db.RunDBTransaction(c, func() (*tag.Tag, error) {
+		t, err := ts.tagMapper.FirstOrCreateTag(c, req.Name)
+		if err != nil {
+			return nil, err
+		}
+		if t.Description != req.Description {
+			t.Description = req.Description
+			if err := ts.tagMapper.UpdateTag(c, t); err != nil {
+				return nil, err
+			}
+		}
+		return t, nil
+	})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to create tag '%s': %v", req.Name, err)
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("tag created successfully with ID: %d", t.ID)
+
+	return &vo.CreateTagResponse{
+		ID:          strconv.FormatInt(t.ID, 10),
+		Name:        t.Name,
+		Description: t.Description,
+		Message:     "Tag created successfully",
+	}, nil
+}
+
+// Update 更新标签
+func (ts *TagServiceImpl) Update(c *app.RequestContext, req *dto.UpdateTagRequest) (*vo.UpdateTagResponse, error) {
+	tagID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid tag ID format: %s", req.ID)
+		return nil, fmt.Errorf("invalid tag ID format: %w", err)
+	}
+
+	t, err := ts.tagMapper.GetTagByID(c, tagID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get tag with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	if req.Name != "" && req.Name != t.Name {
+		if existingTag, err := ts.tagMapper.GetTagByName(c, req.Name); err == nil && existingTag.ID != t.ID {
+			logger.BizLogger(c).Errorf("tag '%s' already exists", req.Name)
+			return nil, fmt.Errorf("tag '%s' already exists", req.Name)
+		}
+		t.Name = req.Name
+	}
+	if req.Description != "" {
+		t.Description = req.Description
+	}
+
+	if err := ts.tagMapper.UpdateTag(c, t); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			logger.BizLogger(c).Errorf("tag '%s' is used by a deleted tag", t.Name)
+			return nil, fmt.Errorf("tag '%s' already exists", t.Name)
+		}
+		logger.BizLogger(c).Errorf("failed to update tag with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("tag updated successfully with ID: %s", req.ID)
+
+	return &vo.UpdateTagResponse{
+		ID:          strconv.FormatInt(t.ID, 10),
+		Name:        t.Name,
+		Description: t.Description,
+		Message:     "Tag updated successfully",
+	}, nil
+}
+
+// Delete 删除标签
+func (ts *TagServiceImpl) Delete(c *app.RequestContext, req *dto.DeleteTagRequest) (*vo.DeleteTagResponse, error) {
+	tagID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid tag ID format: %s", req.ID)
+		return nil, fmt.Errorf("invalid tag ID format: %w", err)
+	}
+
+	if _, err := ts.tagMapper.GetTagByID(c, tagID); err != nil {
+		logger.BizLogger(c).Errorf("tag with ID %s not found: %v", req.ID, err)
+		return nil, fmt.Errorf("tag not found: %w", err)
+	}
+
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		return nil, ts.tagMapper.DeleteTag(c, tagID)
+	})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to delete tag with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("tag deleted successfully with ID: %s", req.ID)
+
+	return &vo.DeleteTagResponse{
+		Message: "Tag deleted successfully",
+	}, nil
+}
@@ -1,17 +1,29 @@
 package impl
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
 
+	"github.com/Done-0/jank/configs"
 	"github.com/Done-0/jank/internal/theme"
 	"github.com/Done-0/jank/internal/theme/impl"
 	"github.com/Done-0/jank/internal/types/consts"
 	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/internal/utils/seo"
+	"github.com/Done-0/jank/internal/utils/site"
 	"github.com/Done-0/jank/pkg/serve/controller/dto"
 	"github.com/Done-0/jank/pkg/serve/service"
 	"github.com/Done-0/jank/pkg/vo"
@@ -19,12 +31,25 @@ import (
 	themeUtils "github.com/Done-0/jank/internal/utils/theme"
 )
 
+// defaultThemePageSize 主题未设置每页文章数时首页与分类页的默认值
+const defaultThemePageSize = 10
+
 // ThemeServiceImpl 主题服务实现
-type ThemeServiceImpl struct{}
+type ThemeServiceImpl struct {
+	postService     service.PostService
+	categoryService service.CategoryService
+
+	templatesMu  sync.Mutex            // 保护已解析模板
+	templatesKey string                // 已解析模板所属的主题 ID 与加载时间，主题切换或重新加载后失效
+	templates    *themeUtils.Templates // 当前前端主题已解析的服务端渲染模板
+}
 
 // NewThemeService 创建主题服务实例
-func NewThemeService() service.ThemeService {
-	return &ThemeServiceImpl{}
+func NewThemeService(postService service.PostService, categoryService service.CategoryService) service.ThemeService {
+	return &ThemeServiceImpl{
+		postService:     postService,
+		categoryService: categoryService,
+	}
 }
 
 // SwitchTheme 切换主题逻辑
@@ -273,7 +298,8 @@ func (s *ThemeServiceImpl) ServeStaticResource(c *app.RequestContext, requestPat
 
 	// 检查文件是否存在
 	if _, err := os.Stat(absolutePath); os.IsNotExist(err) {
-		if !strings.Contains(cleanedPath, ".") {
+		// 声明了服务端渲染模板的前端主题不回退到主页，由 404 模板处理
+		if !strings.Contains(cleanedPath, ".") && (themeType == consts.ThemeTypeConsole || activeTheme.Templates == nil) {
 			// SPA 路由回退到主页
 			return filepath.Join(activeTheme.Path, activeTheme.IndexFilePath), nil
 		}
@@ -283,3 +309,392 @@ func (s *ThemeServiceImpl) ServeStaticResource(c *app.RequestContext, requestPat
 
 	return absolutePath, nil
 }
+
+// RenderPage 使用前端主题的服务端渲染模板渲染首页、文章页与分类页，文章页在单页应用主题下输出注入 SEO 元数据的首页文件
+// 主题未声明模板、未提供对应页面模板或路径不对应页面时返回 nil，文章或分类不存在时返回记录不存在错误
+func (s *ThemeServiceImpl) RenderPage(c *app.RequestContext, requestPath string) (*vo.RenderThemePageResponse, error) {
+	activeTheme, templates, err := s.frontendTemplates(c, requestPath)
+	if err != nil || activeTheme == nil {
+		return nil, err
+	}
+
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	siteConfig := cfgs.SiteConfig
+
+	pageSize := int64(defaultThemePageSize)
+	if activeTheme.Templates != nil && activeTheme.Templates.PageSize > 0 {
+		pageSize = activeTheme.Templates.PageSize
+	}
+
+	var page string
+	var data *vo.ThemePageData
+	if categoryID, ok := site.MatchCategoryPath(siteConfig, requestPath); ok {
+		page = consts.ThemeTemplateCategory
+		if !templates.Has(page) {
+			return nil, nil
+		}
+		data, err = s.categoryPageData(c, siteConfig, requestPath, categoryID, pageSize)
+	} else if params, ok := site.MatchPostPath(siteConfig, requestPath); ok {
+		page = consts.ThemeTemplatePost
+		post, redirectURL, err := s.resolvePost(c, siteConfig, params)
+		switch {
+		case err != nil && !templates.Has(page) && errors.Is(err, gorm.ErrRecordNotFound):
+			// 单页应用主题由前端展示文章不存在页面
+			return nil, nil
+		case err != nil:
+			return nil, err
+		case redirectURL != "":
+			// 文章通过历史别名访问时重定向到当前地址
+			return &vo.RenderThemePageResponse{StatusCode: http.StatusMovedPermanently, RedirectURL: redirectURL}, nil
+		case !templates.Has(page):
+			return s.renderSPAPost(c, activeTheme, siteConfig, post)
+		}
+		// 模板主题不经过文章接口，在此统计浏览量；单页应用主题由前端请求文章接口时统计
+		_ = s.postService.RecordView(c, post)
+		data, err = s.postPageData(c, siteConfig, requestPath, post)
+		if err != nil {
+			return nil, err
+		}
+	} else if requestPath == "/" || requestPath == "" {
+		page = consts.ThemeTemplateIndex
+		if !templates.Has(page) {
+			return nil, nil
+		}
+		data, err = s.indexPageData(c, siteConfig, pageSize)
+	} else {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.renderTemplate(c, templates, page, http.StatusOK, data)
+}
+
+// RenderNotFound 使用前端主题的 404 模板渲染页面，主题未提供 404 模板时返回 nil
+func (s *ThemeServiceImpl) RenderNotFound(c *app.RequestContext) (*vo.RenderThemePageResponse, error) {
+	requestPath := string(c.Path())
+	_, templates, err := s.frontendTemplates(c, requestPath)
+	if err != nil || !templates.Has(consts.ThemeTemplateNotFound) {
+		return nil, err
+	}
+
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	data, err := s.notFoundPageData(c, cfgs.SiteConfig, requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.renderTemplate(c, templates, consts.ThemeTemplateNotFound, http.StatusNotFound, data)
+}
+
+// frontendTemplates 获取当前前端主题已解析的服务端渲染模板，控制台与接口路径或主题未声明模板时返回 nil
+func (s *ThemeServiceImpl) frontendTemplates(c *app.RequestContext, requestPath string) (*impl.ThemeInfo, *themeUtils.Templates, error) {
+	if strings.HasPrefix(requestPath, "/console") || strings.HasPrefix(requestPath, "/api/") {
+		return nil, nil, nil
+	}
+
+	activeTheme, err := theme.GlobalThemeManager.GetActiveThemeByType(consts.ThemeTypeFrontend)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get active %s theme: %v", consts.ThemeTypeFrontend, err)
+		return nil, nil, fmt.Errorf("failed to get active %s theme: %w", consts.ThemeTypeFrontend, err)
+	}
+	if activeTheme.Templates == nil {
+		return activeTheme, nil, nil
+	}
+
+	s.templatesMu.Lock()
+	defer s.templatesMu.Unlock()
+
+	key := activeTheme.ID + "@" + strconv.FormatInt(activeTheme.LoadedAt, 10)
+	if s.templatesKey == key {
+		return activeTheme, s.templates, nil
+	}
+
+	templates, err := themeUtils.LoadTemplates(activeTheme.Path, map[string]string{
+		consts.ThemeTemplateIndex:    activeTheme.Templates.Index,
+		consts.ThemeTemplatePost:     activeTheme.Templates.Post,
+		consts.ThemeTemplateCategory: activeTheme.Templates.Category,
+		consts.ThemeTemplateNotFound: activeTheme.Templates.NotFound,
+	}, activeTheme.Templates.Partials, themeTemplateFuncs())
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to load templates of theme '%s': %v", activeTheme.ID, err)
+		return nil, nil, fmt.Errorf("failed to load theme templates: %w", err)
+	}
+
+	s.templatesKey = key
+	s.templates = templates
+	logger.BizLogger(c).Infof("loaded server-side templates of theme '%s'", activeTheme.ID)
+	return activeTheme, templates, nil
+}
+
+// basePageData 生成各页面共用的模板数据：站点信息与启用的顶级分类
+func (s *ThemeServiceImpl) basePageData(c *app.RequestContext, siteConfig configs.SiteConfig, page, requestPath string) (*vo.ThemePageData, error) {
+	isActive := true
+	var categories []*vo.CategoryItem
+	for pageNo := int64(1); ; pageNo++ {
+		list, err := s.categoryService.ListCategories(c, &dto.ListCategoriesRequest{PageNo: pageNo, PageSize: 100, IsActive: &isActive})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list categories: %w", err)
+		}
+		categories = append(categories, list.List...)
+		if len(list.List) == 0 || int64(len(categories)) >= list.Total {
+			break
+		}
+	}
+
+	return &vo.ThemePageData{
+		Page: page,
+		Path: requestPath,
+		Site: &vo.ThemeSiteData{
+			URL:         siteConfig.URL,
+			Title:       siteConfig.Title,
+			Description: siteConfig.Description,
+			Language:    siteConfig.Language,
+		},
+		Categories: categories,
+	}, nil
+}
+
+// listPageData 生成首页与分类页的模板数据，页码取自 page 查询参数，超出总页数时返回记录不存在错误
+func (s *ThemeServiceImpl) listPageData(c *app.RequestContext, siteConfig configs.SiteConfig, requestPath string, categoryID *int64, pageSize int64) (*vo.ThemePageData, error) {
+	pageNo := int64(1)
+	if raw := string(c.Query("page")); raw != "" {
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid page '%s': %w", raw, gorm.ErrRecordNotFound)
+		}
+		pageNo = n
+	}
+
+	page := consts.ThemeTemplateIndex
+	if categoryID != nil {
+		page = consts.ThemeTemplateCategory
+	}
+	data, err := s.basePageData(c, siteConfig, page, requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := s.postService.ListPublishedPosts(c, &dto.ListPublishedPostsRequest{PageNo: pageNo, PageSize: pageSize, CategoryID: categoryID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list published posts: %w", err)
+	}
+
+	totalPages := (posts.Total + pageSize - 1) / pageSize
+	if pageNo > 1 && pageNo > totalPages {
+		return nil, fmt.Errorf("page %d exceeds %d pages: %w", pageNo, totalPages, gorm.ErrRecordNotFound)
+	}
+
+	pagination := &vo.ThemePagination{PageNo: pageNo, PageSize: pageSize, Total: posts.Total, TotalPages: totalPages}
+	if pageNo > 1 {
+		pagination.PrevURL = requestPath
+		if pageNo > 2 {
+			pagination.PrevURL += "?page=" + strconv.FormatInt(pageNo-1, 10)
+		}
+	}
+	if pageNo < totalPages {
+		pagination.NextURL = requestPath + "?page=" + strconv.FormatInt(pageNo+1, 10)
+	}
+
+	data.Posts = posts
+	data.Pagination = pagination
+	return data, nil
+}
+
+// indexPageData 生成首页的模板数据
+func (s *ThemeServiceImpl) indexPageData(c *app.RequestContext, siteConfig configs.SiteConfig, pageSize int64) (*vo.ThemePageData, error) {
+	data, err := s.listPageData(c, siteConfig, "/", nil, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Title = siteConfig.Title
+	data.Description = siteConfig.Description
+	data.Canonical = site.AbsoluteURL(siteConfig, "/")
+	return data, nil
+}
+
+// categoryPageData 生成分类页的模板数据，分类未启用时按不存在处理
+func (s *ThemeServiceImpl) categoryPageData(c *app.RequestContext, siteConfig configs.SiteConfig, requestPath, categoryID string, pageSize int64) (*vo.ThemePageData, error) {
+	category, err := s.categoryService.GetCategory(c, &dto.GetCategoryRequest{ID: categoryID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category %s: %w", categoryID, err)
+	}
+	if !category.IsActive {
+		return nil, fmt.Errorf("category %s is inactive: %w", categoryID, gorm.ErrRecordNotFound)
+	}
+
+	id, _ := strconv.ParseInt(category.ID, 10, 64)
+	data, err := s.listPageData(c, siteConfig, requestPath, &id, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Category = category
+	data.Title = category.Name + " - " + siteConfig.Title
+	data.Description = category.Description
+	data.Canonical = site.CategoryURL(siteConfig, id)
+	return data, nil
+}
+
+// resolvePost 按路径参数获取文章，文章通过历史别名访问时不返回文章而返回需重定向到的站内地址
+func (s *ThemeServiceImpl) resolvePost(c *app.RequestContext, siteConfig configs.SiteConfig, params map[string]string) (*vo.GetPostResponse, string, error) {
+	var post *vo.GetPostResponse
+	var err error
+	switch {
+	case params["id"] != "":
+		post, err = s.postService.GetPost(c, &dto.GetPostRequest{ID: params["id"]})
+	default:
+		post, err = s.postService.GetPostBySlug(c, &dto.GetPostBySlugRequest{Slug: params["slug"]})
+		// 未设置别名的文章链接中 {slug} 为文章 ID
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if _, parseErr := strconv.ParseInt(params["slug"], 10, 64); parseErr == nil {
+				post, err = s.postService.GetPost(c, &dto.GetPostRequest{ID: params["slug"]})
+			}
+		}
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get post: %w", err)
+	}
+
+	if slug, ok := params["slug"]; ok && post.Slug != "" && slug != post.Slug {
+		id, _ := strconv.ParseInt(post.ID, 10, 64)
+		target := site.PostURL(siteConfig, id, post.Slug)
+		if u, err := url.Parse(target); err == nil {
+			target = u.RequestURI()
+		}
+		return nil, target, nil
+	}
+	return post, "", nil
+}
+
+// postPageData 生成文章页的模板数据，标题、描述与规范链接优先使用文章 SEO 字段
+func (s *ThemeServiceImpl) postPageData(c *app.RequestContext, siteConfig configs.SiteConfig, requestPath string, post *vo.GetPostResponse) (*vo.ThemePageData, error) {
+	data, err := s.basePageData(c, siteConfig, consts.ThemeTemplatePost, requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	article, title := postSEO(siteConfig, post)
+	data.Post = post
+	data.Title = title
+	data.Description = article.Description
+	data.Canonical = article.Canonical
+	data.NoIndex = article.NoIndex
+	data.Head = article.HeadHTML()
+	return data, nil
+}
+
+// notFoundPageData 生成 404 页面的模板数据
+func (s *ThemeServiceImpl) notFoundPageData(c *app.RequestContext, siteConfig configs.SiteConfig, requestPath string) (*vo.ThemePageData, error) {
+	data, err := s.basePageData(c, siteConfig, consts.ThemeTemplateNotFound, requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Title = "404 - " + siteConfig.Title
+	return data, nil
+}
+
+// renderSPAPost 为单页应用主题输出首页文件，并按文章注入标题与 SEO 元数据，使分享卡片与搜索引擎无需执行脚本即可获取
+func (s *ThemeServiceImpl) renderSPAPost(c *app.RequestContext, activeTheme *impl.ThemeInfo, siteConfig configs.SiteConfig, post *vo.GetPostResponse) (*vo.RenderThemePageResponse, error) {
+	index, err := os.ReadFile(filepath.Join(activeTheme.Path, activeTheme.IndexFilePath))
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to read index file of theme '%s': %v", activeTheme.ID, err)
+		return nil, fmt.Errorf("failed to read theme index file: %w", err)
+	}
+
+	article, title := postSEO(siteConfig, post)
+	return &vo.RenderThemePageResponse{StatusCode: http.StatusOK, Body: seo.InjectHead(index, title, article.HeadHTML())}, nil
+}
+
+// postSEO 生成文章页面的 SEO 元数据与页面标题，SEO 字段为空时使用文章对应字段
+// 参数：
+//   - siteConfig: 站点配置
+//   - post: 文章详情
+//
+// 返回值：
+//   - *seo.Article: 文章 SEO 元数据
+//   - string: 页面标题，未设置 SEO 标题时为「文章标题 - 站点标题」
+func postSEO(siteConfig configs.SiteConfig, post *vo.GetPostResponse) (*seo.Article, string) {
+	id, _ := strconv.ParseInt(post.ID, 10, 64)
+	article := &seo.Article{
+		Title:       cmp.Or(post.MetaTitle, post.Title),
+		Description: cmp.Or(post.MetaDescription, post.Description),
+		Canonical:   cmp.Or(post.CanonicalURL, site.PostURL(siteConfig, id, post.Slug)),
+		Image:       cmp.Or(post.OGImage, post.Image),
+		NoIndex:     post.NoIndex,
+		SiteName:    siteConfig.Title,
+		Locale:      siteConfig.Language,
+		Author:      post.AuthorNickname,
+		Section:     post.CategoryName,
+		WordCount:   post.WordCount,
+	}
+	if strings.HasPrefix(article.Image, "/") && !strings.HasPrefix(article.Image, "//") {
+		article.Image = site.AbsoluteURL(siteConfig, article.Image)
+	}
+	for _, tag := range post.Tags {
+		article.Tags = append(article.Tags, tag.Name)
+	}
+	if published, err := time.ParseInLocation("2006-01-02 15:04:05", cmp.Or(post.PublishAt, post.CreatedAt), time.Local); err == nil {
+		article.Published = published
+	}
+	if modified, err := time.ParseInLocation("2006-01-02 15:04:05", post.UpdatedAt, time.Local); err == nil {
+		article.Modified = modified
+	}
+
+	title := post.MetaTitle
+	if title == "" {
+		title = post.Title + " - " + siteConfig.Title
+	}
+	return article, title
+}
+
+// renderTemplate 渲染页面模板
+func (s *ThemeServiceImpl) renderTemplate(c *app.RequestContext, templates *themeUtils.Templates, page string, statusCode int, data *vo.ThemePageData) (*vo.RenderThemePageResponse, error) {
+	body, err := templates.Render(page, data)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to render %s page for '%s': %v", page, data.Path, err)
+		return nil, fmt.Errorf("failed to render %s page: %w", page, err)
+	}
+
+	return &vo.RenderThemePageResponse{StatusCode: statusCode, Body: body}, nil
+}
+
+// themeTemplateFuncs 主题模板可用的函数，链接按当前站点配置生成
+func themeTemplateFuncs() template.FuncMap {
+	siteConfig := func() configs.SiteConfig {
+		cfgs, err := configs.GetConfig()
+		if err != nil {
+			return configs.SiteConfig{}
+		}
+		return cfgs.SiteConfig
+	}
+	parseID := func(id string) int64 {
+		n, _ := strconv.ParseInt(id, 10, 64)
+		return n
+	}
+
+	return template.FuncMap{
+		// safeHTML 原样输出已渲染并清洗的 HTML，如文章正文
+		"safeHTML": func(s string) template.HTML { return template.HTML(s) },
+		// absURL 将站内路径拼接为绝对链接
+		"absURL": func(path string) string { return site.AbsoluteURL(siteConfig(), path) },
+		// postURL 文章页面链接，参数为文章 ID 与别名
+		"postURL": func(id, slug string) string { return site.PostURL(siteConfig(), parseID(id), slug) },
+		// categoryURL 分类页面链接，参数为分类 ID
+		"categoryURL": func(id string) string { return site.CategoryURL(siteConfig(), parseID(id)) },
+		// tagURL 标签页面链接，参数为标签 ID
+		"tagURL": func(id string) string { return site.TagURL(siteConfig(), parseID(id)) },
+	}
+}
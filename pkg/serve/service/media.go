@@ -0,0 +1,17 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// MediaService 媒体文件服务接口
+type MediaService interface {
+	Upload(c *app.RequestContext, req *dto.UploadMediaRequest) (*vo.UploadMediaResponse, error) // 上传媒体文件，相同内容的文件不重复存储
+	List(c *app.RequestContext, req *dto.ListMediaRequest) (*vo.ListMediaResponse, error)       // 获取媒体文件列表，具备管理权限时返回所有用户的文件
+	Delete(c *app.RequestContext, req *dto.DeleteMediaRequest) (*vo.DeleteMediaResponse, error) // 删除媒体文件，仅上传者或具备管理权限的用户可删除
+	ServeLocalFile(c *app.RequestContext, key string) (string, error)                           // 获取本地存储文件路径
+	ServeVariant(c *app.RequestContext, key string) (string, error)                             // 获取图片变体访问地址，尚未生成时按需生成
+}
@@ -3,16 +3,33 @@ package service
 import (
 	"github.com/cloudwego/hertz/pkg/app"
 
+	"github.com/Done-0/jank/internal/utils/importer"
 	"github.com/Done-0/jank/pkg/serve/controller/dto"
 	"github.com/Done-0/jank/pkg/vo"
 )
 
 // PostService 文章服务接口
 type PostService interface {
-	GetPost(c *app.RequestContext, req *dto.GetPostRequest) (*vo.GetPostResponse, error)                         // 获取单篇文章
-	ListPublishedPosts(c *app.RequestContext, req *dto.ListPublishedPostsRequest) (*vo.ListPostsResponse, error) // 获取已发布文章列表
-	ListPostsByStatus(c *app.RequestContext, req *dto.ListPostsByStatusRequest) (*vo.ListPostsResponse, error)   // 根据状态获取文章列表，支持管理员查询所有文章
-	Create(c *app.RequestContext, req *dto.CreatePostRequest) (*vo.CreatePostResponse, error)                    // 创建文章
-	Update(c *app.RequestContext, req *dto.UpdatePostRequest) (*vo.UpdatePostResponse, error)                    // 更新文章
-	Delete(c *app.RequestContext, req *dto.DeletePostRequest) (*vo.DeletePostResponse, error)                    // 删除文章
+	GetPost(c *app.RequestContext, req *dto.GetPostRequest) (*vo.GetPostResponse, error)                                 // 获取单篇文章
+	GetPostBySlug(c *app.RequestContext, req *dto.GetPostBySlugRequest) (*vo.GetPostResponse, error)                     // 根据别名获取文章
+	ListPublishedPosts(c *app.RequestContext, req *dto.ListPublishedPostsRequest) (*vo.ListPostsResponse, error)         // 获取已发布文章列表
+	ListPostsByAuthor(c *app.RequestContext, req *dto.ListPostsByAuthorRequest) (*vo.ListPostsResponse, error)           // 获取指定作者的已发布文章列表
+	ListPostsByStatus(c *app.RequestContext, req *dto.ListPostsByStatusRequest) (*vo.ListPostsResponse, error)           // 根据状态获取文章列表，支持管理员查询所有文章
+	Search(c *app.RequestContext, req *dto.SearchPostsRequest) (*vo.SearchPostsResponse, error)                          // 全文检索已发布文章
+	Create(c *app.RequestContext, req *dto.CreatePostRequest) (*vo.CreatePostResponse, error)                            // 创建文章
+	Update(c *app.RequestContext, req *dto.UpdatePostRequest) (*vo.UpdatePostResponse, error)                            // 更新文章
+	Delete(c *app.RequestContext, req *dto.DeletePostRequest) (*vo.DeletePostResponse, error)                            // 删除文章
+	ListRevisions(c *app.RequestContext, req *dto.ListPostRevisionsRequest) (*vo.ListPostRevisionsResponse, error)       // 获取文章修订列表
+	DiffRevisions(c *app.RequestContext, req *dto.DiffPostRevisionsRequest) (*vo.DiffPostRevisionsResponse, error)       // 对比文章修订
+	RestoreRevision(c *app.RequestContext, req *dto.RestorePostRevisionRequest) (*vo.RestorePostRevisionResponse, error) // 恢复文章修订
+	RerenderPosts(c *app.RequestContext) (*vo.RerenderPostsResponse, error)                                              // 按当前渲染与清洗配置重新生成全部文章的 HTML
+	Import(c *app.RequestContext, req *dto.ImportPostsRequest) (*vo.ImportPostsResponse, error)                          // 从上传的 Markdown、zip 或 WXR 文件导入文章（需具备导入权限）
+	ImportParsed(c *app.RequestContext, parsed *importer.Result) (*vo.ImportPostsResponse, error)                        // 导入已解析的文章，作者为当前用户，供命令行导入使用
+	Pin(c *app.RequestContext, req *dto.PinPostRequest) (*vo.PinPostResponse, error)                                     // 设置或取消文章置顶（需具备置顶权限）
+	Feature(c *app.RequestContext, req *dto.FeaturePostRequest) (*vo.FeaturePostResponse, error)                         // 设置或取消文章推荐（需具备置顶权限）
+	ListPinnedPosts(c *app.RequestContext, req *dto.ListPinnedPostsRequest) (*vo.ListPinnedPostsResponse, error)         // 获取指定范围内未到期的已发布置顶文章
+	ReorderPins(c *app.RequestContext, req *dto.ReorderPinsRequest) (*vo.ReorderPinsResponse, error)                     // 调整指定范围内置顶文章的顺序（需具备置顶权限）
+	RecordView(c *app.RequestContext, post *vo.GetPostResponse) error                                                    // 记录一次文章浏览，仅统计已发布文章，同一访客在去重时间窗口内只计一次
+	ListPopularWeek(c *app.RequestContext, req *dto.ListPopularPostsRequest) (*vo.ListPopularPostsResponse, error)       // 获取近 7 天浏览量最多的已发布文章
+	ListPopularAllTime(c *app.RequestContext, req *dto.ListPopularPostsRequest) (*vo.ListPopularPostsResponse, error)    // 获取总浏览量最多的已发布文章
 }
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// ReactionService 文章表态服务接口
+type ReactionService interface {
+	Toggle(c *app.RequestContext, req *dto.ToggleReactionRequest) (*vo.ToggleReactionResponse, error)   // 切换文章表态，未表态时添加，已表态时取消
+	GetReactions(c *app.RequestContext, req *dto.GetReactionsRequest) (*vo.GetReactionsResponse, error) // 获取文章表态数量及当前用户或访客已表态的类型
+	ListTypes(c *app.RequestContext) (*vo.ListReactionTypesResponse, error)                             // 获取已配置的表态类型
+	Report(c *app.RequestContext, req *dto.ReactionReportRequest) (*vo.ReactionReportResponse, error)   // 获取表态最多的文章报表
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// SeriesService 文章系列服务接口
+type SeriesService interface {
+	GetSeries(c *app.RequestContext, req *dto.GetSeriesRequest) (*vo.GetSeriesResponse, error)    // 获取单个系列及其文章
+	ListSeries(c *app.RequestContext, req *dto.ListSeriesRequest) (*vo.ListSeriesResponse, error) // 获取系列列表
+	Create(c *app.RequestContext, req *dto.CreateSeriesRequest) (*vo.CreateSeriesResponse, error) // 创建系列
+	Update(c *app.RequestContext, req *dto.UpdateSeriesRequest) (*vo.UpdateSeriesResponse, error) // 更新系列
+	Delete(c *app.RequestContext, req *dto.DeleteSeriesRequest) (*vo.DeleteSeriesResponse, error) // 删除系列
+}
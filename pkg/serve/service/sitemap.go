@@ -0,0 +1,14 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// SitemapService 站点地图服务接口
+type SitemapService interface {
+	GetSitemap(c *app.RequestContext, req *dto.GetSitemapRequest) (*vo.SitemapResponse, error) // 生成站点地图，链接数超过上限时生成站点地图索引
+	GetRobots(c *app.RequestContext) (*vo.RobotsResponse, error)                               // 生成 robots.txt
+}
@@ -0,0 +1,13 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// StaticSiteService 静态站点服务接口
+type StaticSiteService interface {
+	Build(c *app.RequestContext, req *dto.BuildStaticSiteRequest) (*vo.BuildStaticSiteResponse, error) // 使用当前前端主题将所有公开页面生成为静态站点
+}
@@ -19,4 +19,8 @@ type ThemeService interface {
 	// 路由服务方法（根据路径自动识别主题类型）
 	ServeHomePage(c *app.RequestContext) (string, error)
 	ServeStaticResource(c *app.RequestContext, requestPath string) (string, error)
+
+	// 服务端渲染方法（前端主题声明模板时使用，未声明时返回 nil 以回退到单页应用）
+	RenderPage(c *app.RequestContext, requestPath string) (*vo.RenderThemePageResponse, error)
+	RenderNotFound(c *app.RequestContext) (*vo.RenderThemePageResponse, error)
 }
@@ -2,3 +2,74 @@
 // 创建者：Done-0
 // 创建时间：2025-08-05
 package vo
+
+// CreateCommentResponse 创建评论响应
+type CreateCommentResponse struct {
+	ID       string `json:"id"`        // 评论 ID
+	PostID   string `json:"post_id"`   // 文章 ID
+	ParentID string `json:"parent_id"` // 父评论 ID
+	Nickname string `json:"nickname"`  // 评论者昵称
+	Content  string `json:"content"`   // 评论内容
+	Status   string `json:"status"`    // 审核状态
+	Message  string `json:"message"`   // 创建结果消息
+}
+
+// CommentItem 评论列表项
+type CommentItem struct {
+	ID        string         `json:"id"`                 // 评论 ID
+	PostID    string         `json:"post_id"`            // 文章 ID
+	ParentID  string         `json:"parent_id"`          // 父评论 ID
+	UserID    string         `json:"user_id"`            // 评论用户 ID，匿名评论为空
+	Nickname  string         `json:"nickname"`           // 评论者昵称
+	Avatar    string         `json:"avatar"`             // 评论者头像
+	Website   string         `json:"website"`            // 评论者网站
+	Content   string         `json:"content"`            // 评论内容
+	Status    string         `json:"status"`             // 审核状态
+	CreatedAt string         `json:"created_at"`         // 创建时间
+	Children  []*CommentItem `json:"children,omitempty"` // 子评论列表
+}
+
+// ListCommentsResponse 评论列表响应
+type ListCommentsResponse struct {
+	Total    int64          `json:"total"`     // 总数量
+	PageNo   int64          `json:"page_no"`   // 当前页码
+	PageSize int64          `json:"page_size"` // 每页数量
+	List     []*CommentItem `json:"list"`      // 评论列表
+}
+
+// AdminCommentItem 评论管理列表项
+type AdminCommentItem struct {
+	ID        string `json:"id"`         // 评论 ID
+	PostID    string `json:"post_id"`    // 文章 ID
+	PostTitle string `json:"post_title"` // 文章标题
+	ParentID  string `json:"parent_id"`  // 父评论 ID
+	UserID    string `json:"user_id"`    // 评论用户 ID，匿名评论为空
+	Nickname  string `json:"nickname"`   // 评论者昵称
+	Email     string `json:"email"`      // 评论者邮箱
+	Website   string `json:"website"`    // 评论者网站
+	Content   string `json:"content"`    // 评论内容
+	Status    string `json:"status"`     // 审核状态
+	IP        string `json:"ip"`         // 评论者 IP
+	UserAgent string `json:"user_agent"` // 评论者 User-Agent
+	CreatedAt string `json:"created_at"` // 创建时间
+	UpdatedAt string `json:"updated_at"` // 更新时间
+}
+
+// ListCommentsByStatusResponse 评论管理列表响应
+type ListCommentsByStatusResponse struct {
+	Total    int64               `json:"total"`     // 总数量
+	PageNo   int64               `json:"page_no"`   // 当前页码
+	PageSize int64               `json:"page_size"` // 每页数量
+	List     []*AdminCommentItem `json:"list"`      // 评论列表
+}
+
+// ModerateCommentResponse 审核评论响应
+type ModerateCommentResponse struct {
+	Affected int64  `json:"affected"` // 受影响的评论数量
+	Message  string `json:"message"`  // 审核结果消息
+}
+
+// DeleteCommentResponse 删除评论响应
+type DeleteCommentResponse struct {
+	Message string `json:"message"` // 删除结果消息
+}
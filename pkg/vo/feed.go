@@ -0,0 +1,14 @@
+// Package vo 订阅源相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package vo
+
+import "time"
+
+// FeedResponse 订阅源响应
+type FeedResponse struct {
+	Content      []byte    // 订阅源内容
+	ContentType  string    // 内容类型
+	ETag         string    // 实体标签，用于条件请求
+	LastModified time.Time // 最近修改时间，无文章时为零值
+}
@@ -0,0 +1,54 @@
+// Package vo 媒体文件相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package vo
+
+// MediaItem 媒体文件项
+type MediaItem struct {
+	ID        string `json:"id"`         // 媒体文件 ID
+	OwnerID   string `json:"owner_id"`   // 上传者用户 ID
+	FileName  string `json:"file_name"`  // 原始文件名
+	URL       string `json:"url"`        // 访问地址
+	MimeType  string `json:"mime_type"`  // MIME 类型
+	Size      int64  `json:"size"`       // 文件大小（字节）
+	Hash      string `json:"hash"`       // 文件内容 SHA-256 哈希
+	Width     int    `json:"width"`      // 图片宽度（像素），非图片为 0
+	Height    int    `json:"height"`     // 图片高度（像素），非图片为 0
+	CreatedAt string `json:"created_at"` // 上传时间
+	*ImageSet
+}
+
+// ImageSet 图片响应式尺寸集合
+type ImageSet struct {
+	Variants []*ImageVariant   `json:"variants"` // 尺寸变体列表，按宽度升序，含原图
+	Srcset   map[string]string `json:"srcset"`   // 按 MIME 类型分组的 srcset 属性值，如 {"image/webp": "a_w320.webp 320w, a_w640.webp 640w"}
+}
+
+// ImageVariant 图片尺寸变体
+type ImageVariant struct {
+	URL      string `json:"url"`       // 访问地址
+	Width    int    `json:"width"`     // 宽度（像素）
+	Height   int    `json:"height"`    // 高度（像素）
+	MimeType string `json:"mime_type"` // MIME 类型
+	Size     int64  `json:"size"`      // 文件大小（字节），按需生成且尚未生成时为 0
+}
+
+// UploadMediaResponse 上传媒体文件响应
+type UploadMediaResponse struct {
+	*MediaItem
+	Duplicate bool   `json:"duplicate"` // 是否为已上传过的相同文件，为 true 时返回已有记录
+	Message   string `json:"message"`   // 上传结果消息
+}
+
+// ListMediaResponse 媒体文件列表响应
+type ListMediaResponse struct {
+	Total    int64        `json:"total"`     // 总数量
+	PageNo   int64        `json:"page_no"`   // 当前页码
+	PageSize int64        `json:"page_size"` // 每页数量
+	List     []*MediaItem `json:"list"`      // 媒体文件列表
+}
+
+// DeleteMediaResponse 删除媒体文件响应
+type DeleteMediaResponse struct {
+	Message string `json:"message"` // 删除结果消息
+}
@@ -5,43 +5,100 @@ package vo
 
 // CreatePostResponse 创建文章响应
 type CreatePostResponse struct {
-	ID           string `json:"id"`            // 文章 ID
-	Title        string `json:"title"`         // 文章标题
-	Description  string `json:"description"`   // 文章描述/摘要
-	Image        string `json:"image"`         // 文章封面图片
-	Status       string `json:"status"`        // 文章状态
-	CategoryID   string `json:"category_id"`   // 分类 ID
-	CategoryName string `json:"category_name"` // 分类名称
-	Markdown     string `json:"markdown"`      // Markdown内容
-	Message      string `json:"message"`       // 创建结果消息
+	ID           string         `json:"id"`            // 文章 ID
+	Title        string         `json:"title"`         // 文章标题
+	Slug         string         `json:"slug"`          // URL 别名
+	Description  string         `json:"description"`   // 文章描述/摘要
+	Image        string         `json:"image"`         // 文章封面图片
+	Status       string         `json:"status"`        // 文章状态
+	CategoryID   string         `json:"category_id"`   // 分类 ID
+	CategoryName string         `json:"category_name"` // 分类名称
+	PublishAt    string         `json:"publish_at"`    // 发布时间，未设置时为空
+	Tags         []*PostTagItem `json:"tags"`          // 标签列表
+	Markdown     string         `json:"markdown"`      // Markdown内容
+	Message      string         `json:"message"`       // 创建结果消息
+
+	// SEO 相关，为空时使用文章对应字段
+	MetaTitle       string `json:"meta_title"`       // SEO 标题，为空时使用文章标题
+	MetaDescription string `json:"meta_description"` // SEO 描述，为空时使用文章描述
+	CanonicalURL    string `json:"canonical_url"`    // 规范链接，为空时使用文章页面地址
+	NoIndex         bool   `json:"no_index"`         // 是否禁止搜索引擎收录
+	OGImage         string `json:"og_image"`         // 分享卡片图片，为空时使用封面图片
 }
 
 // GetPostResponse 获取文章响应
 type GetPostResponse struct {
-	ID           string `json:"id"`            // 文章 ID
-	Title        string `json:"title"`         // 文章标题
-	Description  string `json:"description"`   // 文章描述/摘要
-	Image        string `json:"image"`         // 文章封面图片
-	Status       string `json:"status"`        // 文章状态
-	CategoryID   string `json:"category_id"`   // 分类 ID
-	CategoryName string `json:"category_name"` // 分类名称
-	Markdown     string `json:"markdown"`      // Markdown 内容
-	HTML         string `json:"html"`          // 渲染后的 HTML
-	CreatedAt    string `json:"created_at"`    // 创建时间
-	UpdatedAt    string `json:"updated_at"`    // 更新时间
+	ID             string         `json:"id"`              // 文章 ID
+	Title          string         `json:"title"`           // 文章标题
+	Slug           string         `json:"slug"`            // URL 别名
+	Description    string         `json:"description"`     // 文章描述/摘要
+	Image          string         `json:"image"`           // 文章封面图片
+	ImageSet       *ImageSet      `json:"image_set"`       // 封面图片响应式尺寸集合，封面不是媒体库图片时为 null
+	Status         string         `json:"status"`          // 文章状态
+	CategoryID     string         `json:"category_id"`     // 分类 ID
+	CategoryName   string         `json:"category_name"`   // 分类名称
+	AuthorID       string         `json:"author_id"`       // 作者用户 ID，作者未知时为空
+	AuthorNickname string         `json:"author_nickname"` // 作者昵称
+	AuthorAvatar   string         `json:"author_avatar"`   // 作者头像
+	PublishAt      string         `json:"publish_at"`      // 发布时间，未设置时为空
+	Tags           []*PostTagItem `json:"tags"`            // 标签列表
+	Markdown       string         `json:"markdown"`        // Markdown 内容
+	HTML           string         `json:"html"`            // 渲染后的 HTML
+	TOC            []*PostTOCItem `json:"toc"`             // 目录
+	WordCount      int            `json:"word_count"`      // 字数，中日韩文字按字计、其他文字按词计
+	ReadingTime    int            `json:"reading_time"`    // 预计阅读时长（分钟）
+	CreatedAt      string         `json:"created_at"`      // 创建时间
+	UpdatedAt      string         `json:"updated_at"`      // 更新时间
+
+	// SEO 相关，为空时使用文章对应字段
+	MetaTitle       string `json:"meta_title"`       // SEO 标题，为空时使用文章标题
+	MetaDescription string `json:"meta_description"` // SEO 描述，为空时使用文章描述
+	CanonicalURL    string `json:"canonical_url"`    // 规范链接，为空时使用文章页面地址
+	NoIndex         bool   `json:"no_index"`         // 是否禁止搜索引擎收录
+	OGImage         string `json:"og_image"`         // 分享卡片图片，为空时使用封面图片
+
+	Series *PostSeriesInfo `json:"series"` // 所属系列，不属于任何系列时为 null
+
+	// 置顶、推荐与浏览量
+	PinScope    string `json:"pin_scope"`     // 置顶范围，未置顶或置顶已到期时为空
+	PinExpireAt string `json:"pin_expire_at"` // 置顶到期时间，永久置顶时为空
+	Featured    bool   `json:"featured"`      // 是否推荐
+	Views       int64  `json:"views"`         // 浏览量（按访客去重，定期写入，存在少量延迟）
+
+	// 表态相关
+	ReactionTypes []string         `json:"reaction_types"` // 文章选择的表态类型，为空时启用全部已配置类型
+	Reactions     []*ReactionCount `json:"reactions"`      // 文章启用的表态类型及数量，按配置顺序排列
+}
+
+// PostTOCItem 文章目录项
+type PostTOCItem struct {
+	Level    int            `json:"level"`              // 标题级别（1-6）
+	Text     string         `json:"text"`               // 标题文本
+	ID       string         `json:"id"`                 // 标题锚点 ID
+	Children []*PostTOCItem `json:"children,omitempty"` // 下级目录项
 }
 
 // UpdatePostResponse 更新文章响应
 type UpdatePostResponse struct {
-	ID           string `json:"id"`            // 文章 ID
-	Title        string `json:"title"`         // 文章标题
-	Description  string `json:"description"`   // 文章描述/摘要
-	Image        string `json:"image"`         // 文章封面图片
-	Status       string `json:"status"`        // 文章状态
-	CategoryID   string `json:"category_id"`   // 分类 ID
-	CategoryName string `json:"category_name"` // 分类名称
-	Markdown     string `json:"markdown"`      // Markdown内容
-	Message      string `json:"message"`       // 更新结果消息
+	ID           string         `json:"id"`            // 文章 ID
+	Title        string         `json:"title"`         // 文章标题
+	Slug         string         `json:"slug"`          // URL 别名
+	Description  string         `json:"description"`   // 文章描述/摘要
+	Image        string         `json:"image"`         // 文章封面图片
+	Status       string         `json:"status"`        // 文章状态
+	CategoryID   string         `json:"category_id"`   // 分类 ID
+	CategoryName string         `json:"category_name"` // 分类名称
+	PublishAt    string         `json:"publish_at"`    // 发布时间，未设置时为空
+	Tags         []*PostTagItem `json:"tags"`          // 标签列表
+	Markdown     string         `json:"markdown"`      // Markdown内容
+	Message      string         `json:"message"`       // 更新结果消息
+
+	// SEO 相关，为空时使用文章对应字段
+	MetaTitle       string `json:"meta_title"`       // SEO 标题，为空时使用文章标题
+	MetaDescription string `json:"meta_description"` // SEO 描述，为空时使用文章描述
+	CanonicalURL    string `json:"canonical_url"`    // 规范链接，为空时使用文章页面地址
+	NoIndex         bool   `json:"no_index"`         // 是否禁止搜索引擎收录
+	OGImage         string `json:"og_image"`         // 分享卡片图片，为空时使用封面图片
 }
 
 // DeletePostResponse 删除文章响应
@@ -51,15 +108,30 @@ type DeletePostResponse struct {
 
 // PostItem 文章列表项
 type PostItem struct {
-	ID           string `json:"id"`            // 文章 ID
-	Title        string `json:"title"`         // 文章标题
-	Description  string `json:"description"`   // 文章描述/摘要
-	Image        string `json:"image"`         // 文章封面图片
-	Status       string `json:"status"`        // 文章状态
-	CategoryID   string `json:"category_id"`   // 分类 ID
-	CategoryName string `json:"category_name"` // 分类名称
-	CreatedAt    string `json:"created_at"`    // 创建时间
-	UpdatedAt    string `json:"updated_at"`    // 更新时间
+	ID             string         `json:"id"`              // 文章 ID
+	Title          string         `json:"title"`           // 文章标题
+	Slug           string         `json:"slug"`            // URL 别名
+	Description    string         `json:"description"`     // 文章描述/摘要
+	Image          string         `json:"image"`           // 文章封面图片
+	ImageSet       *ImageSet      `json:"image_set"`       // 封面图片响应式尺寸集合，封面不是媒体库图片时为 null
+	Status         string         `json:"status"`          // 文章状态
+	CategoryID     string         `json:"category_id"`     // 分类 ID
+	CategoryName   string         `json:"category_name"`   // 分类名称
+	AuthorID       string         `json:"author_id"`       // 作者用户 ID，作者未知时为空
+	AuthorNickname string         `json:"author_nickname"` // 作者昵称
+	AuthorAvatar   string         `json:"author_avatar"`   // 作者头像
+	PublishAt      string         `json:"publish_at"`      // 发布时间，未设置时为空
+	Tags           []*PostTagItem `json:"tags"`            // 标签列表
+	CreatedAt      string         `json:"created_at"`      // 创建时间
+	UpdatedAt      string         `json:"updated_at"`      // 更新时间
+
+	// 置顶、推荐与浏览量
+	PinScope    string `json:"pin_scope"`     // 置顶范围，未置顶或置顶已到期时为空
+	PinExpireAt string `json:"pin_expire_at"` // 置顶到期时间，永久置顶时为空
+	Featured    bool   `json:"featured"`      // 是否推荐
+	Views       int64  `json:"views"`         // 浏览量（按访客去重，定期写入，存在少量延迟）
+
+	Reactions []*ReactionCount `json:"reactions"` // 文章启用的表态类型及数量，按配置顺序排列
 }
 
 // ListPostsResponse 文章列表响应
@@ -69,3 +141,116 @@ type ListPostsResponse struct {
 	PageSize int64       `json:"page_size"` // 每页数量
 	List     []*PostItem `json:"list"`      // 文章列表
 }
+
+// SearchPostItem 文章检索结果项
+type SearchPostItem struct {
+	*PostItem
+	HighlightedTitle string `json:"highlighted_title"` // 高亮后的标题（HTML，关键字以 <mark> 标记）
+	Snippet          string `json:"snippet"`           // 正文摘要片段（HTML，关键字以 <mark> 标记）
+	Score            int64  `json:"score"`             // 相关度得分
+}
+
+// SearchPostsResponse 文章检索响应
+type SearchPostsResponse struct {
+	Total    int64             `json:"total"`     // 总数量
+	PageNo   int64             `json:"page_no"`   // 当前页码
+	PageSize int64             `json:"page_size"` // 每页数量
+	List     []*SearchPostItem `json:"list"`      // 检索结果列表
+}
+
+// PostRevisionItem 文章修订列表项
+type PostRevisionItem struct {
+	ID        string `json:"id"`         // 修订 ID
+	PostID    string `json:"post_id"`    // 文章 ID
+	Version   int64  `json:"version"`    // 修订版本号
+	Title     string `json:"title"`      // 标题快照
+	EditorID  string `json:"editor_id"`  // 修改者用户 ID，系统操作时为空
+	CreatedAt string `json:"created_at"` // 修订时间
+}
+
+// ListPostRevisionsResponse 文章修订列表响应
+type ListPostRevisionsResponse struct {
+	Total    int64               `json:"total"`     // 总数量
+	PageNo   int64               `json:"page_no"`   // 当前页码
+	PageSize int64               `json:"page_size"` // 每页数量
+	List     []*PostRevisionItem `json:"list"`      // 修订列表
+}
+
+// DiffPostRevisionsResponse 对比文章修订响应
+type DiffPostRevisionsResponse struct {
+	FromID      string `json:"from_id"`      // 旧修订 ID
+	ToID        string `json:"to_id"`        // 新修订 ID
+	FromVersion int64  `json:"from_version"` // 旧修订版本号
+	ToVersion   int64  `json:"to_version"`   // 新修订版本号
+	Diff        string `json:"diff"`         // 统一格式差异（unified diff）
+}
+
+// RestorePostRevisionResponse 恢复文章修订响应
+type RestorePostRevisionResponse struct {
+	PostID  string `json:"post_id"` // 文章 ID
+	Version int64  `json:"version"` // 恢复后生成的新修订版本号
+	Message string `json:"message"` // 恢复结果消息
+}
+
+// RerenderPostsResponse 重新渲染文章响应
+type RerenderPostsResponse struct {
+	Total   int64  `json:"total"`   // 处理的文章数量
+	Updated int64  `json:"updated"` // HTML 发生变化并已更新的文章数量
+	Failed  int64  `json:"failed"`  // 处理失败的文章数量
+	Message string `json:"message"` // 处理结果消息
+}
+
+// PinPostResponse 置顶文章响应
+type PinPostResponse struct {
+	ID          string `json:"id"`            // 文章 ID
+	PinScope    string `json:"pin_scope"`     // 置顶范围，取消置顶时为空
+	PinExpireAt string `json:"pin_expire_at"` // 置顶到期时间，永久置顶时为空
+	Message     string `json:"message"`       // 处理结果消息
+}
+
+// FeaturePostResponse 推荐文章响应
+type FeaturePostResponse struct {
+	ID       string `json:"id"`       // 文章 ID
+	Featured bool   `json:"featured"` // 是否推荐
+	Message  string `json:"message"`  // 处理结果消息
+}
+
+// ListPinnedPostsResponse 置顶文章列表响应
+type ListPinnedPostsResponse struct {
+	List []*PostItem `json:"list"` // 置顶文章列表，按置顶顺序排列
+}
+
+// PopularPostItem 热门文章列表项
+type PopularPostItem struct {
+	*PostItem
+	PeriodViews int64 `json:"period_views"` // 统计周期内的浏览量，全部时间时等于总浏览量
+}
+
+// ListPopularPostsResponse 热门文章列表响应
+type ListPopularPostsResponse struct {
+	List []*PopularPostItem `json:"list"` // 热门文章列表，按统计周期内的浏览量降序
+}
+
+// ReorderPinsResponse 调整置顶顺序响应
+type ReorderPinsResponse struct {
+	Message string `json:"message"` // 处理结果消息
+}
+
+// ImportPostsResponse 导入文章响应
+type ImportPostsResponse struct {
+	Total    int64             `json:"total"`    // 处理的条目数量
+	Imported int64             `json:"imported"` // 导入成功的文章数量
+	Failed   int64             `json:"failed"`   // 导入失败的条目数量
+	Items    []*ImportPostItem `json:"items"`    // 各条目导入结果
+	Message  string            `json:"message"`  // 导入结果消息
+}
+
+// ImportPostItem 导入文章条目结果
+type ImportPostItem struct {
+	Source string `json:"source"` // 来源，Markdown 为文件路径，WXR 为 文件名#文章 ID
+	Title  string `json:"title"`  // 文章标题，解析失败时为空
+	ID     string `json:"id"`     // 导入后的文章 ID，失败时为空
+	Slug   string `json:"slug"`   // 导入后的 URL 别名，失败时为空
+	Status string `json:"status"` // 导入后的文章状态，失败时为空
+	Error  string `json:"error"`  // 失败原因，成功时为空
+}
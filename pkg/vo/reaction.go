@@ -0,0 +1,52 @@
+// Package vo 文章表态相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package vo
+
+// ReactionCount 文章表态类型及数量
+type ReactionCount struct {
+	Type  string `json:"type"`  // 表态类型
+	Emoji string `json:"emoji"` // 表情符号
+	Count int64  `json:"count"` // 表态数量
+}
+
+// ReactionTypeItem 表态类型
+type ReactionTypeItem struct {
+	Type  string `json:"type"`  // 表态类型
+	Emoji string `json:"emoji"` // 表情符号
+}
+
+// ListReactionTypesResponse 获取已配置表态类型响应
+type ListReactionTypesResponse struct {
+	List []*ReactionTypeItem `json:"list"` // 表态类型列表，按配置顺序排列，为空时表态功能已关闭
+}
+
+// GetReactionsResponse 获取文章表态响应
+type GetReactionsResponse struct {
+	PostID    string           `json:"post_id"`   // 文章 ID
+	Reactions []*ReactionCount `json:"reactions"` // 文章启用的表态类型及数量
+	Reacted   []string         `json:"reacted"`   // 当前用户或访客已表态的类型
+}
+
+// ToggleReactionResponse 切换文章表态响应
+type ToggleReactionResponse struct {
+	PostID    string           `json:"post_id"`   // 文章 ID
+	Type      string           `json:"type"`      // 表态类型
+	Reacted   bool             `json:"reacted"`   // 切换后是否处于已表态状态
+	Reactions []*ReactionCount `json:"reactions"` // 切换后文章启用的表态类型及数量
+}
+
+// ReactedPostItem 表态报表文章项
+type ReactedPostItem struct {
+	ID          string           `json:"id"`           // 文章 ID
+	Title       string           `json:"title"`        // 文章标题
+	Slug        string           `json:"slug"`         // URL 别名
+	PublishAt   string           `json:"publish_at"`   // 发布时间
+	PeriodCount int64            `json:"period_count"` // 统计周期内符合条件的表态数量
+	Reactions   []*ReactionCount `json:"reactions"`    // 文章全部时间内各已配置表态类型的数量
+}
+
+// ReactionReportResponse 表态最多文章报表响应
+type ReactionReportResponse struct {
+	List []*ReactedPostItem `json:"list"` // 文章列表，按统计周期内的表态数量降序
+}
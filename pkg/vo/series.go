@@ -0,0 +1,73 @@
+// Package vo 文章系列相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package vo
+
+// CreateSeriesResponse 创建系列响应
+type CreateSeriesResponse struct {
+	ID          string `json:"id"`          // 系列 ID
+	Name        string `json:"name"`        // 系列名称
+	Description string `json:"description"` // 系列描述
+	PostCount   int64  `json:"post_count"`  // 文章数量
+	Message     string `json:"message"`     // 创建结果消息
+}
+
+// GetSeriesResponse 获取系列响应
+type GetSeriesResponse struct {
+	ID          string            `json:"id"`          // 系列 ID
+	Name        string            `json:"name"`        // 系列名称
+	Description string            `json:"description"` // 系列描述
+	Posts       []*SeriesPostItem `json:"posts"`       // 公开文章列表，按系列顺序排列
+	CreatedAt   string            `json:"created_at"`  // 创建时间
+	UpdatedAt   string            `json:"updated_at"`  // 更新时间
+}
+
+// UpdateSeriesResponse 更新系列响应
+type UpdateSeriesResponse struct {
+	ID          string `json:"id"`          // 系列 ID
+	Name        string `json:"name"`        // 系列名称
+	Description string `json:"description"` // 系列描述
+	PostCount   int64  `json:"post_count"`  // 文章数量
+	Message     string `json:"message"`     // 更新结果消息
+}
+
+// DeleteSeriesResponse 删除系列响应
+type DeleteSeriesResponse struct {
+	Message string `json:"message"` // 删除结果消息
+}
+
+// SeriesItem 系列列表项
+type SeriesItem struct {
+	ID          string `json:"id"`          // 系列 ID
+	Name        string `json:"name"`        // 系列名称
+	Description string `json:"description"` // 系列描述
+	PostCount   int64  `json:"post_count"`  // 文章数量（含未公开文章）
+	CreatedAt   string `json:"created_at"`  // 创建时间
+	UpdatedAt   string `json:"updated_at"`  // 更新时间
+}
+
+// ListSeriesResponse 系列列表响应
+type ListSeriesResponse struct {
+	Total    int64         `json:"total"`     // 总数量
+	PageNo   int64         `json:"page_no"`   // 当前页码
+	PageSize int64         `json:"page_size"` // 每页数量
+	List     []*SeriesItem `json:"list"`      // 系列列表
+}
+
+// SeriesPostItem 系列中的文章
+type SeriesPostItem struct {
+	ID       string `json:"id"`       // 文章 ID
+	Title    string `json:"title"`    // 文章标题
+	Slug     string `json:"slug"`     // URL 别名
+	Position int    `json:"position"` // 在系列中的位置，从 1 开始
+}
+
+// PostSeriesInfo 文章所属系列信息
+type PostSeriesInfo struct {
+	ID       string          `json:"id"`       // 系列 ID
+	Name     string          `json:"name"`     // 系列名称
+	Position int             `json:"position"` // 当前文章在系列中的位置，从 1 开始
+	Total    int             `json:"total"`    // 系列中的文章数量
+	Prev     *SeriesPostItem `json:"prev"`     // 上一篇，当前为第一篇时为 null
+	Next     *SeriesPostItem `json:"next"`     // 下一篇，当前为最后一篇时为 null
+}
@@ -0,0 +1,19 @@
+// Package vo 站点地图相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package vo
+
+import "time"
+
+// SitemapResponse 站点地图响应
+type SitemapResponse struct {
+	Content      []byte    // 站点地图内容
+	ContentType  string    // 内容类型
+	ETag         string    // 实体标签，用于条件请求
+	LastModified time.Time // 最近修改时间，无内容时为零值
+}
+
+// RobotsResponse robots.txt 响应
+type RobotsResponse struct {
+	Content string // robots.txt 内容
+}
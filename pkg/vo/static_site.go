@@ -0,0 +1,15 @@
+// Package vo 静态站点相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-17
+package vo
+
+// BuildStaticSiteResponse 生成静态站点响应
+type BuildStaticSiteResponse struct {
+	OutputDir  string `json:"output_dir"` // 输出目录
+	Theme      string `json:"theme"`      // 使用的前端主题 ID
+	Pages      int64  `json:"pages"`      // 生成的页面数量
+	Posts      int64  `json:"posts"`      // 生成的文章页面数量
+	Categories int64  `json:"categories"` // 生成的分类页面数量
+	Files      int64  `json:"files"`      // 写入的文件总数（含主题静态资源与媒体文件）
+	Message    string `json:"message"`    // 生成结果消息
+}
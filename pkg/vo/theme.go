@@ -41,3 +41,45 @@ type ListThemesResponse struct {
 	PageSize int64              `json:"page_size"` // 每页大小
 	List     []GetThemeResponse `json:"list"`      // 主题列表
 }
+
+// RenderThemePageResponse 主题服务端渲染页面响应
+type RenderThemePageResponse struct {
+	StatusCode  int    `json:"status_code"`  // HTTP 状态码
+	Body        []byte `json:"body"`         // 渲染后的 HTML
+	RedirectURL string `json:"redirect_url"` // 非空时永久重定向到该地址（如文章旧别名）
+}
+
+// ThemePageData 主题页面模板数据
+type ThemePageData struct {
+	Page        string               `json:"page"`        // 页面类型（index/post/category/not_found）
+	Path        string               `json:"path"`        // 请求路径
+	Title       string               `json:"title"`       // 页面标题
+	Description string               `json:"description"` // 页面描述
+	Canonical   string               `json:"canonical"`   // 页面规范链接
+	NoIndex     bool                 `json:"no_index"`    // 是否禁止搜索引擎收录，仅文章页
+	Head        string               `json:"head"`        // 文章 SEO 元数据标签（描述、规范链接、Open Graph、Twitter Card 与 JSON-LD），模板中通过 safeHTML 输出，仅文章页
+	Site        *ThemeSiteData       `json:"site"`        // 站点信息
+	Categories  []*CategoryItem      `json:"categories"`  // 启用的分类列表，供导航使用
+	Post        *GetPostResponse     `json:"post"`        // 当前文章，仅文章页
+	Category    *GetCategoryResponse `json:"category"`    // 当前分类，仅分类页
+	Posts       *ListPostsResponse   `json:"posts"`       // 文章列表，仅首页与分类页
+	Pagination  *ThemePagination     `json:"pagination"`  // 分页信息，仅首页与分类页
+}
+
+// ThemeSiteData 主题模板中的站点信息
+type ThemeSiteData struct {
+	URL         string `json:"url"`         // 站点访问地址
+	Title       string `json:"title"`       // 站点标题
+	Description string `json:"description"` // 站点描述
+	Language    string `json:"language"`    // 站点语言
+}
+
+// ThemePagination 主题模板中的分页信息
+type ThemePagination struct {
+	PageNo     int64  `json:"page_no"`     // 当前页码
+	PageSize   int64  `json:"page_size"`   // 每页数量
+	Total      int64  `json:"total"`       // 文章总数
+	TotalPages int64  `json:"total_pages"` // 总页数
+	PrevURL    string `json:"prev_url"`    // 上一页站内链接，没有上一页时为空
+	NextURL    string `json:"next_url"`    // 下一页站内链接，没有下一页时为空
+}
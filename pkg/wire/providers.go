@@ -13,6 +13,11 @@ var MapperProviderSet = wire.NewSet(
 	mapperImpl.NewRBACMapper,
 	mapperImpl.NewPostMapper,
 	mapperImpl.NewCategoryMapper,
+	mapperImpl.NewCommentMapper,
+	mapperImpl.NewTagMapper,
+	mapperImpl.NewMediaMapper,
+	mapperImpl.NewSeriesMapper,
+	mapperImpl.NewReactionMapper,
 )
 
 // ServiceProviderSet 服务相关的 Provider 集合
@@ -24,6 +29,14 @@ var ServiceProviderSet = wire.NewSet(
 	serviceImpl.NewVerificationService,
 	serviceImpl.NewPostService,
 	serviceImpl.NewCategoryService,
+	serviceImpl.NewCommentService,
+	serviceImpl.NewTagService,
+	serviceImpl.NewFeedService,
+	serviceImpl.NewSitemapService,
+	serviceImpl.NewMediaService,
+	serviceImpl.NewStaticSiteService,
+	serviceImpl.NewSeriesService,
+	serviceImpl.NewReactionService,
 )
 
 // AllProviderSet 所有 Provider 的集合
@@ -7,6 +7,7 @@ import (
 	"github.com/google/wire"
 
 	"github.com/Done-0/jank/pkg/serve/controller"
+	"github.com/Done-0/jank/pkg/serve/service"
 )
 
 // NewPluginController 使用 Wire 初始化插件控制器
@@ -64,3 +65,73 @@ func NewCategoryController() (*controller.CategoryController, error) {
 		controller.NewCategoryController,
 	))
 }
+
+// NewCommentController 使用 Wire 初始化评论控制器
+func NewCommentController() (*controller.CommentController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewCommentController,
+	))
+}
+
+// NewTagController 使用 Wire 初始化标签控制器
+func NewTagController() (*controller.TagController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewTagController,
+	))
+}
+
+// NewSeriesController 使用 Wire 初始化文章系列控制器
+func NewSeriesController() (*controller.SeriesController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewSeriesController,
+	))
+}
+
+// NewReactionController 使用 Wire 初始化文章表态控制器
+func NewReactionController() (*controller.ReactionController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewReactionController,
+	))
+}
+
+// NewFeedController 使用 Wire 初始化订阅源控制器
+func NewFeedController() (*controller.FeedController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewFeedController,
+	))
+}
+
+// NewSitemapController 使用 Wire 初始化站点地图控制器
+func NewSitemapController() (*controller.SitemapController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewSitemapController,
+	))
+}
+
+// NewMediaController 使用 Wire 初始化媒体文件控制器
+func NewMediaController() (*controller.MediaController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewMediaController,
+	))
+}
+
+// NewPostService 使用 Wire 初始化文章服务，供命令行工具使用
+func NewPostService() (service.PostService, error) {
+	panic(wire.Build(
+		AllProviderSet,
+	))
+}
+
+// NewStaticSiteService 使用 Wire 初始化静态站点服务，供命令行工具使用
+func NewStaticSiteService() (service.StaticSiteService, error) {
+	panic(wire.Build(
+		AllProviderSet,
+	))
+}
@@ -9,6 +9,7 @@ package wire
 import (
 	"github.com/Done-0/jank/pkg/serve/controller"
 	impl2 "github.com/Done-0/jank/pkg/serve/mapper/impl"
+	"github.com/Done-0/jank/pkg/serve/service"
 	"github.com/Done-0/jank/pkg/serve/service/impl"
 )
 
@@ -32,7 +33,17 @@ func NewRBACController() (*controller.RBACController, error) {
 
 // NewThemeController 使用 Wire 初始化主题控制器
 func NewThemeController() (*controller.ThemeController, error) {
-	themeService := impl.NewThemeService()
+	postMapper := impl2.NewPostMapper()
+	categoryMapper := impl2.NewCategoryMapper()
+	tagMapper := impl2.NewTagMapper()
+	userMapper := impl2.NewUserMapper()
+	rbacMapper := impl2.NewRBACMapper()
+	mediaMapper := impl2.NewMediaMapper()
+	seriesMapper := impl2.NewSeriesMapper()
+	reactionMapper := impl2.NewReactionMapper()
+	postService := impl.NewPostService(postMapper, categoryMapper, tagMapper, userMapper, rbacMapper, mediaMapper, seriesMapper, reactionMapper)
+	categoryService := impl.NewCategoryService(categoryMapper)
+	themeService := impl.NewThemeService(postService, categoryService)
 	themeController := controller.NewThemeController(themeService)
 	return themeController, nil
 }
@@ -57,7 +68,13 @@ func NewVerificationController() (*controller.VerificationController, error) {
 func NewPostController() (*controller.PostController, error) {
 	postMapper := impl2.NewPostMapper()
 	categoryMapper := impl2.NewCategoryMapper()
-	postService := impl.NewPostService(postMapper, categoryMapper)
+	tagMapper := impl2.NewTagMapper()
+	userMapper := impl2.NewUserMapper()
+	rbacMapper := impl2.NewRBACMapper()
+	mediaMapper := impl2.NewMediaMapper()
+	seriesMapper := impl2.NewSeriesMapper()
+	reactionMapper := impl2.NewReactionMapper()
+	postService := impl.NewPostService(postMapper, categoryMapper, tagMapper, userMapper, rbacMapper, mediaMapper, seriesMapper, reactionMapper)
 	postController := controller.NewPostController(postService)
 	return postController, nil
 }
@@ -69,3 +86,103 @@ func NewCategoryController() (*controller.CategoryController, error) {
 	categoryController := controller.NewCategoryController(categoryService)
 	return categoryController, nil
 }
+
+// NewCommentController 使用 Wire 初始化评论控制器
+func NewCommentController() (*controller.CommentController, error) {
+	commentMapper := impl2.NewCommentMapper()
+	postMapper := impl2.NewPostMapper()
+	userMapper := impl2.NewUserMapper()
+	rbacMapper := impl2.NewRBACMapper()
+	commentService := impl.NewCommentService(commentMapper, postMapper, userMapper, rbacMapper)
+	commentController := controller.NewCommentController(commentService)
+	return commentController, nil
+}
+
+// NewTagController 使用 Wire 初始化标签控制器
+func NewTagController() (*controller.TagController, error) {
+	tagMapper := impl2.NewTagMapper()
+	tagService := impl.NewTagService(tagMapper)
+	tagController := controller.NewTagController(tagService)
+	return tagController, nil
+}
+
+// NewSeriesController 使用 Wire 初始化文章系列控制器
+func NewSeriesController() (*controller.SeriesController, error) {
+	seriesMapper := impl2.NewSeriesMapper()
+	postMapper := impl2.NewPostMapper()
+	seriesService := impl.NewSeriesService(seriesMapper, postMapper)
+	seriesController := controller.NewSeriesController(seriesService)
+	return seriesController, nil
+}
+
+// NewReactionController 使用 Wire 初始化文章表态控制器
+func NewReactionController() (*controller.ReactionController, error) {
+	reactionMapper := impl2.NewReactionMapper()
+	postMapper := impl2.NewPostMapper()
+	rbacMapper := impl2.NewRBACMapper()
+	reactionService := impl.NewReactionService(reactionMapper, postMapper, rbacMapper)
+	reactionController := controller.NewReactionController(reactionService)
+	return reactionController, nil
+}
+
+// NewFeedController 使用 Wire 初始化订阅源控制器
+func NewFeedController() (*controller.FeedController, error) {
+	postMapper := impl2.NewPostMapper()
+	categoryMapper := impl2.NewCategoryMapper()
+	tagMapper := impl2.NewTagMapper()
+	userMapper := impl2.NewUserMapper()
+	feedService := impl.NewFeedService(postMapper, categoryMapper, tagMapper, userMapper)
+	feedController := controller.NewFeedController(feedService)
+	return feedController, nil
+}
+
+// NewSitemapController 使用 Wire 初始化站点地图控制器
+func NewSitemapController() (*controller.SitemapController, error) {
+	postMapper := impl2.NewPostMapper()
+	categoryMapper := impl2.NewCategoryMapper()
+	sitemapService := impl.NewSitemapService(postMapper, categoryMapper)
+	sitemapController := controller.NewSitemapController(sitemapService)
+	return sitemapController, nil
+}
+
+// NewMediaController 使用 Wire 初始化媒体文件控制器
+func NewMediaController() (*controller.MediaController, error) {
+	mediaMapper := impl2.NewMediaMapper()
+	rbacMapper := impl2.NewRBACMapper()
+	mediaService := impl.NewMediaService(mediaMapper, rbacMapper)
+	mediaController := controller.NewMediaController(mediaService)
+	return mediaController, nil
+}
+
+// NewPostService 使用 Wire 初始化文章服务，供命令行工具使用
+func NewPostService() (service.PostService, error) {
+	postMapper := impl2.NewPostMapper()
+	categoryMapper := impl2.NewCategoryMapper()
+	tagMapper := impl2.NewTagMapper()
+	userMapper := impl2.NewUserMapper()
+	rbacMapper := impl2.NewRBACMapper()
+	mediaMapper := impl2.NewMediaMapper()
+	seriesMapper := impl2.NewSeriesMapper()
+	reactionMapper := impl2.NewReactionMapper()
+	postService := impl.NewPostService(postMapper, categoryMapper, tagMapper, userMapper, rbacMapper, mediaMapper, seriesMapper, reactionMapper)
+	return postService, nil
+}
+
+// NewStaticSiteService 使用 Wire 初始化静态站点服务，供命令行工具使用
+func NewStaticSiteService() (service.StaticSiteService, error) {
+	postMapper := impl2.NewPostMapper()
+	categoryMapper := impl2.NewCategoryMapper()
+	tagMapper := impl2.NewTagMapper()
+	userMapper := impl2.NewUserMapper()
+	rbacMapper := impl2.NewRBACMapper()
+	mediaMapper := impl2.NewMediaMapper()
+	seriesMapper := impl2.NewSeriesMapper()
+	reactionMapper := impl2.NewReactionMapper()
+	postService := impl.NewPostService(postMapper, categoryMapper, tagMapper, userMapper, rbacMapper, mediaMapper, seriesMapper, reactionMapper)
+	categoryService := impl.NewCategoryService(categoryMapper)
+	feedService := impl.NewFeedService(postMapper, categoryMapper, tagMapper, userMapper)
+	sitemapService := impl.NewSitemapService(postMapper, categoryMapper)
+	mediaService := impl.NewMediaService(mediaMapper, rbacMapper)
+	staticSiteService := impl.NewStaticSiteService(postService, categoryService, feedService, sitemapService, mediaService)
+	return staticSiteService, nil
+}
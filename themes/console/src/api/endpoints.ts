@@ -63,11 +63,25 @@ export const PLUGIN_ENDPOINTS = {
 // ===== 文章相关 =====
 export const POST_ENDPOINTS = {
   GET_POST: "/api/v1/post/get",
+  GET_POST_BY_SLUG: "/api/v1/post/get-by-slug",
   LIST_PUBLISHED_POSTS: "/api/v1/post/list-published",
+  LIST_POSTS_BY_AUTHOR: "/api/v1/post/list-by-author",
+  SEARCH_POSTS: "/api/v1/post/search",
   LIST_POSTS_BY_STATUS: "/api/v1/post/list-by-status",
   CREATE_POST: "/api/v1/post/create",
   UPDATE_POST: "/api/v1/post/update",
   DELETE_POST: "/api/v1/post/delete",
+  RERENDER_POSTS: "/api/v1/post/rerender",
+  IMPORT_POSTS: "/api/v1/post/import",
+  PIN_POST: "/api/v1/post/pin",
+  REORDER_PINNED_POSTS: "/api/v1/post/pin/reorder",
+  FEATURE_POST: "/api/v1/post/feature",
+  LIST_PINNED_POSTS: "/api/v1/post/list-pinned",
+  LIST_POPULAR_POSTS_WEEK: "/api/v1/post/popular/week",
+  LIST_POPULAR_POSTS_ALL_TIME: "/api/v1/post/popular/all-time",
+  LIST_POST_REVISIONS: "/api/v1/post/revision/list",
+  DIFF_POST_REVISIONS: "/api/v1/post/revision/diff",
+  RESTORE_POST_REVISION: "/api/v1/post/revision/restore",
 } as const;
 
 // ===== 分类相关 =====
@@ -79,3 +93,45 @@ export const CATEGORY_ENDPOINTS = {
   DELETE_CATEGORY: "/api/v1/category/delete",
 } as const;
 
+
+// ===== 评论相关 =====
+export const COMMENT_ENDPOINTS = {
+  LIST_COMMENTS: "/api/v1/comment/list",
+  LIST_COMMENTS_BY_STATUS: "/api/v1/comment/list-by-status",
+  CREATE_COMMENT: "/api/v1/comment/create",
+  MODERATE_COMMENT: "/api/v1/comment/moderate",
+  DELETE_COMMENT: "/api/v1/comment/delete",
+} as const;
+
+export const TAG_ENDPOINTS = {
+  GET_TAG: "/api/v1/tag/get",
+  LIST_TAGS: "/api/v1/tag/list",
+  GET_TAG_CLOUD: "/api/v1/tag/cloud",
+  CREATE_TAG: "/api/v1/tag/create",
+  UPDATE_TAG: "/api/v1/tag/update",
+  DELETE_TAG: "/api/v1/tag/delete",
+} as const;
+
+// ===== 文章系列相关 =====
+export const SERIES_ENDPOINTS = {
+  GET_SERIES: "/api/v1/series/get",
+  LIST_SERIES: "/api/v1/series/list",
+  CREATE_SERIES: "/api/v1/series/create",
+  UPDATE_SERIES: "/api/v1/series/update",
+  DELETE_SERIES: "/api/v1/series/delete",
+} as const;
+
+// ===== 文章表态相关 =====
+export const REACTION_ENDPOINTS = {
+  LIST_REACTION_TYPES: "/api/v1/reaction/types",
+  GET_REACTIONS: "/api/v1/reaction/get",
+  TOGGLE_REACTION: "/api/v1/reaction/toggle",
+  GET_REACTION_REPORT: "/api/v1/reaction/report",
+} as const;
+
+// ===== 媒体文件相关 =====
+export const MEDIA_ENDPOINTS = {
+  UPLOAD_MEDIA: "/api/v1/media/upload",
+  LIST_MEDIA: "/api/v1/media/list",
+  DELETE_MEDIA: "/api/v1/media/delete",
+} as const;
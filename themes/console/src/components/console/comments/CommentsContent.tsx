@@ -0,0 +1,381 @@
+/**
+ * 评论审核列表内容组件
+ * 负责评论列表展示、搜索、分页及审核操作
+ */
+
+import { useMemo } from "react";
+
+import { Button } from "@/components/ui/button";
+import { Badge } from "@/components/ui/badge";
+import { Input } from "@/components/ui/input";
+import { ConfirmDialog } from "@/components/ui/confirm-dialog";
+import {
+  DropdownMenu,
+  DropdownMenuContent,
+  DropdownMenuItem,
+  DropdownMenuSeparator,
+  DropdownMenuTrigger,
+} from "@/components/ui/dropdown-menu";
+
+import {
+  Search,
+  Check,
+  Clock,
+  ShieldAlert,
+  Trash2,
+  ChevronLeft,
+  ChevronRight,
+  MessageSquare,
+  MoreHorizontal,
+} from "lucide-react";
+
+import type { AdminCommentItem, CommentStatus } from "@/types/comment";
+
+interface CommentsContentProps {
+  // 状态数据
+  selectedStatus: CommentStatus | null;
+  searchQuery: string;
+  comments: AdminCommentItem[];
+  total: number;
+  currentPage: number;
+  pageSize: number;
+  isLoading: boolean;
+
+  // 操作方法
+  onStatusChange: (status: CommentStatus | null) => void;
+  onSearchChange: (query: string) => void;
+  onPageChange: (page: number) => void;
+  onModerate: (comment: AdminCommentItem, status: CommentStatus) => void;
+  onDelete: (comment: AdminCommentItem) => void;
+}
+
+export function CommentsContent({
+  selectedStatus,
+  searchQuery,
+  comments,
+  total,
+  currentPage,
+  pageSize,
+  isLoading,
+  onStatusChange,
+  onSearchChange,
+  onPageChange,
+  onModerate,
+  onDelete,
+}: CommentsContentProps) {
+  // ===== 工具函数 =====
+  const getStatusBadge = (status: CommentStatus) => {
+    const statusConfig: Record<
+      CommentStatus,
+      {
+        variant: "default" | "secondary" | "outline" | "destructive";
+        label: string;
+      }
+    > = {
+      pending: { variant: "secondary", label: "待审核" },
+      approved: { variant: "default", label: "已通过" },
+      spam: { variant: "destructive", label: "垃圾评论" },
+    };
+    return statusConfig[status] || { variant: "outline", label: status };
+  };
+
+  const getStatusLabel = (status: CommentStatus | null) =>
+    status ? getStatusBadge(status).label : "";
+
+  const formatDate = (dateStr: string) => {
+    return new Date(dateStr).toLocaleString();
+  };
+
+  // ===== 分页器工具函数 =====
+  const totalPages = Math.ceil(total / pageSize);
+  const hasPrevPage = currentPage > 1;
+  const hasNextPage = currentPage < totalPages;
+
+  const createPageButton = (pageNum: number, isActive = false) => (
+    <Button
+      key={pageNum}
+      variant={isActive ? "default" : "outline"}
+      size="sm"
+      className="h-8 w-8 p-0"
+      onClick={() => onPageChange(pageNum)}
+    >
+      {pageNum}
+    </Button>
+  );
+
+  const generatePageNumbers = () => {
+    const pages = [];
+    const isMobile = typeof window !== "undefined" && window.innerWidth < 640;
+    const maxPages = isMobile ? 3 : 5;
+
+    if (totalPages <= maxPages) {
+      for (let i = 1; i <= totalPages; i++) {
+        pages.push(createPageButton(i, i === currentPage));
+      }
+    } else {
+      pages.push(createPageButton(1, currentPage === 1));
+
+      if (currentPage > 3) {
+        pages.push(
+          <span key="ellipsis1" className="px-1 text-muted-foreground text-xs">
+            …
+          </span>
+        );
+      }
+
+      const start = Math.max(2, currentPage - 1);
+      const end = Math.min(totalPages - 1, currentPage + 1);
+
+      for (let i = start; i <= end; i++) {
+        pages.push(createPageButton(i, i === currentPage));
+      }
+
+      if (currentPage < totalPages - 2) {
+        pages.push(
+          <span key="ellipsis2" className="px-1 text-muted-foreground text-xs">
+            …
+          </span>
+        );
+      }
+
+      pages.push(createPageButton(totalPages, currentPage === totalPages));
+    }
+
+    return pages;
+  };
+
+  // ===== 数据计算 =====
+  const filteredComments = useMemo(() => {
+    if (!searchQuery.trim()) return comments;
+
+    const query = searchQuery.toLowerCase();
+    return comments.filter(
+      (comment) =>
+        comment.content.toLowerCase().includes(query) ||
+        comment.nickname.toLowerCase().includes(query) ||
+        comment.email.toLowerCase().includes(query) ||
+        comment.post_title.toLowerCase().includes(query)
+    );
+  }, [comments, searchQuery]);
+
+  const statusTabs = [
+    { value: null, label: "全部" },
+    { value: "pending", label: "待审核" },
+    { value: "approved", label: "已通过" },
+    { value: "spam", label: "垃圾" },
+  ] as const;
+
+  const getTabClassName = (status: CommentStatus | null) => {
+    const isActive = selectedStatus === status;
+    return `flex-1 py-3 text-sm font-medium border-b-2 transition-colors ${
+      isActive
+        ? "border-primary text-primary"
+        : "border-transparent text-muted-foreground hover:text-foreground"
+    }`;
+  };
+
+  return (
+    <div className="flex-1 flex flex-col h-full">
+      {/* 移动端状态导航 */}
+      <div className="md:hidden border-b bg-background">
+        <div className="flex">
+          {statusTabs.map(({ value, label }) => (
+            <button
+              key={value || "all"}
+              className={getTabClassName(value)}
+              onClick={() => onStatusChange(value)}
+            >
+              {label}
+            </button>
+          ))}
+        </div>
+      </div>
+
+      {/* 顶部操作栏 */}
+      <div className="px-4 py-4 border-b">
+        <div className="relative sm:w-80">
+          <Search className="absolute left-3 top-1/2 -translate-y-1/2 h-4 w-4 text-muted-foreground" />
+          <Input
+            placeholder="搜索评论..."
+            className="pl-9 h-10 rounded-full"
+            value={searchQuery}
+            onChange={(e) => onSearchChange(e.target.value)}
+          />
+        </div>
+      </div>
+
+      {/* 评论列表区域 */}
+      <div className="flex-1 flex flex-col overflow-hidden w-full">
+        {isLoading ? (
+          <div className="flex items-center justify-center h-full">
+            <div className="text-center">
+              <div className="animate-spin rounded-full h-8 w-8 border-b-2 border-primary mx-auto mb-4"></div>
+              <p className="text-sm text-muted-foreground">加载中...</p>
+            </div>
+          </div>
+        ) : filteredComments.length > 0 ? (
+          <>
+            <div className="flex-1 overflow-y-scroll scrollbar-hidden">
+              <div className="divide-y divide-border">
+                {filteredComments.map((comment) => (
+                  <div
+                    key={comment.id}
+                    className="px-4 py-4 hover:bg-accent/50 transition-colors"
+                  >
+                    <div className="flex flex-col gap-3">
+                      <div className="flex items-start justify-between gap-3">
+                        <div className="flex-1 min-w-0">
+                          <h3 className="font-medium truncate">
+                            {comment.nickname}
+                            {comment.email && (
+                              <span className="ml-2 text-sm font-normal text-muted-foreground">
+                                {comment.email}
+                              </span>
+                            )}
+                          </h3>
+                          <p className="text-xs text-muted-foreground truncate">
+                            评论于《{comment.post_title || "未知文章"}》
+                          </p>
+                        </div>
+                        <Badge
+                          variant={getStatusBadge(comment.status).variant}
+                          className="shrink-0"
+                        >
+                          {getStatusBadge(comment.status).label}
+                        </Badge>
+                      </div>
+
+                      <p className="text-sm whitespace-pre-wrap break-words line-clamp-4">
+                        {comment.content}
+                      </p>
+
+                      <div className="flex items-center justify-between gap-3">
+                        <div className="flex items-center gap-2 text-xs text-muted-foreground">
+                          <div className="flex items-center gap-1.5">
+                            <div className="w-1.5 h-1.5 rounded-full bg-slate-400" />
+                            <span>{formatDate(comment.created_at)}</span>
+                          </div>
+                          {comment.ip && (
+                            <div className="hidden sm:flex items-center gap-1.5">
+                              <div className="w-1.5 h-1.5 rounded-full bg-slate-400" />
+                              <span>{comment.ip}</span>
+                            </div>
+                          )}
+                        </div>
+
+                        <DropdownMenu>
+                          <DropdownMenuTrigger asChild>
+                            <Button
+                              variant="ghost"
+                              size="sm"
+                              className="h-8 w-8 p-0 rounded-full"
+                            >
+                              <MoreHorizontal className="h-4 w-4" />
+                            </Button>
+                          </DropdownMenuTrigger>
+                          <DropdownMenuContent align="end" className="w-40">
+                            {comment.status !== "approved" && (
+                              <DropdownMenuItem
+                                className="cursor-pointer"
+                                onClick={() => onModerate(comment, "approved")}
+                              >
+                                <Check className="mr-2 h-4 w-4" />
+                                通过评论
+                              </DropdownMenuItem>
+                            )}
+                            {comment.status !== "pending" && (
+                              <DropdownMenuItem
+                                className="cursor-pointer"
+                                onClick={() => onModerate(comment, "pending")}
+                              >
+                                <Clock className="mr-2 h-4 w-4" />
+                                退回待审核
+                              </DropdownMenuItem>
+                            )}
+                            {comment.status !== "spam" && (
+                              <DropdownMenuItem
+                                className="cursor-pointer"
+                                onClick={() => onModerate(comment, "spam")}
+                              >
+                                <ShieldAlert className="mr-2 h-4 w-4" />
+                                标记为垃圾
+                              </DropdownMenuItem>
+                            )}
+                            <DropdownMenuSeparator />
+                            <ConfirmDialog
+                              title="删除评论"
+                              description={`确定要删除"${comment.nickname}"的这条评论吗？其下的回复将一并删除，此操作不可撤销。`}
+                              onConfirm={() => onDelete(comment)}
+                              destructive
+                            >
+                              <DropdownMenuItem
+                                className="cursor-pointer text-destructive focus:text-destructive"
+                                onSelect={(e) => e.preventDefault()}
+                              >
+                                <Trash2 className="mr-2 h-4 w-4" />
+                                删除评论
+                              </DropdownMenuItem>
+                            </ConfirmDialog>
+                          </DropdownMenuContent>
+                        </DropdownMenu>
+                      </div>
+                    </div>
+                  </div>
+                ))}
+              </div>
+            </div>
+
+            {/* 分页控件 */}
+            <div className="flex flex-col sm:flex-row items-center justify-between px-3 sm:px-4 lg:px-5 py-3 border-t gap-3 sm:gap-0">
+              <div className="text-sm text-muted-foreground order-2 sm:order-1">
+                共 {total} 条记录
+              </div>
+
+              <div className="flex items-center gap-1 order-1 sm:order-2">
+                <Button
+                  variant="outline"
+                  size="sm"
+                  className="h-8 w-8 p-0"
+                  onClick={() => onPageChange(Math.max(1, currentPage - 1))}
+                  disabled={!hasPrevPage}
+                >
+                  <ChevronLeft className="h-4 w-4" />
+                </Button>
+
+                <div className="flex items-center gap-1 mx-2">
+                  {generatePageNumbers()}
+                </div>
+
+                <Button
+                  variant="outline"
+                  size="sm"
+                  className="h-8 w-8 p-0"
+                  onClick={() =>
+                    onPageChange(Math.min(totalPages, currentPage + 1))
+                  }
+                  disabled={!hasNextPage}
+                >
+                  <ChevronRight className="h-4 w-4" />
+                </Button>
+              </div>
+            </div>
+          </>
+        ) : (
+          <div className="flex-1 overflow-auto p-4">
+            <div className="text-center py-12 border-2 border-dashed border-muted-foreground/30 rounded-lg">
+              <MessageSquare className="h-12 w-12 text-muted-foreground mx-auto mb-4" />
+              <h3 className="text-lg font-medium mb-2">暂无评论</h3>
+              <p className="text-muted-foreground">
+                {searchQuery
+                  ? `未找到包含"${searchQuery}"的评论`
+                  : selectedStatus
+                  ? `暂无${getStatusLabel(selectedStatus)}的评论`
+                  : "暂无任何评论"}
+              </p>
+            </div>
+          </div>
+        )}
+      </div>
+    </div>
+  );
+}
@@ -0,0 +1,71 @@
+import { MessageSquare, Clock, CheckCircle, ShieldAlert } from "lucide-react";
+
+import type { CommentStatus } from "@/types/comment";
+
+interface CommentsSidebarProps {
+  selectedStatus: CommentStatus | null;
+  stats: {
+    total: number;
+    pending: number;
+    approved: number;
+    spam: number;
+  };
+  onStatusChange: (status: CommentStatus | null) => void;
+}
+
+export function CommentsSidebar({
+  selectedStatus,
+  stats,
+  onStatusChange,
+}: CommentsSidebarProps) {
+  const statusItems = [
+    { status: null, icon: MessageSquare, label: "全部", count: stats.total },
+    {
+      status: "pending" as CommentStatus,
+      icon: Clock,
+      label: "待审核",
+      count: stats.pending,
+    },
+    {
+      status: "approved" as CommentStatus,
+      icon: CheckCircle,
+      label: "已通过",
+      count: stats.approved,
+    },
+    {
+      status: "spam" as CommentStatus,
+      icon: ShieldAlert,
+      label: "垃圾评论",
+      count: stats.spam,
+    },
+  ];
+
+  return (
+    <div className="w-64 lg:w-72 border-r bg-background hidden md:flex">
+      <div className="h-full flex flex-col w-full">
+        {/* 状态筛选 */}
+        <div className="px-4 py-4">
+          <div className="space-y-0.5">
+            {statusItems.map(({ status, icon: Icon, label, count }) => (
+              <button
+                key={status || "all"}
+                onClick={() => onStatusChange(status)}
+                className={`w-full flex items-center gap-3 px-3 py-2.5 rounded-full text-left transition-colors ${
+                  selectedStatus === status
+                    ? "bg-accent text-accent-foreground font-medium"
+                    : "hover:bg-accent/50 text-foreground"
+                }`}
+              >
+                <Icon className="h-5 w-5 flex-shrink-0" />
+                <span className="flex-1 text-sm">{label}</span>
+                <span className="text-xs text-muted-foreground font-medium">
+                  {count}
+                </span>
+              </button>
+            ))}
+          </div>
+        </div>
+      </div>
+    </div>
+  );
+}
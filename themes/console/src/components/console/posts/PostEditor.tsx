@@ -54,6 +54,7 @@ export function PostEditor({
   const [image, setImage] = useState("");
   const [categoryId, setCategoryId] = useState("");
   const [status, setStatus] = useState<PostStatus>(POST_STATUS.DRAFT);
+  const [publishAt, setPublishAt] = useState("");
   const [isDirty, setIsDirty] = useState(false);
   const [showSaveDialog, setShowSaveDialog] = useState(false);
   const [showUnsavedDialog, setShowUnsavedDialog] = useState(false);
@@ -72,7 +73,15 @@ export function PostEditor({
   }, [title]);
 
   const handleSaveConfirm = useCallback(() => {
+    if (status === POST_STATUS.SCHEDULED && !publishAt) {
+      return toast.error("请选择发布时间");
+    }
     const markdown = vditorRef.current?.getValue() || content;
+    // datetime-local 值为 "YYYY-MM-DDTHH:mm"，转换为后端格式 "YYYY-MM-DD HH:mm:ss"
+    const publish_at =
+      status === POST_STATUS.SCHEDULED
+        ? `${publishAt.replace("T", " ")}:00`
+        : undefined;
     const data = isEditMode
       ? ({
           id: postId!,
@@ -82,6 +91,7 @@ export function PostEditor({
           image: image.trim(),
           category_id: categoryId,
           status,
+          publish_at,
         } as UpdatePostRequest)
       : ({
           title: title.trim(),
@@ -90,6 +100,7 @@ export function PostEditor({
           image: image.trim(),
           category_id: categoryId,
           status,
+          publish_at,
         } as CreatePostRequest);
 
     onSave(data);
@@ -104,6 +115,7 @@ export function PostEditor({
     image,
     categoryId,
     status,
+    publishAt,
     onSave,
   ]);
 
@@ -119,6 +131,7 @@ export function PostEditor({
       setImage(postData.image || "");
       setCategoryId(postData.category_id || "");
       setStatus(postData.status || POST_STATUS.DRAFT);
+      setPublishAt(postData.publish_at?.slice(0, 16).replace(" ", "T") || "");
       setIsDirty(false);
 
       if (vditorRef.current && editorReady) {
@@ -340,9 +353,20 @@ export function PostEditor({
                   <SelectItem value={POST_STATUS.PUBLISHED}>已发布</SelectItem>
                   <SelectItem value={POST_STATUS.ARCHIVED}>已归档</SelectItem>
                   <SelectItem value={POST_STATUS.PRIVATE}>私有</SelectItem>
+                  <SelectItem value={POST_STATUS.SCHEDULED}>定时发布</SelectItem>
                 </SelectContent>
               </Select>
             </div>
+            {status === POST_STATUS.SCHEDULED && (
+              <div>
+                <label className="text-sm font-medium">发布时间</label>
+                <Input
+                  value={publishAt}
+                  onChange={(e) => setPublishAt(e.target.value)}
+                  type="datetime-local"
+                />
+              </div>
+            )}
           </div>
           <DialogFooter>
             <Button variant="outline" onClick={() => setShowSaveDialog(false)}>
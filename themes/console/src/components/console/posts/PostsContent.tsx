@@ -86,6 +86,7 @@ export function PostsContent({
       draft: { variant: "secondary", label: "草稿" },
       archived: { variant: "outline", label: "已归档" },
       private: { variant: "outline", label: "私有" },
+      scheduled: { variant: "secondary", label: "定时发布" },
     };
     return statusConfig[status] || { variant: "outline", label: status };
   };
@@ -10,6 +10,7 @@ import {
   Puzzle,
   FileText,
   FolderOpen,
+  MessageSquare,
 } from "lucide-react";
 import { Link } from "@tanstack/react-router";
 
@@ -67,6 +68,12 @@ export function AppSidebar({ activeTab, user, onLogout }: AppSidebarProps) {
           icon: FolderOpen,
           route: CONSOLE_ROUTES.CATEGORIES,
         },
+        {
+          id: CONSOLE_ROUTES.COMMENTS,
+          label: "评论",
+          icon: MessageSquare,
+          route: CONSOLE_ROUTES.COMMENTS,
+        },
       ],
     },
     {
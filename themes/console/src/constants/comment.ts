@@ -0,0 +1,15 @@
+/**
+ * 评论相关常量定义
+ */
+
+// ===== 评论审核状态 =====
+export const COMMENT_STATUS = {
+  PENDING: "pending",
+  APPROVED: "approved",
+  SPAM: "spam",
+} as const;
+
+// ===== 评论查询键 =====
+export const COMMENT_QUERY_KEYS = {
+  COMMENTS: "comments",
+} as const;
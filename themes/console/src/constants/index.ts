@@ -3,5 +3,6 @@ export * from "./rbac";
 export * from "./routes";
 export * from "./post";
 export * from "./category";
+export * from "./comment";
 export * from "./theme";
 export * from "./storage";
@@ -8,10 +8,31 @@ export const POST_STATUS = {
   PUBLISHED: "published",
   PRIVATE: "private",
   ARCHIVED: "archived",
+  SCHEDULED: "scheduled",
 } as const;
 
 export type PostStatus = (typeof POST_STATUS)[keyof typeof POST_STATUS];
 
+// ===== 文章置顶范围 =====
+export const POST_PIN_SCOPE = {
+  NONE: "none", // 取消置顶（仅用于请求参数）
+  GLOBAL: "global", // 全局置顶
+  CATEGORY: "category", // 分类置顶
+} as const;
+
+export type PostPinScope =
+  (typeof POST_PIN_SCOPE)[keyof typeof POST_PIN_SCOPE];
+
+// ===== 文章排序方式 =====
+export const POST_SORT = {
+  NEWEST: "newest",
+  OLDEST: "oldest",
+  MOST_VIEWED: "most_viewed",
+  LAST_UPDATED: "last_updated",
+} as const;
+
+export type PostSort = (typeof POST_SORT)[keyof typeof POST_SORT];
+
 // ===== 文章查询键 =====
 export const POST_QUERY_KEYS = {
   POSTS: "posts",
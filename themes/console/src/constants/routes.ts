@@ -18,6 +18,7 @@ export const CONSOLE_ROUTES = {
   POSTS: "/console/posts",
   POST_EDITOR: "/console/posts/editor",
   CATEGORIES: "/console/categories",
+  COMMENTS: "/console/comments",
   SYSTEM: "/console/system",
   PROFILE: "/console/profile",
 } as const;
@@ -37,6 +38,7 @@ export const ROUTE_TITLES = {
   [CONSOLE_ROUTES.POSTS]: "文章管理",
   [CONSOLE_ROUTES.POST_EDITOR]: "文章编辑器",
   [CONSOLE_ROUTES.CATEGORIES]: "分类管理",
+  [CONSOLE_ROUTES.COMMENTS]: "评论审核",
   [CONSOLE_ROUTES.SYSTEM]: "系统设置",
   [CONSOLE_ROUTES.PROFILE]: "个人资料",
 } as const;
@@ -28,3 +28,8 @@ export {
   useUpdateCategory,
   useDeleteCategory,
 } from "./use-categories";
+export {
+  useCommentsByStatus,
+  useModerateComments,
+  useDeleteComment,
+} from "./use-comments";
@@ -0,0 +1,82 @@
+/**
+ * 评论审核相关 Query Hooks
+ */
+
+import {
+  useQuery,
+  useMutation,
+  useQueryClient,
+  type UseQueryOptions,
+  type UseMutationOptions,
+} from "@tanstack/react-query";
+import { commentService } from "@/services";
+import { COMMENT_QUERY_KEYS } from "@/constants";
+import type {
+  ListCommentsByStatusRequest,
+  ListCommentsByStatusResponse,
+  ModerateCommentRequest,
+  ModerateCommentResponse,
+  DeleteCommentRequest,
+  DeleteCommentResponse,
+} from "@/types";
+
+// Query Keys
+export const commentKeys = {
+  all: [COMMENT_QUERY_KEYS.COMMENTS] as const,
+  lists: () => [...commentKeys.all, "list"] as const,
+  list: (params: ListCommentsByStatusRequest) =>
+    [...commentKeys.lists(), params] as const,
+} as const;
+
+// 根据审核状态获取评论列表
+export function useCommentsByStatus(
+  params: ListCommentsByStatusRequest,
+  options?: Omit<
+    UseQueryOptions<ListCommentsByStatusResponse>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery({
+    queryKey: commentKeys.list(params),
+    queryFn: () => commentService.listCommentsByStatus(params),
+    ...options,
+  });
+}
+
+// 审核评论
+export function useModerateComments(
+  options?: Omit<
+    UseMutationOptions<ModerateCommentResponse, Error, ModerateCommentRequest>,
+    "mutationFn"
+  >
+) {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (data: ModerateCommentRequest) =>
+      commentService.moderateComment(data),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: commentKeys.lists() });
+    },
+    ...options,
+  });
+}
+
+// 删除评论
+export function useDeleteComment(
+  options?: Omit<
+    UseMutationOptions<DeleteCommentResponse, Error, DeleteCommentRequest>,
+    "mutationFn"
+  >
+) {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (data: DeleteCommentRequest) =>
+      commentService.deleteComment(data),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: commentKeys.lists() });
+    },
+    ...options,
+  });
+}
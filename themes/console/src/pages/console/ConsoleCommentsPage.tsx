@@ -0,0 +1,121 @@
+/**
+ * 评论审核页面
+ */
+
+import { useState } from "react";
+import { toast } from "sonner";
+
+import { CommentsSidebar } from "@/components/console/comments/CommentsSidebar";
+import { CommentsContent } from "@/components/console/comments/CommentsContent";
+
+import {
+  useCommentsByStatus,
+  useModerateComments,
+  useDeleteComment,
+} from "@/hooks/use-comments";
+import { COMMENT_STATUS } from "@/constants/comment";
+import type { AdminCommentItem, CommentStatus } from "@/types/comment";
+
+const PAGE_SIZE = 10;
+
+export function ConsoleCommentsPage() {
+  // ===== 状态管理 =====
+  const [selectedStatus, setSelectedStatus] = useState<CommentStatus | null>(
+    COMMENT_STATUS.PENDING
+  );
+  const [currentPage, setCurrentPage] = useState(1);
+  const [searchQuery, setSearchQuery] = useState("");
+
+  // ===== 数据获取 =====
+  const { data: commentsData, isLoading } = useCommentsByStatus({
+    page_no: currentPage,
+    page_size: PAGE_SIZE,
+    ...(selectedStatus && { status: selectedStatus }),
+  });
+
+  // 各状态数量仅需总数，每页取 1 条即可
+  const countParams = { page_no: 1, page_size: 1 };
+  const { data: allData } = useCommentsByStatus(countParams);
+  const { data: pendingData } = useCommentsByStatus({
+    ...countParams,
+    status: COMMENT_STATUS.PENDING,
+  });
+  const { data: approvedData } = useCommentsByStatus({
+    ...countParams,
+    status: COMMENT_STATUS.APPROVED,
+  });
+  const { data: spamData } = useCommentsByStatus({
+    ...countParams,
+    status: COMMENT_STATUS.SPAM,
+  });
+
+  const moderateMutation = useModerateComments();
+  const deleteMutation = useDeleteComment();
+
+  // ===== 计算数据 =====
+  const stats = {
+    total: allData?.total || 0,
+    pending: pendingData?.total || 0,
+    approved: approvedData?.total || 0,
+    spam: spamData?.total || 0,
+  };
+
+  // ===== 事件处理 =====
+  const handleStatusChange = (status: CommentStatus | null) => {
+    setSelectedStatus(status);
+    setCurrentPage(1);
+  };
+
+  const handleModerate = async (
+    comment: AdminCommentItem,
+    status: CommentStatus
+  ) => {
+    try {
+      await moderateMutation.mutateAsync({ ids: [comment.id], status });
+      toast.success("评论审核状态已更新");
+    } catch (error) {
+      console.error("审核评论失败:", error);
+      toast.error("审核评论失败，请稍后重试");
+    }
+  };
+
+  const handleDelete = async (comment: AdminCommentItem) => {
+    try {
+      await deleteMutation.mutateAsync({ id: comment.id });
+      toast.success("评论已删除");
+    } catch (error) {
+      console.error("删除评论失败:", error);
+      toast.error("删除评论失败，请稍后重试");
+    }
+  };
+
+  // ===== 渲染 =====
+  return (
+    <div className="flex h-full">
+      <div className="hidden md:block">
+        <CommentsSidebar
+          selectedStatus={selectedStatus}
+          stats={stats}
+          onStatusChange={handleStatusChange}
+        />
+      </div>
+
+      <div className="flex-1 flex flex-col h-full">
+        <CommentsContent
+          selectedStatus={selectedStatus}
+          searchQuery={searchQuery}
+          comments={commentsData?.list || []}
+          total={commentsData?.total || 0}
+          currentPage={currentPage}
+          pageSize={PAGE_SIZE}
+          isLoading={isLoading}
+          onStatusChange={handleStatusChange}
+          onSearchChange={setSearchQuery}
+          onPageChange={setCurrentPage}
+          onModerate={handleModerate}
+          onDelete={handleDelete}
+        />
+      </div>
+    </div>
+  );
+}
@@ -1,5 +1,6 @@
 import { createRbacGuardedRoute } from "@/router/guards/RbacGuard";
 import { CONSOLE_ROUTES, RBAC_ACTION } from "@/constants";
+import { COMMENT_ENDPOINTS } from "@/api";
 
 import { ConsoleDashboardPage } from "@/pages/console/ConsoleDashboardPage";
 import { ConsoleUsersPage } from "@/pages/console/ConsoleUsersPage";
@@ -9,6 +10,7 @@ import { ConsolePluginsPage } from "@/pages/console/ConsolePluginsPage";
 import { ConsolePostsPage } from "@/pages/console/ConsolePostsPage";
 import { PostEditorPage } from "@/pages/console/PostEditorPage";
 import { ConsoleCategoriesPage } from "@/pages/console/ConsoleCategoriesPage";
+import { ConsoleCommentsPage } from "@/pages/console/ConsoleCommentsPage";
 
 export const createConsoleRoutes = (rootRoute: any) => {
   return [
@@ -64,6 +66,13 @@ export const createConsoleRoutes = (rootRoute: any) => {
       requireConsoleAccess: true,
       resource: '/api/categories/*',
       action: RBAC_ACTION.GET,
+    }),
+
+    // 评论审核页面 - 需要审核评论权限
+    createRbacGuardedRoute(rootRoute, CONSOLE_ROUTES.COMMENTS, ConsoleCommentsPage, {
+      requireConsoleAccess: true,
+      resource: COMMENT_ENDPOINTS.MODERATE_COMMENT,
+      action: RBAC_ACTION.POST,
     })
   ];
 };
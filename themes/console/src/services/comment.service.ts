@@ -0,0 +1,53 @@
+/**
+ * 评论服务
+ */
+
+import { COMMENT_ENDPOINTS } from "@/api";
+import { apiClient } from "@/lib/api-client";
+import type {
+  ApiResponse,
+  DeleteCommentRequest,
+  DeleteCommentResponse,
+  ListCommentsByStatusRequest,
+  ListCommentsByStatusResponse,
+  ModerateCommentRequest,
+  ModerateCommentResponse,
+} from "@/types";
+
+class CommentService {
+  // ===== 评论审核 =====
+
+  // 根据审核状态获取评论列表
+  async listCommentsByStatus(
+    request: ListCommentsByStatusRequest
+  ): Promise<ListCommentsByStatusResponse> {
+    const response = await apiClient.get<
+      ApiResponse<ListCommentsByStatusResponse>
+    >(COMMENT_ENDPOINTS.LIST_COMMENTS_BY_STATUS, { params: request });
+    return response.data.data!;
+  }
+
+  // 审核评论
+  async moderateComment(
+    request: ModerateCommentRequest
+  ): Promise<ModerateCommentResponse> {
+    const response = await apiClient.post<ApiResponse<ModerateCommentResponse>>(
+      COMMENT_ENDPOINTS.MODERATE_COMMENT,
+      request
+    );
+    return response.data.data!;
+  }
+
+  // 删除评论
+  async deleteComment(
+    request: DeleteCommentRequest
+  ): Promise<DeleteCommentResponse> {
+    const response = await apiClient.post<ApiResponse<DeleteCommentResponse>>(
+      COMMENT_ENDPOINTS.DELETE_COMMENT,
+      request
+    );
+    return response.data.data!;
+  }
+}
+
+export const commentService = new CommentService();
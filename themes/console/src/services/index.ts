@@ -5,3 +5,8 @@ export { pluginService } from "./plugin.service";
 export { postService } from "./post.service";
 export { categoryService } from "./category.service";
 export { rbacService } from "./rbac.service";
+export { commentService } from "./comment.service";
+export { tagService } from "./tag.service";
+export { seriesService } from "./series.service";
+export { reactionService } from "./reaction.service";
+export { mediaService } from "./media.service";
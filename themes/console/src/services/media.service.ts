@@ -0,0 +1,48 @@
+/**
+ * 媒体文件服务
+ */
+
+import { MEDIA_ENDPOINTS } from "@/api";
+import { apiClient } from "@/lib/api-client";
+import type {
+  ApiResponse,
+  DeleteMediaRequest,
+  DeleteMediaResponse,
+  ListMediaRequest,
+  ListMediaResponse,
+  UploadMediaResponse,
+} from "@/types";
+
+class MediaService {
+  // 上传媒体文件，相同内容的文件返回已有记录
+  async uploadMedia(file: File): Promise<UploadMediaResponse> {
+    const formData = new FormData();
+    formData.append("file", file);
+    const response = await apiClient.post<ApiResponse<UploadMediaResponse>>(
+      MEDIA_ENDPOINTS.UPLOAD_MEDIA,
+      formData,
+      { headers: { "Content-Type": "multipart/form-data" } }
+    );
+    return response.data.data!;
+  }
+
+  // 获取媒体文件列表
+  async listMedia(request: ListMediaRequest): Promise<ListMediaResponse> {
+    const response = await apiClient.get<ApiResponse<ListMediaResponse>>(
+      MEDIA_ENDPOINTS.LIST_MEDIA,
+      { params: request }
+    );
+    return response.data.data!;
+  }
+
+  // 删除媒体文件
+  async deleteMedia(request: DeleteMediaRequest): Promise<DeleteMediaResponse> {
+    const response = await apiClient.post<ApiResponse<DeleteMediaResponse>>(
+      MEDIA_ENDPOINTS.DELETE_MEDIA,
+      request
+    );
+    return response.data.data!;
+  }
+}
+
+export const mediaService = new MediaService();
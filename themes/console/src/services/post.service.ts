@@ -10,13 +10,35 @@ import type {
   CreatePostResponse,
   DeletePostRequest,
   DeletePostResponse,
+  FeaturePostRequest,
+  FeaturePostResponse,
   GetPostRequest,
+  GetPostBySlugRequest,
   GetPostResponse,
+  ImportPostsResponse,
+  ListPinnedPostsRequest,
+  ListPinnedPostsResponse,
+  ListPopularPostsRequest,
+  ListPopularPostsResponse,
+  PinPostRequest,
+  PinPostResponse,
+  ReorderPinsRequest,
+  ReorderPinsResponse,
   UpdatePostRequest,
   UpdatePostResponse,
   ListPublishedPostsRequest,
+  ListPostsByAuthorRequest,
   ListPostsByStatusRequest,
   ListPostsResponse,
+  RerenderPostsResponse,
+  SearchPostsRequest,
+  SearchPostsResponse,
+  ListPostRevisionsRequest,
+  ListPostRevisionsResponse,
+  DiffPostRevisionsRequest,
+  DiffPostRevisionsResponse,
+  RestorePostRevisionRequest,
+  RestorePostRevisionResponse,
 } from "@/types";
 
 class PostService {
@@ -40,6 +62,15 @@ class PostService {
     return response.data.data!;
   }
 
+  // 根据别名获取文章详情
+  async getPostBySlug(request: GetPostBySlugRequest): Promise<GetPostResponse> {
+    const response = await apiClient.get<ApiResponse<GetPostResponse>>(
+      POST_ENDPOINTS.GET_POST_BY_SLUG,
+      { params: request }
+    );
+    return response.data.data!;
+  }
+
   // 更新文章
   async updatePost(request: UpdatePostRequest): Promise<UpdatePostResponse> {
     const response = await apiClient.post<ApiResponse<UpdatePostResponse>>(
@@ -58,6 +89,26 @@ class PostService {
     return response.data.data!;
   }
 
+  // 按当前渲染与清洗配置重新渲染全部文章
+  async rerenderPosts(): Promise<RerenderPostsResponse> {
+    const response = await apiClient.post<ApiResponse<RerenderPostsResponse>>(
+      POST_ENDPOINTS.RERENDER_POSTS
+    );
+    return response.data.data!;
+  }
+
+  // 从 Markdown 文件、zip 压缩包或 WordPress 导出文件导入文章
+  async importPosts(file: File): Promise<ImportPostsResponse> {
+    const formData = new FormData();
+    formData.append("file", file);
+    const response = await apiClient.post<ApiResponse<ImportPostsResponse>>(
+      POST_ENDPOINTS.IMPORT_POSTS,
+      formData,
+      { headers: { "Content-Type": "multipart/form-data" } }
+    );
+    return response.data.data!;
+  }
+
   // 获取已发布文章列表
   async listPublishedPosts(
     request: ListPublishedPostsRequest
@@ -69,6 +120,26 @@ class PostService {
     return response.data.data!;
   }
 
+  // 获取指定作者的已发布文章列表
+  async listPostsByAuthor(
+    request: ListPostsByAuthorRequest
+  ): Promise<ListPostsResponse> {
+    const response = await apiClient.get<ApiResponse<ListPostsResponse>>(
+      POST_ENDPOINTS.LIST_POSTS_BY_AUTHOR,
+      { params: request }
+    );
+    return response.data.data!;
+  }
+
+  // 全文检索已发布文章
+  async searchPosts(request: SearchPostsRequest): Promise<SearchPostsResponse> {
+    const response = await apiClient.get<ApiResponse<SearchPostsResponse>>(
+      POST_ENDPOINTS.SEARCH_POSTS,
+      { params: request }
+    );
+    return response.data.data!;
+  }
+
   // 根据状态获取文章列表
   async listPostsByStatus(
     request: ListPostsByStatusRequest
@@ -79,6 +150,103 @@ class PostService {
     );
     return response.data.data!;
   }
+
+  // ===== 热门文章 =====
+
+  // 获取近 7 天浏览量最多的文章
+  async listPopularWeek(
+    request: ListPopularPostsRequest = {}
+  ): Promise<ListPopularPostsResponse> {
+    const response = await apiClient.get<
+      ApiResponse<ListPopularPostsResponse>
+    >(POST_ENDPOINTS.LIST_POPULAR_POSTS_WEEK, { params: request });
+    return response.data.data!;
+  }
+
+  // 获取总浏览量最多的文章
+  async listPopularAllTime(
+    request: ListPopularPostsRequest = {}
+  ): Promise<ListPopularPostsResponse> {
+    const response = await apiClient.get<
+      ApiResponse<ListPopularPostsResponse>
+    >(POST_ENDPOINTS.LIST_POPULAR_POSTS_ALL_TIME, { params: request });
+    return response.data.data!;
+  }
+
+  // ===== 置顶与推荐 =====
+
+  // 设置或取消文章置顶
+  async pinPost(request: PinPostRequest): Promise<PinPostResponse> {
+    const response = await apiClient.post<ApiResponse<PinPostResponse>>(
+      POST_ENDPOINTS.PIN_POST,
+      request
+    );
+    return response.data.data!;
+  }
+
+  // 调整置顶文章顺序
+  async reorderPins(
+    request: ReorderPinsRequest
+  ): Promise<ReorderPinsResponse> {
+    const response = await apiClient.post<ApiResponse<ReorderPinsResponse>>(
+      POST_ENDPOINTS.REORDER_PINNED_POSTS,
+      request
+    );
+    return response.data.data!;
+  }
+
+  // 设置或取消文章推荐
+  async featurePost(
+    request: FeaturePostRequest
+  ): Promise<FeaturePostResponse> {
+    const response = await apiClient.post<ApiResponse<FeaturePostResponse>>(
+      POST_ENDPOINTS.FEATURE_POST,
+      request
+    );
+    return response.data.data!;
+  }
+
+  // 获取置顶文章列表
+  async listPinnedPosts(
+    request: ListPinnedPostsRequest
+  ): Promise<ListPinnedPostsResponse> {
+    const response = await apiClient.get<
+      ApiResponse<ListPinnedPostsResponse>
+    >(POST_ENDPOINTS.LIST_PINNED_POSTS, { params: request });
+    return response.data.data!;
+  }
+
+  // ===== 文章修订 =====
+
+  // 获取文章修订列表
+  async listRevisions(
+    request: ListPostRevisionsRequest
+  ): Promise<ListPostRevisionsResponse> {
+    const response = await apiClient.get<
+      ApiResponse<ListPostRevisionsResponse>
+    >(POST_ENDPOINTS.LIST_POST_REVISIONS, { params: request });
+    return response.data.data!;
+  }
+
+  // 对比文章修订
+  async diffRevisions(
+    request: DiffPostRevisionsRequest
+  ): Promise<DiffPostRevisionsResponse> {
+    const response = await apiClient.get<
+      ApiResponse<DiffPostRevisionsResponse>
+    >(POST_ENDPOINTS.DIFF_POST_REVISIONS, { params: request });
+    return response.data.data!;
+  }
+
+  // 恢复文章修订
+  async restoreRevision(
+    request: RestorePostRevisionRequest
+  ): Promise<RestorePostRevisionResponse> {
+    const response = await apiClient.post<
+      ApiResponse<RestorePostRevisionResponse>
+    >(POST_ENDPOINTS.RESTORE_POST_REVISION, request);
+    return response.data.data!;
+  }
 }
 
 export const postService = new PostService();
@@ -0,0 +1,67 @@
+/**
+ * 文章表态服务
+ */
+
+import { REACTION_ENDPOINTS } from "@/api";
+import { apiClient } from "@/lib/api-client";
+import type {
+  ApiResponse,
+  GetReactionsRequest,
+  GetReactionsResponse,
+  ListReactionTypesResponse,
+  ReactionReportRequest,
+  ReactionReportResponse,
+  ToggleReactionRequest,
+  ToggleReactionResponse,
+} from "@/types";
+
+class ReactionService {
+  // ===== 表态查询 =====
+
+  // 获取已配置的表态类型
+  async listTypes(): Promise<ListReactionTypesResponse> {
+    const response = await apiClient.get<
+      ApiResponse<ListReactionTypesResponse>
+    >(REACTION_ENDPOINTS.LIST_REACTION_TYPES);
+    return response.data.data!;
+  }
+
+  // 获取文章表态数量及当前用户已表态的类型
+  async getReactions(
+    request: GetReactionsRequest
+  ): Promise<GetReactionsResponse> {
+    const response = await apiClient.get<ApiResponse<GetReactionsResponse>>(
+      REACTION_ENDPOINTS.GET_REACTIONS,
+      { params: request }
+    );
+    return response.data.data!;
+  }
+
+  // ===== 表态操作 =====
+
+  // 切换文章表态，未表态时添加，已表态时取消
+  async toggle(
+    request: ToggleReactionRequest
+  ): Promise<ToggleReactionResponse> {
+    const response = await apiClient.post<ApiResponse<ToggleReactionResponse>>(
+      REACTION_ENDPOINTS.TOGGLE_REACTION,
+      request
+    );
+    return response.data.data!;
+  }
+
+  // ===== 表态报表 =====
+
+  // 获取表态最多的文章报表
+  async getReport(
+    request: ReactionReportRequest = {}
+  ): Promise<ReactionReportResponse> {
+    const response = await apiClient.get<ApiResponse<ReactionReportResponse>>(
+      REACTION_ENDPOINTS.GET_REACTION_REPORT,
+      { params: request }
+    );
+    return response.data.data!;
+  }
+}
+
+export const reactionService = new ReactionService();
@@ -0,0 +1,78 @@
+/**
+ * 文章系列服务
+ */
+
+import { SERIES_ENDPOINTS } from "@/api";
+import { apiClient } from "@/lib/api-client";
+import type {
+  ApiResponse,
+  CreateSeriesRequest,
+  CreateSeriesResponse,
+  DeleteSeriesRequest,
+  DeleteSeriesResponse,
+  GetSeriesRequest,
+  GetSeriesResponse,
+  ListSeriesRequest,
+  ListSeriesResponse,
+  UpdateSeriesRequest,
+  UpdateSeriesResponse,
+} from "@/types";
+
+class SeriesService {
+  // ===== 系列查询 =====
+
+  // 获取系列详情及其文章
+  async getSeries(request: GetSeriesRequest): Promise<GetSeriesResponse> {
+    const response = await apiClient.get<ApiResponse<GetSeriesResponse>>(
+      SERIES_ENDPOINTS.GET_SERIES,
+      { params: request }
+    );
+    return response.data.data!;
+  }
+
+  // 获取系列列表
+  async listSeries(request: ListSeriesRequest): Promise<ListSeriesResponse> {
+    const response = await apiClient.get<ApiResponse<ListSeriesResponse>>(
+      SERIES_ENDPOINTS.LIST_SERIES,
+      { params: request }
+    );
+    return response.data.data!;
+  }
+
+  // ===== 系列管理 =====
+
+  // 创建系列
+  async createSeries(
+    request: CreateSeriesRequest
+  ): Promise<CreateSeriesResponse> {
+    const response = await apiClient.post<ApiResponse<CreateSeriesResponse>>(
+      SERIES_ENDPOINTS.CREATE_SERIES,
+      request
+    );
+    return response.data.data!;
+  }
+
+  // 更新系列
+  async updateSeries(
+    request: UpdateSeriesRequest
+  ): Promise<UpdateSeriesResponse> {
+    const response = await apiClient.post<ApiResponse<UpdateSeriesResponse>>(
+      SERIES_ENDPOINTS.UPDATE_SERIES,
+      request
+    );
+    return response.data.data!;
+  }
+
+  // 删除系列
+  async deleteSeries(
+    request: DeleteSeriesRequest
+  ): Promise<DeleteSeriesResponse> {
+    const response = await apiClient.post<ApiResponse<DeleteSeriesResponse>>(
+      SERIES_ENDPOINTS.DELETE_SERIES,
+      request
+    );
+    return response.data.data!;
+  }
+}
+
+export const seriesService = new SeriesService();
@@ -0,0 +1,85 @@
+/**
+ * 标签服务
+ */
+
+import { TAG_ENDPOINTS } from "@/api";
+import { apiClient } from "@/lib/api-client";
+import type {
+  ApiResponse,
+  CreateTagRequest,
+  CreateTagResponse,
+  DeleteTagRequest,
+  DeleteTagResponse,
+  GetTagCloudRequest,
+  GetTagCloudResponse,
+  GetTagRequest,
+  GetTagResponse,
+  ListTagsRequest,
+  ListTagsResponse,
+  UpdateTagRequest,
+  UpdateTagResponse,
+} from "@/types";
+
+class TagService {
+  // ===== 标签查询 =====
+
+  // 获取标签详情
+  async getTag(request: GetTagRequest): Promise<GetTagResponse> {
+    const response = await apiClient.get<ApiResponse<GetTagResponse>>(
+      TAG_ENDPOINTS.GET_TAG,
+      { params: request }
+    );
+    return response.data.data!;
+  }
+
+  // 获取标签列表
+  async listTags(request: ListTagsRequest): Promise<ListTagsResponse> {
+    const response = await apiClient.get<ApiResponse<ListTagsResponse>>(
+      TAG_ENDPOINTS.LIST_TAGS,
+      { params: request }
+    );
+    return response.data.data!;
+  }
+
+  // 获取标签云
+  async getTagCloud(
+    request: GetTagCloudRequest = {}
+  ): Promise<GetTagCloudResponse> {
+    const response = await apiClient.get<ApiResponse<GetTagCloudResponse>>(
+      TAG_ENDPOINTS.GET_TAG_CLOUD,
+      { params: request }
+    );
+    return response.data.data!;
+  }
+
+  // ===== 标签管理 =====
+
+  // 创建标签
+  async createTag(request: CreateTagRequest): Promise<CreateTagResponse> {
+    const response = await apiClient.post<ApiResponse<CreateTagResponse>>(
+      TAG_ENDPOINTS.CREATE_TAG,
+      request
+    );
+    return response.data.data!;
+  }
+
+  // 更新标签
+  async updateTag(request: UpdateTagRequest): Promise<UpdateTagResponse> {
+    const response = await apiClient.post<ApiResponse<UpdateTagResponse>>(
+      TAG_ENDPOINTS.UPDATE_TAG,
+      request
+    );
+    return response.data.data!;
+  }
+
+  // 删除标签
+  async deleteTag(request: DeleteTagRequest): Promise<DeleteTagResponse> {
+    const response = await apiClient.post<ApiResponse<DeleteTagResponse>>(
+      TAG_ENDPOINTS.DELETE_TAG,
+      request
+    );
+    return response.data.data!;
+  }
+}
+
+export const tagService = new TagService();
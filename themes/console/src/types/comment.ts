@@ -0,0 +1,66 @@
+/**
+ * 评论相关类型定义
+ */
+
+// CommentStatus 评论审核状态
+export type CommentStatus = "pending" | "approved" | "spam";
+
+// ===== 请求类型 (Request) =====
+
+// ListCommentsByStatusRequest 根据审核状态获取评论列表请求
+export interface ListCommentsByStatusRequest {
+  page_no: number; // 页码（int64），从1开始
+  page_size: number; // 每页数量（int64）
+  status?: CommentStatus; // 审核状态，为空时获取所有评论
+  post_id?: string; // 文章 ID，为空时不按文章筛选
+}
+
+// ModerateCommentRequest 审核评论请求
+export interface ModerateCommentRequest {
+  ids: string[]; // 评论 ID 列表
+  status: CommentStatus; // 目标审核状态
+}
+
+// DeleteCommentRequest 删除评论请求
+export interface DeleteCommentRequest {
+  id: string; // 评论 ID
+}
+
+// ===== 响应类型 (Response) =====
+
+// AdminCommentItem 评论管理列表项
+export interface AdminCommentItem {
+  id: string; // 评论 ID
+  post_id: string; // 文章 ID
+  post_title: string; // 文章标题
+  parent_id: string; // 父评论 ID
+  user_id: string; // 评论用户 ID，匿名评论为空
+  nickname: string; // 评论者昵称
+  email: string; // 评论者邮箱
+  website: string; // 评论者网站
+  content: string; // 评论内容
+  status: CommentStatus; // 审核状态
+  ip: string; // 评论者 IP
+  user_agent: string; // 评论者 User-Agent
+  created_at: string; // 创建时间
+  updated_at: string; // 更新时间
+}
+
+// ListCommentsByStatusResponse 评论管理列表响应
+export interface ListCommentsByStatusResponse {
+  total: number; // 总数量（int64）
+  page_no: number; // 当前页码（int64）
+  page_size: number; // 每页数量（int64）
+  list: AdminCommentItem[]; // 评论列表
+}
+
+// ModerateCommentResponse 审核评论响应
+export interface ModerateCommentResponse {
+  affected: number; // 受影响的评论数量
+  message: string; // 审核结果消息
+}
+
+// DeleteCommentResponse 删除评论响应
+export interface DeleteCommentResponse {
+  message: string; // 删除结果消息
+}
@@ -6,3 +6,8 @@ export * from "./rbac";
 export * from "./theme";
 export * from "./plugin";
 export * from "./verification";
+export * from "./comment";
+export * from "./tag";
+export * from "./series";
+export * from "./reaction";
+export * from "./media";
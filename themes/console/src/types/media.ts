@@ -0,0 +1,69 @@
+/**
+ * 媒体文件相关类型定义
+ */
+
+// ===== 请求类型 (Request) =====
+
+// ListMediaRequest 获取媒体文件列表请求
+export interface ListMediaRequest {
+  page_no: number; // 页码，从1开始
+  page_size: number; // 每页数量
+  mime_type?: string; // MIME 类型前缀筛选，如 image/
+}
+
+// DeleteMediaRequest 删除媒体文件请求
+export interface DeleteMediaRequest {
+  id: string; // 媒体文件 ID
+}
+
+// ===== 响应类型 (Response) =====
+
+// MediaItem 媒体文件项
+export interface MediaItem {
+  id: string; // 媒体文件 ID
+  owner_id: string; // 上传者用户 ID
+  file_name: string; // 原始文件名
+  url: string; // 访问地址
+  mime_type: string; // MIME 类型
+  size: number; // 文件大小（字节）
+  hash: string; // 文件内容 SHA-256 哈希
+  width: number; // 图片宽度（像素），非图片为 0
+  height: number; // 图片高度（像素），非图片为 0
+  created_at: string; // 上传时间
+  variants?: ImageVariant[]; // 图片尺寸变体列表，按宽度升序，含原图；非图片时不返回
+  srcset?: Record<string, string>; // 按 MIME 类型分组的 srcset 属性值；非图片时不返回
+}
+
+// ImageVariant 图片尺寸变体
+export interface ImageVariant {
+  url: string; // 访问地址
+  width: number; // 宽度（像素）
+  height: number; // 高度（像素）
+  mime_type: string; // MIME 类型
+  size: number; // 文件大小（字节），按需生成且尚未生成时为 0
+}
+
+// ImageSet 图片响应式尺寸集合
+export interface ImageSet {
+  variants: ImageVariant[]; // 尺寸变体列表，按宽度升序，含原图
+  srcset: Record<string, string>; // 按 MIME 类型分组的 srcset 属性值
+}
+
+// UploadMediaResponse 上传媒体文件响应
+export interface UploadMediaResponse extends MediaItem {
+  duplicate: boolean; // 是否为已上传过的相同文件
+  message: string; // 上传结果消息
+}
+
+// ListMediaResponse 媒体文件列表响应
+export interface ListMediaResponse {
+  total: number; // 总数量
+  page_no: number; // 当前页码
+  page_size: number; // 每页数量
+  list: MediaItem[]; // 媒体文件列表
+}
+
+// DeleteMediaResponse 删除媒体文件响应
+export interface DeleteMediaResponse {
+  message: string; // 删除结果消息
+}
@@ -2,18 +2,31 @@
  * 文章相关类型定义
  */
 
-import type { PostStatus } from "@/constants/post";
+import type { PostPinScope, PostSort, PostStatus } from "@/constants/post";
+import type { ImageSet } from "./media";
+import type { ReactionCount } from "./reaction";
+import type { PostSeriesInfo } from "./series";
+import type { PostTagItem } from "./tag";
 
 // ===== 请求类型 (Request) =====
 
 // CreatePostRequest 创建文章请求
 export interface CreatePostRequest {
   title: string; // 文章标题
-  description?: string; // 文章描述/摘要
-  image?: string; // 文章封面图片
+  slug?: string; // URL 别名，为空时根据标题自动生成
+  description?: string; // 文章描述/摘要，为空时由正文自动生成
+  image?: string; // 文章封面图片，为空时取正文第一张图片
   status?: PostStatus; // 文章状态
   category_id?: string; // 分类 ID
+  publish_at?: string; // 发布时间（YYYY-MM-DD HH:mm:ss），定时发布时必填且须晚于当前时间
+  tags?: string[]; // 标签名称列表，不存在的标签将自动创建
   markdown?: string; // Markdown 内容
+  meta_title?: string; // SEO 标题，为空时使用文章标题
+  meta_description?: string; // SEO 描述，为空时使用文章描述
+  canonical_url?: string; // 规范链接，为空时使用文章页面地址
+  no_index?: boolean; // 是否禁止搜索引擎收录
+  og_image?: string; // 分享卡片图片，为空时使用封面图片
+  reaction_types?: string[]; // 启用的表态类型，须为已配置的类型，为空时启用全部已配置类型
 }
 
 // DeletePostRequest 删除文章请求
@@ -26,15 +39,29 @@ export interface GetPostRequest {
   id: string; // 文章 ID
 }
 
+// GetPostBySlugRequest 根据别名获取文章请求
+export interface GetPostBySlugRequest {
+  slug: string; // URL 别名
+}
+
 // UpdatePostRequest 更新文章请求
 export interface UpdatePostRequest {
   id: string; // 文章 ID
   title?: string; // 文章标题
-  description?: string; // 文章描述/摘要
-  image?: string; // 文章封面图片
+  slug?: string; // URL 别名，修改后旧别名将保留用于重定向
+  description?: string; // 文章描述/摘要，为空时由正文自动生成
+  image?: string; // 文章封面图片，为空时取正文第一张图片
   status?: PostStatus; // 文章状态
   category_id?: string; // 分类 ID
+  publish_at?: string; // 发布时间（YYYY-MM-DD HH:mm:ss），定时发布时必填且须晚于当前时间
+  tags?: string[]; // 标签名称列表，传空数组清空标签
   markdown?: string; // Markdown内容
+  meta_title?: string | null; // SEO 标题，省略时不修改，传空字符串时清空
+  meta_description?: string | null; // SEO 描述，省略时不修改，传空字符串时清空
+  canonical_url?: string | null; // 规范链接，省略时不修改，传空字符串时清空
+  no_index?: boolean | null; // 是否禁止搜索引擎收录，省略时不修改
+  og_image?: string | null; // 分享卡片图片，省略时不修改，传空字符串时清空
+  reaction_types?: string[] | null; // 启用的表态类型，省略时不修改，传空数组时启用全部已配置类型
 }
 
 // ListPublishedPostsRequest 获取已发布文章列表请求
@@ -42,6 +69,25 @@ export interface ListPublishedPostsRequest {
   page_no: number; // 页码，从1开始
   page_size: number; // 每页数量
   category_id?: number; // 分类 ID，为空时不按分类筛选
+  tag_id?: number; // 标签 ID，为空时不按标签筛选
+  series_id?: number; // 系列 ID，为空时不按系列筛选，有值时按系列顺序排列
+  featured?: boolean; // 是否推荐，为空时不按推荐筛选
+  sort?: PostSort; // 排序方式，为空时按最新发布；未指定排序方式且未按标签、系列或推荐筛选时置顶文章优先
+}
+
+// ListPostsByAuthorRequest 获取作者文章列表请求
+export interface ListPostsByAuthorRequest {
+  author_id: string; // 作者用户 ID
+  page_no: number; // 页码，从1开始
+  page_size: number; // 每页数量
+  sort?: PostSort; // 排序方式，为空时按最新发布
+}
+
+// SearchPostsRequest 检索文章请求
+export interface SearchPostsRequest {
+  keyword: string; // 检索关键字，多个关键字以空格分隔
+  page_no: number; // 页码，从1开始
+  page_size: number; // 每页数量
 }
 
 // ListPostsByStatusRequest 根据状态获取文章列表请求
@@ -50,6 +96,40 @@ export interface ListPostsByStatusRequest {
   page_size: number; // 每页数量
   status?: PostStatus; // 文章状态，为空时获取所有文章
   category_id?: number; // 分类 ID，为空时不按分类筛选
+  series_id?: number; // 系列 ID，为空时不按系列筛选，有值时按系列顺序排列
+  featured?: boolean; // 是否推荐，为空时不按推荐筛选
+  sort?: PostSort; // 排序方式，为空时按最新发布
+}
+
+// PinPostRequest 置顶文章请求
+export interface PinPostRequest {
+  id: string; // 文章 ID
+  scope: PostPinScope; // 置顶范围，none 表示取消置顶
+  expire_at?: string; // 置顶到期时间（YYYY-MM-DD HH:mm:ss），为空时永久置顶
+}
+
+// FeaturePostRequest 推荐文章请求
+export interface FeaturePostRequest {
+  id: string; // 文章 ID
+  featured: boolean; // 是否推荐
+}
+
+// ListPopularPostsRequest 获取热门文章列表请求
+export interface ListPopularPostsRequest {
+  limit?: number; // 返回数量（1-50），为空时默认 10
+}
+
+// ListPinnedPostsRequest 获取置顶文章列表请求
+export interface ListPinnedPostsRequest {
+  scope: Exclude<PostPinScope, "none">; // 置顶范围
+  category_id?: string; // 分类 ID，分类置顶时必填
+}
+
+// ReorderPinsRequest 调整置顶顺序请求
+export interface ReorderPinsRequest {
+  scope: Exclude<PostPinScope, "none">; // 置顶范围
+  category_id?: string; // 分类 ID，分类置顶时必填
+  post_ids: string[]; // 该范围内全部未到期置顶文章的 ID，按期望顺序排列（靠前者优先）
 }
 
 // ===== 响应类型 (Response) =====
@@ -58,12 +138,20 @@ export interface ListPostsByStatusRequest {
 export interface CreatePostResponse {
   id: string; // 文章 ID
   title: string; // 文章标题
+  slug: string; // URL 别名
   description: string; // 文章描述/摘要
   image: string; // 文章封面图片
   status: string; // 文章状态
   category_id: string; // 分类 ID
   category_name: string; // 分类名称
+  publish_at: string; // 发布时间，未设置时为空
+  tags: PostTagItem[]; // 标签列表
   markdown: string; // Markdown内容
+  meta_title: string; // SEO 标题，为空时使用文章标题
+  meta_description: string; // SEO 描述，为空时使用文章描述
+  canonical_url: string; // 规范链接，为空时使用文章页面地址
+  no_index: boolean; // 是否禁止搜索引擎收录
+  og_image: string; // 分享卡片图片，为空时使用封面图片
   message: string; // 创建结果消息
 }
 
@@ -71,27 +159,65 @@ export interface CreatePostResponse {
 export interface GetPostResponse {
   id: string; // 文章 ID
   title: string; // 文章标题
+  slug: string; // URL 别名
   description: string; // 文章描述/摘要
   image: string; // 文章封面图片
+  image_set: ImageSet | null; // 封面图片响应式尺寸集合，封面不是媒体库图片时为 null
   status: string; // 文章状态
   category_id: string; // 分类 ID
   category_name: string; // 分类名称
+  author_id: string; // 作者用户 ID，作者未知时为空
+  author_nickname: string; // 作者昵称
+  author_avatar: string; // 作者头像
+  publish_at: string; // 发布时间，未设置时为空
+  tags: PostTagItem[]; // 标签列表
   markdown: string; // Markdown 内容
   html: string; // 渲染后的 HTML
+  toc: PostTOCItem[]; // 目录
+  word_count: number; // 字数，中日韩文字按字计、其他文字按词计
+  reading_time: number; // 预计阅读时长（分钟）
+  meta_title: string; // SEO 标题，为空时使用文章标题
+  meta_description: string; // SEO 描述，为空时使用文章描述
+  canonical_url: string; // 规范链接，为空时使用文章页面地址
+  no_index: boolean; // 是否禁止搜索引擎收录
+  og_image: string; // 分享卡片图片，为空时使用封面图片
+  series: PostSeriesInfo | null; // 所属系列，不属于任何系列时为 null
+  pin_scope: string; // 置顶范围，未置顶或置顶已到期时为空
+  pin_expire_at: string; // 置顶到期时间，永久置顶时为空
+  featured: boolean; // 是否推荐
+  views: number; // 浏览量（按访客去重，定期写入，存在少量延迟）
+  reaction_types: string[]; // 文章选择的表态类型，为空时启用全部已配置类型
+  reactions: ReactionCount[]; // 文章启用的表态类型及数量，按配置顺序排列
   created_at: string; // 创建时间
   updated_at: string; // 更新时间
 }
 
+// PostTOCItem 文章目录项
+export interface PostTOCItem {
+  level: number; // 标题级别（1-6）
+  text: string; // 标题文本
+  id: string; // 标题锚点 ID
+  children?: PostTOCItem[]; // 下级目录项
+}
+
 // UpdatePostResponse 更新文章响应
 export interface UpdatePostResponse {
   id: string; // 文章 ID
   title: string; // 文章标题
+  slug: string; // URL 别名
   description: string; // 文章描述/摘要
   image: string; // 文章封面图片
   status: string; // 文章状态
   category_id: string; // 分类 ID
   category_name: string; // 分类名称
+  publish_at: string; // 发布时间，未设置时为空
+  tags: PostTagItem[]; // 标签列表
   markdown: string; // Markdown内容
+  meta_title: string; // SEO 标题，为空时使用文章标题
+  meta_description: string; // SEO 描述，为空时使用文章描述
+  canonical_url: string; // 规范链接，为空时使用文章页面地址
+  no_index: boolean; // 是否禁止搜索引擎收录
+  og_image: string; // 分享卡片图片，为空时使用封面图片
   message: string; // 更新结果消息
 }
 
@@ -100,17 +226,56 @@ export interface DeletePostResponse {
   message: string; // 删除结果消息
 }
 
+// RerenderPostsResponse 重新渲染文章响应
+export interface RerenderPostsResponse {
+  total: number; // 处理的文章数量
+  updated: number; // HTML 发生变化并已更新的文章数量
+  failed: number; // 处理失败的文章数量
+  message: string; // 处理结果消息
+}
+
+// ImportPostsResponse 导入文章响应
+export interface ImportPostsResponse {
+  total: number; // 处理的条目数量
+  imported: number; // 导入成功的文章数量
+  failed: number; // 导入失败的条目数量
+  items: ImportPostItem[]; // 各条目导入结果
+  message: string; // 导入结果消息
+}
+
+// ImportPostItem 导入文章条目结果
+export interface ImportPostItem {
+  source: string; // 来源，Markdown 为文件路径，WXR 为 文件名#文章 ID
+  title: string; // 文章标题，解析失败时为空
+  id: string; // 导入后的文章 ID，失败时为空
+  slug: string; // 导入后的 URL 别名，失败时为空
+  status: string; // 导入后的文章状态，失败时为空
+  error: string; // 失败原因，成功时为空
+}
+
 // PostItem 文章列表项
 export interface PostItem {
   id: string; // 文章 ID
   title: string; // 文章标题
+  slug: string; // URL 别名
   description: string; // 文章描述/摘要
   image: string; // 文章封面图片
+  image_set: ImageSet | null; // 封面图片响应式尺寸集合，封面不是媒体库图片时为 null
   status: string; // 文章状态
   category_id: string; // 分类 ID
   category_name: string; // 分类名称
+  author_id: string; // 作者用户 ID，作者未知时为空
+  author_nickname: string; // 作者昵称
+  author_avatar: string; // 作者头像
+  publish_at: string; // 发布时间，未设置时为空
+  tags: PostTagItem[]; // 标签列表
   created_at: string; // 创建时间
   updated_at: string; // 更新时间
+  pin_scope: string; // 置顶范围，未置顶或置顶已到期时为空
+  pin_expire_at: string; // 置顶到期时间，永久置顶时为空
+  featured: boolean; // 是否推荐
+  views: number; // 浏览量（按访客去重，定期写入，存在少量延迟）
+  reactions: ReactionCount[]; // 文章启用的表态类型及数量，按配置顺序排列
 }
 
 // ListPostsResponse 文章列表响应
@@ -121,6 +286,21 @@ export interface ListPostsResponse {
   list: PostItem[]; // 文章列表
 }
 
+// SearchPostItem 文章检索结果项
+export interface SearchPostItem extends PostItem {
+  highlighted_title: string; // 高亮后的标题（HTML，关键字以 <mark> 标记）
+  snippet: string; // 正文摘要片段（HTML，关键字以 <mark> 标记）
+  score: number; // 相关度得分
+}
+
+// SearchPostsResponse 文章检索响应
+export interface SearchPostsResponse {
+  total: number; // 总数量
+  page_no: number; // 当前页码
+  page_size: number; // 每页数量
+  list: SearchPostItem[]; // 检索结果列表
+}
+
 // ===== 客户端状态类型 =====
 
 // PostEditState 文章编辑状态（客户端使用）
@@ -130,3 +310,92 @@ export interface PostEditState {
   isDirty: boolean;
   loading: boolean;
 }
+
+// ===== 文章修订 (Revision) =====
+
+// ListPostRevisionsRequest 获取文章修订列表请求
+export interface ListPostRevisionsRequest {
+  post_id: string; // 文章 ID
+  page_no: number; // 页码，从1开始
+  page_size: number; // 每页数量
+}
+
+// DiffPostRevisionsRequest 对比文章修订请求
+export interface DiffPostRevisionsRequest {
+  from_id: string; // 旧修订 ID
+  to_id: string; // 新修订 ID
+}
+
+// RestorePostRevisionRequest 恢复文章修订请求
+export interface RestorePostRevisionRequest {
+  id: string; // 修订 ID
+}
+
+// PostRevisionItem 文章修订列表项
+export interface PostRevisionItem {
+  id: string; // 修订 ID
+  post_id: string; // 文章 ID
+  version: number; // 修订版本号
+  title: string; // 标题快照
+  editor_id: string; // 修改者用户 ID，系统操作时为空
+  created_at: string; // 修订时间
+}
+
+// ListPostRevisionsResponse 文章修订列表响应
+export interface ListPostRevisionsResponse {
+  total: number; // 总数量
+  page_no: number; // 当前页码
+  page_size: number; // 每页数量
+  list: PostRevisionItem[]; // 修订列表
+}
+
+// DiffPostRevisionsResponse 对比文章修订响应
+export interface DiffPostRevisionsResponse {
+  from_id: string; // 旧修订 ID
+  to_id: string; // 新修订 ID
+  from_version: number; // 旧修订版本号
+  to_version: number; // 新修订版本号
+  diff: string; // 统一格式差异（unified diff）
+}
+
+// RestorePostRevisionResponse 恢复文章修订响应
+export interface RestorePostRevisionResponse {
+  post_id: string; // 文章 ID
+  version: number; // 恢复后生成的新修订版本号
+  message: string; // 恢复结果消息
+}
+
+// PinPostResponse 置顶文章响应
+export interface PinPostResponse {
+  id: string; // 文章 ID
+  pin_scope: string; // 置顶范围，取消置顶时为空
+  pin_expire_at: string; // 置顶到期时间，永久置顶时为空
+  message: string; // 处理结果消息
+}
+
+// FeaturePostResponse 推荐文章响应
+export interface FeaturePostResponse {
+  id: string; // 文章 ID
+  featured: boolean; // 是否推荐
+  message: string; // 处理结果消息
+}
+
+// PopularPostItem 热门文章列表项
+export interface PopularPostItem extends PostItem {
+  period_views: number; // 统计周期内的浏览量，全部时间时等于总浏览量
+}
+
+// ListPopularPostsResponse 热门文章列表响应
+export interface ListPopularPostsResponse {
+  list: PopularPostItem[]; // 热门文章列表，按统计周期内的浏览量降序
+}
+
+// ListPinnedPostsResponse 置顶文章列表响应
+export interface ListPinnedPostsResponse {
+  list: PostItem[]; // 置顶文章列表，按置顶顺序排列
+}
+
+// ReorderPinsResponse 调整置顶顺序响应
+export interface ReorderPinsResponse {
+  message: string; // 处理结果消息
+}
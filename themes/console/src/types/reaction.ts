@@ -0,0 +1,75 @@
+/**
+ * 文章表态相关类型定义
+ */
+
+// ===== 请求类型 (Request) =====
+
+// ToggleReactionRequest 切换文章表态请求
+export interface ToggleReactionRequest {
+  post_id: string; // 文章 ID
+  type: string; // 表态类型
+  fingerprint?: string; // 匿名访客客户端指纹，为空时按 IP 与 User-Agent 去重；登录用户忽略
+}
+
+// GetReactionsRequest 获取文章表态请求
+export interface GetReactionsRequest {
+  post_id: string; // 文章 ID
+  fingerprint?: string; // 匿名访客客户端指纹，与表态时一致
+}
+
+// ReactionReportRequest 表态最多文章报表请求
+export interface ReactionReportRequest {
+  days?: number; // 统计最近天数（1-365，含当日），为空时统计全部时间
+  type?: string; // 表态类型，为空时统计全部类型
+  limit?: number; // 返回数量（1-100），为空时默认 20
+}
+
+// ===== 响应类型 (Response) =====
+
+// ReactionCount 文章表态类型及数量
+export interface ReactionCount {
+  type: string; // 表态类型
+  emoji: string; // 表情符号
+  count: number; // 表态数量
+}
+
+// ReactionTypeItem 表态类型
+export interface ReactionTypeItem {
+  type: string; // 表态类型
+  emoji: string; // 表情符号
+}
+
+// ListReactionTypesResponse 获取已配置表态类型响应
+export interface ListReactionTypesResponse {
+  list: ReactionTypeItem[]; // 表态类型列表，按配置顺序排列，为空时表态功能已关闭
+}
+
+// GetReactionsResponse 获取文章表态响应
+export interface GetReactionsResponse {
+  post_id: string; // 文章 ID
+  reactions: ReactionCount[]; // 文章启用的表态类型及数量
+  reacted: string[]; // 当前用户或访客已表态的类型
+}
+
+// ToggleReactionResponse 切换文章表态响应
+export interface ToggleReactionResponse {
+  post_id: string; // 文章 ID
+  type: string; // 表态类型
+  reacted: boolean; // 切换后是否处于已表态状态
+  reactions: ReactionCount[]; // 切换后文章启用的表态类型及数量
+}
+
+// ReactedPostItem 表态报表文章项
+export interface ReactedPostItem {
+  id: string; // 文章 ID
+  title: string; // 文章标题
+  slug: string; // URL 别名
+  publish_at: string; // 发布时间
+  period_count: number; // 统计周期内符合条件的表态数量
+  reactions: ReactionCount[]; // 文章全部时间内各已配置表态类型的数量
+}
+
+// ReactionReportResponse 表态最多文章报表响应
+export interface ReactionReportResponse {
+  list: ReactedPostItem[]; // 文章列表，按统计周期内的表态数量降序
+}
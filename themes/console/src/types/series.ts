@@ -0,0 +1,108 @@
+/**
+ * 文章系列相关类型定义
+ */
+
+// ===== 请求类型 (Request) =====
+
+// CreateSeriesRequest 创建系列请求
+export interface CreateSeriesRequest {
+  name: string; // 系列名称
+  description?: string; // 系列描述
+  post_ids?: string[]; // 文章 ID 列表，按系列顺序排列，文章将从原系列中移除
+}
+
+// DeleteSeriesRequest 删除系列请求
+export interface DeleteSeriesRequest {
+  id: string; // 系列 ID
+}
+
+// GetSeriesRequest 获取系列请求
+export interface GetSeriesRequest {
+  id: string; // 系列 ID
+}
+
+// UpdateSeriesRequest 更新系列请求
+export interface UpdateSeriesRequest {
+  id: string; // 系列 ID
+  name?: string; // 系列名称
+  description?: string; // 系列描述
+  post_ids?: string[] | null; // 文章 ID 列表，按系列顺序排列，为 null 时不修改，为空数组时清空系列
+}
+
+// ListSeriesRequest 获取系列列表请求
+export interface ListSeriesRequest {
+  page_no: number; // 页码，从1开始
+  page_size: number; // 每页数量
+  keyword?: string; // 名称关键字，为空时获取所有系列
+}
+
+// ===== 响应类型 (Response) =====
+
+// CreateSeriesResponse 创建系列响应
+export interface CreateSeriesResponse {
+  id: string; // 系列 ID
+  name: string; // 系列名称
+  description: string; // 系列描述
+  post_count: number; // 文章数量
+  message: string; // 创建结果消息
+}
+
+// GetSeriesResponse 获取系列响应
+export interface GetSeriesResponse {
+  id: string; // 系列 ID
+  name: string; // 系列名称
+  description: string; // 系列描述
+  posts: SeriesPostItem[]; // 公开文章列表，按系列顺序排列
+  created_at: string; // 创建时间
+  updated_at: string; // 更新时间
+}
+
+// UpdateSeriesResponse 更新系列响应
+export interface UpdateSeriesResponse {
+  id: string; // 系列 ID
+  name: string; // 系列名称
+  description: string; // 系列描述
+  post_count: number; // 文章数量
+  message: string; // 更新结果消息
+}
+
+// DeleteSeriesResponse 删除系列响应
+export interface DeleteSeriesResponse {
+  message: string; // 删除结果消息
+}
+
+// SeriesItem 系列列表项
+export interface SeriesItem {
+  id: string; // 系列 ID
+  name: string; // 系列名称
+  description: string; // 系列描述
+  post_count: number; // 文章数量（含未公开文章）
+  created_at: string; // 创建时间
+  updated_at: string; // 更新时间
+}
+
+// ListSeriesResponse 系列列表响应
+export interface ListSeriesResponse {
+  total: number; // 总数量
+  page_no: number; // 当前页码
+  page_size: number; // 每页数量
+  list: SeriesItem[]; // 系列列表
+}
+
+// SeriesPostItem 系列中的文章
+export interface SeriesPostItem {
+  id: string; // 文章 ID
+  title: string; // 文章标题
+  slug: string; // URL 别名
+  position: number; // 在系列中的位置，从 1 开始
+}
+
+// PostSeriesInfo 文章所属系列信息
+export interface PostSeriesInfo {
+  id: string; // 系列 ID
+  name: string; // 系列名称
+  position: number; // 当前文章在系列中的位置，从 1 开始
+  total: number; // 系列中的文章数量
+  prev: SeriesPostItem | null; // 上一篇，当前为第一篇时为 null
+  next: SeriesPostItem | null; // 下一篇，当前为最后一篇时为 null
+}
@@ -0,0 +1,107 @@
+/**
+ * 标签相关类型定义
+ */
+
+// ===== 请求类型 (Request) =====
+
+// CreateTagRequest 创建标签请求
+export interface CreateTagRequest {
+  name: string; // 标签名称
+  description?: string; // 标签描述
+}
+
+// DeleteTagRequest 删除标签请求
+export interface DeleteTagRequest {
+  id: string; // 标签 ID
+}
+
+// GetTagRequest 获取标签请求
+export interface GetTagRequest {
+  id: string; // 标签 ID
+}
+
+// UpdateTagRequest 更新标签请求
+export interface UpdateTagRequest {
+  id: string; // 标签 ID
+  name?: string; // 标签名称
+  description?: string; // 标签描述
+}
+
+// ListTagsRequest 获取标签列表请求
+export interface ListTagsRequest {
+  page_no: number; // 页码，从1开始
+  page_size: number; // 每页数量
+  keyword?: string; // 名称关键字，为空时获取所有标签
+}
+
+// GetTagCloudRequest 获取标签云请求
+export interface GetTagCloudRequest {
+  limit?: number; // 返回数量上限，为空时默认 100
+}
+
+// ===== 响应类型 (Response) =====
+
+// CreateTagResponse 创建标签响应
+export interface CreateTagResponse {
+  id: string; // 标签 ID
+  name: string; // 标签名称
+  description: string; // 标签描述
+  message: string; // 创建结果消息
+}
+
+// GetTagResponse 获取标签响应
+export interface GetTagResponse {
+  id: string; // 标签 ID
+  name: string; // 标签名称
+  description: string; // 标签描述
+  created_at: string; // 创建时间
+  updated_at: string; // 更新时间
+}
+
+// UpdateTagResponse 更新标签响应
+export interface UpdateTagResponse {
+  id: string; // 标签 ID
+  name: string; // 标签名称
+  description: string; // 标签描述
+  message: string; // 更新结果消息
+}
+
+// DeleteTagResponse 删除标签响应
+export interface DeleteTagResponse {
+  message: string; // 删除结果消息
+}
+
+// TagItem 标签列表项
+export interface TagItem {
+  id: string; // 标签 ID
+  name: string; // 标签名称
+  description: string; // 标签描述
+  created_at: string; // 创建时间
+  updated_at: string; // 更新时间
+}
+
+// ListTagsResponse 标签列表响应
+export interface ListTagsResponse {
+  total: number; // 总数量
+  page_no: number; // 当前页码
+  page_size: number; // 每页数量
+  list: TagItem[]; // 标签列表
+}
+
+// TagCloudItem 标签云项
+export interface TagCloudItem {
+  id: string; // 标签 ID
+  name: string; // 标签名称
+  post_count: number; // 已发布文章数量
+}
+
+// GetTagCloudResponse 标签云响应
+export interface GetTagCloudResponse {
+  list: TagCloudItem[]; // 标签云列表，按文章数量降序
+}
+
+// PostTagItem 文章关联标签
+export interface PostTagItem {
+  id: string; // 标签 ID
+  name: string; // 标签名称
+}